@@ -156,3 +156,135 @@ func (q Query) AggregateWithSeedByT(seed interface{},
 
 	return q.AggregateWithSeedBy(seed, fFunc, resultSelectorFunc)
 }
+
+// AggregateRight applies an accumulator function over a sequence, starting
+// from its end.
+//
+// Unlike Aggregate, which folds a sequence from left to right, AggregateRight
+// calls f() one time for each element in source except the last one, from the
+// next to last element to the first one. Each time f() is called,
+// AggregateRight passes both the element from the sequence and an aggregated
+// value (as the second argument to f()), so for the sequence [a b c] the
+// result is f(a, f(b, c)). The last element of source is used as the initial
+// aggregate value.
+//
+// If the collection supports random access, such as a query created from a
+// slice, its elements are read backwards by index, otherwise they are
+// buffered. AggregateRight returns the final result of f(), and nil if the
+// collection contains no elements.
+func (q Query) AggregateRight(f func(interface{}, interface{}) interface{}) interface{} {
+	items, n := q.backwards()
+	if n == 0 {
+		return nil
+	}
+
+	result := items(n - 1)
+	for i := n - 2; i >= 0; i-- {
+		result = f(items(i), result)
+	}
+
+	return result
+}
+
+// AggregateRightT is the typed version of AggregateRight.
+//
+//   - f is of type: func(TSource, TSource) TSource
+//
+// NOTE: AggregateRight has better performance than AggregateRightT.
+func (q Query) AggregateRightT(f interface{}) interface{} {
+	fGenericFunc, err := newGenericFunc(
+		"AggregateRightT", "f", f,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fFunc := func(current interface{}, result interface{}) interface{} {
+		return fGenericFunc.Call(current, result)
+	}
+
+	return q.AggregateRight(fFunc)
+}
+
+// AggregateRightWithSeed applies an accumulator function over a sequence,
+// starting from its end. The specified seed value is used as the initial
+// accumulator value.
+//
+// Unlike AggregateWithSeed, AggregateRightWithSeed calls f() one time for each
+// element in source, from the last one to the first one, passing the element
+// and the aggregated value (as the second argument to f()), so for the
+// sequence [a b] the result is f(a, f(b, seed)). Elements are read like in
+// AggregateRight.
+func (q Query) AggregateRightWithSeed(seed interface{},
+	f func(interface{}, interface{}) interface{}) interface{} {
+
+	items, n := q.backwards()
+	result := seed
+
+	for i := n - 1; i >= 0; i-- {
+		result = f(items(i), result)
+	}
+
+	return result
+}
+
+// AggregateRightWithSeedT is the typed version of AggregateRightWithSeed.
+//
+//   - f is of type "func(TSource, TAccumulate) TAccumulate"
+//
+// NOTE: AggregateRightWithSeed has better performance than
+// AggregateRightWithSeedT.
+func (q Query) AggregateRightWithSeedT(seed interface{},
+	f interface{}) interface{} {
+	fGenericFunc, err := newGenericFunc(
+		"AggregateRightWithSeedT", "f", f,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fFunc := func(current interface{}, result interface{}) interface{} {
+		return fGenericFunc.Call(current, result)
+	}
+
+	return q.AggregateRightWithSeed(seed, fFunc)
+}
+
+// backwards returns a function that returns the element of q at an index and
+// the number of elements of q, buffering them unless q supports random
+// access.
+func (q Query) backwards() (func(int) interface{}, int) {
+	if q.index != nil {
+		return q.index, q.length()
+	}
+
+	items := q.Results()
+	return func(i int) interface{} { return items[i] }, len(items)
+}
+
+// AggregateWhile applies an accumulator function over a sequence until the
+// function signals to stop. The specified seed value is used as the initial
+// accumulator value.
+//
+// Like AggregateWithSeed, AggregateWhile calls f() for the elements of the
+// sequence, passing the aggregated value and the element. f() returns the new
+// aggregated value and whether to continue: when it returns false, its result
+// is returned without iterating over the remaining elements, so reductions
+// such as summing until a budget is exceeded can stop early.
+func (q Query) AggregateWhile(seed interface{},
+	f func(interface{}, interface{}) (interface{}, bool)) interface{} {
+
+	next := q.Iterate()
+	result := seed
+
+	for current, ok := next(); ok; current, ok = next() {
+		var more bool
+		if result, more = f(result, current); !more {
+			break
+		}
+	}
+
+	return result
+}
@@ -117,3 +117,88 @@ func TestAggregateWithSeedByT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
 		)
 	})
 }
+
+func TestAggregateRight(t *testing.T) {
+	concat := func(i, r interface{}) interface{} {
+		return "(" + i.(string) + " " + r.(string) + ")"
+	}
+
+	tests := []struct {
+		input Query
+		want  interface{}
+	}{
+		{From([]string{"a", "b", "c"}), "(a (b c))"},
+		{From([]string{"a", "b", "c"}).Where(func(interface{}) bool { return true }), "(a (b c))"},
+		{From([]string{"a"}), "a"},
+		{Empty(), nil},
+	}
+
+	for _, test := range tests {
+		if r := test.input.AggregateRight(concat); r != test.want {
+			t.Errorf("%v.AggregateRight()=%v expected %v", test.input, r, test.want)
+		}
+	}
+
+	if r := Range(1, 4).AggregateRightT(func(i, r int) int { return i - r }); r != -2 {
+		t.Errorf("Range(1, 4).AggregateRightT(-)=%v expected -2", r)
+	}
+}
+
+func TestAggregateRightT_PanicWhenFIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "AggregateRightT: parameter [f] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int)int'", func() {
+		Range(1, 4).AggregateRightT(func(i int) int { return i })
+	})
+}
+
+func TestAggregateRightWithSeed(t *testing.T) {
+	type list struct {
+		head interface{}
+		tail *list
+	}
+
+	cons := func(i, r interface{}) interface{} {
+		return &list{head: i, tail: r.(*list)}
+	}
+
+	l := Range(1, 3).AggregateRightWithSeed((*list)(nil), cons).(*list)
+	if l.head != 1 || l.tail.head != 2 || l.tail.tail.head != 3 || l.tail.tail.tail != nil {
+		t.Errorf("Range(1, 3).AggregateRightWithSeed() expected the list 1 -> 2 -> 3")
+	}
+
+	if r := Empty().AggregateRightWithSeed("seed", cons); r != "seed" {
+		t.Errorf("Empty().AggregateRightWithSeed()=%v expected seed", r)
+	}
+
+	if r := From([]int{1, 2, 3}).AggregateRightWithSeed(0, func(i, r interface{}) interface{} {
+		return i.(int) - r.(int)
+	}); r != 2 {
+		t.Errorf("From([1 2 3]).AggregateRightWithSeed(0, -)=%v expected 2", r)
+	}
+
+	if r := FromString("abc").AggregateRightWithSeedT("", func(c rune, r string) string {
+		return r + string(c)
+	}); r != "cba" {
+		t.Errorf("FromString(abc).AggregateRightWithSeedT()=%v expected cba", r)
+	}
+}
+
+func TestAggregateWhile(t *testing.T) {
+	visited := 0
+	budget := func(acc, item interface{}) (interface{}, bool) {
+		visited++
+		sum := acc.(int) + item.(int)
+		return sum, sum < 10
+	}
+
+	if r := Range(1, 100).AggregateWhile(0, budget); r != 10 || visited != 4 {
+		t.Errorf("Range(1, 100).AggregateWhile()=%v after %d elements expected 10 after 4", r, visited)
+	}
+
+	if r := Range(1, 3).AggregateWhile(0, budget); r != 6 {
+		t.Errorf("Range(1, 3).AggregateWhile()=%v expected 6", r)
+	}
+
+	if r := Empty().AggregateWhile("seed", nil); r != "seed" {
+		t.Errorf("Empty().AggregateWhile()=%v expected seed", r)
+	}
+}
@@ -0,0 +1,194 @@
+package linq
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+)
+
+// CountDistinctApprox estimates the number of distinct elements in a
+// collection with a HyperLogLog sketch, in a single iteration and with a fixed
+// amount of memory of 2^precision bytes, so the cardinality of streams that
+// are too large for Distinct().Count() can be estimated.
+//
+// precision must be between 4 and 18. The standard error of the estimate is
+// about 1.04/sqrt(2^precision), for example 0.8% with a precision of 14, which
+// uses 16 KiB. If precision is out of range, ErrInvalidPrecision is returned.
+//
+// Elements are told apart by value like with ==, through a 64-bit hash of
+// their value.
+func (q Query) CountDistinctApprox(precision int) (uint64, error) {
+	if precision < 4 || precision > 18 {
+		return 0, ErrInvalidPrecision
+	}
+
+	m := 1 << uint(precision)
+	registers := make([]uint8, m)
+
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		x := hashItem(item)
+		index := x >> uint(64-precision)
+		rank := uint8(bits.LeadingZeros64(x<<uint(precision)|1<<uint(precision-1)) + 1)
+		if rank > registers[index] {
+			registers[index] = rank
+		}
+	}
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	mf := float64(m)
+	estimate := hyperLogLogAlpha(m) * mf * mf / sum
+	if estimate <= 2.5*mf && zeros > 0 {
+		estimate = mf * math.Log(mf/float64(zeros))
+	}
+
+	return uint64(estimate + 0.5), nil
+}
+
+// hyperLogLogAlpha returns the bias correction constant of a HyperLogLog
+// sketch with m registers.
+func hyperLogLogAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+// TopKFrequent returns the k most frequent elements of a collection with their
+// approximate number of occurrences, as KeyValue elements whose Key is the
+// element and whose Value is the int count, in descending order of counts.
+//
+// The collection is iterated once, when the first element is requested, with
+// the Space-Saving algorithm, which keeps track of only 4*k candidate
+// elements, so the heavy hitters of huge streams can be found with bounded
+// memory. For a collection of n elements, every element that occurs more than
+// n/(4*k) times is found, and counts are overestimated by at most n/(4*k).
+// Elements are told apart by value like with ==, through a 64-bit hash of
+// their value. TopKFrequent panics if k is not positive.
+func (q Query) TopKFrequent(k int) Query {
+	if k <= 0 {
+		panic(errors.New("TopKFrequent: non-positive k"))
+	}
+
+	return Query{
+		desc: q.chain("TopKFrequent(" + strconv.Itoa(k) + ")"),
+		Iterate: func() Iterator {
+			var top []*frequentItem
+			counted := false
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if !counted {
+					counted = true
+					top = spaceSaving(q, 4*k)
+					if len(top) > k {
+						top = top[:k]
+					}
+				}
+
+				if index >= len(top) {
+					return nil, false
+				}
+
+				item = KeyValue{Key: top[index].item, Value: top[index].count}
+				index++
+				return item, true
+			}
+		},
+	}
+}
+
+// frequentItem is an element tracked by the Space-Saving algorithm.
+type frequentItem struct {
+	item  interface{}
+	hash  uint64
+	count int
+	order int
+	index int
+}
+
+// frequentHeap is a min-heap of tracked elements ordered by count.
+type frequentHeap []*frequentItem
+
+func (h frequentHeap) Len() int           { return len(h) }
+func (h frequentHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+
+func (h frequentHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *frequentHeap) Push(x interface{}) {
+	item := x.(*frequentItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *frequentHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// spaceSaving counts the elements of q with the Space-Saving algorithm using m
+// counters, and returns the tracked elements in descending order of counts,
+// ties broken by the order in which they started being tracked.
+func spaceSaving(q Query, m int) []*frequentItem {
+	tracked := make(map[uint64]*frequentItem, m)
+	counters := make(frequentHeap, 0, m)
+	order := 0
+
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		hash := hashItem(item)
+		if f, ok := tracked[hash]; ok {
+			f.count++
+			heap.Fix(&counters, f.index)
+			continue
+		}
+
+		if len(counters) < m {
+			f := &frequentItem{item: item, hash: hash, count: 1, order: order}
+			tracked[hash] = f
+			heap.Push(&counters, f)
+		} else {
+			f := counters[0]
+			delete(tracked, f.hash)
+			f.item, f.hash, f.order = item, hash, order
+			f.count++
+			tracked[hash] = f
+			heap.Fix(&counters, 0)
+		}
+
+		order++
+	}
+
+	result := []*frequentItem(counters)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].count != result[j].count {
+			return result[i].count > result[j].count
+		}
+
+		return result[i].order < result[j].order
+	})
+
+	return result
+}
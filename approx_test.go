@@ -0,0 +1,72 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCountDistinctApprox(t *testing.T) {
+	tests := []struct {
+		input     Query
+		precision int
+		want      float64
+	}{
+		{Range(0, 100000).Select(func(i interface{}) interface{} { return i.(int) % 50000 }), 14, 50000},
+		{Range(0, 1000), 12, 1000},
+		{Repeat("a", 100), 10, 1},
+		{Empty(), 4, 0},
+	}
+
+	for _, test := range tests {
+		r, err := test.input.CountDistinctApprox(test.precision)
+		if err != nil || math.Abs(float64(r)-test.want) > 0.05*test.want {
+			t.Errorf("%v.CountDistinctApprox(%d)=%d, %v expected about %v", test.input, test.precision, r, err, test.want)
+		}
+	}
+
+	for _, precision := range []int{3, 19} {
+		if _, err := Range(1, 10).CountDistinctApprox(precision); err != ErrInvalidPrecision {
+			t.Errorf("CountDistinctApprox(%d) error=%v expected %v", precision, err, ErrInvalidPrecision)
+		}
+	}
+}
+
+func TestTopKFrequent(t *testing.T) {
+	input := []string{"a", "b", "a", "c", "a", "b", "d"}
+	want := []interface{}{KeyValue{"a", 3}, KeyValue{"b", 2}}
+
+	if q := From(input).TopKFrequent(2); !validateQuery(q, want) {
+		t.Errorf("From(%v).TopKFrequent(2)=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From(input).TopKFrequent(10); q.Count() != 4 {
+		t.Errorf("From(%v).TopKFrequent(10)=%v expected 4 elements", input, toSlice(q))
+	}
+
+	// Heavy hitters among many rare elements overflowing the counters.
+	stream := Range(0, 20000).Select(func(i interface{}) interface{} {
+		switch n := i.(int); {
+		case n%4 == 0:
+			return "x"
+		case n%5 == 1:
+			return "y"
+		default:
+			return n
+		}
+	})
+
+	top := stream.TopKFrequent(2).Results()
+	if len(top) != 2 || top[0].(KeyValue).Key != "x" || top[1].(KeyValue).Key != "y" {
+		t.Errorf("TopKFrequent(2)=%v expected x and y", top)
+	}
+
+	if c := top[0].(KeyValue).Value.(int); c < 5000 || c > 5000+20000/8 {
+		t.Errorf("TopKFrequent(2) count of x=%d expected between 5000 and 7500", c)
+	}
+}
+
+func TestTopKFrequent_PanicWhenKIsNotPositive(t *testing.T) {
+	mustPanicWithError(t, "TopKFrequent: non-positive k", func() {
+		Range(1, 3).TopKFrequent(0)
+	})
+}
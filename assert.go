@@ -0,0 +1,77 @@
+package linq
+
+import "fmt"
+
+// AssertionError is the error Assert panics with when an element of a
+// collection doesn't satisfy the asserted condition.
+type AssertionError struct {
+	// Message is the message passed to Assert.
+	Message string
+
+	// Index is the zero-based index of the element in the collection.
+	Index int
+
+	// Item is the element that doesn't satisfy the condition.
+	Item interface{}
+}
+
+// Error returns the message of the assertion with the offending element and
+// its index.
+func (e AssertionError) Error() string {
+	return fmt.Sprintf("linq: assertion failed: %s (element %d: %v)", e.Message, e.Index, e.Item)
+}
+
+// Assert returns the elements of a collection unchanged and checks that each
+// of them satisfies predicate as they pass through the query. If an element
+// doesn't, iterating over the query panics with an AssertionError holding msg,
+// the element and its index, so invalid data is reported at the point it
+// enters the pipeline instead of where it causes a failure.
+//
+// Since AssertionError is an error, the failure can be recovered with Catch or
+// observed with Materialize and Subscribe like the errors of other sources.
+// The returned query doesn't report its number of elements without iterating,
+// so methods such as Count check every element too.
+func (q Query) Assert(predicate func(interface{}) bool, msg string) Query {
+	return Query{
+		desc: q.chain("Assert"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				if !predicate(item) {
+					panic(AssertionError{Message: msg, Index: index, Item: item})
+				}
+
+				index++
+				return
+			}
+		},
+	}
+}
+
+// AssertT is the typed version of Assert.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: Assert has better performance than AssertT.
+func (q Query) AssertT(predicateFn interface{}, msg string) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"AssertT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.Assert(predicateFunc, msg)
+}
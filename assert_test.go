@@ -0,0 +1,51 @@
+package linq
+
+import "testing"
+
+func TestAssert(t *testing.T) {
+	positive := func(item interface{}) bool { return item.(int) > 0 }
+
+	q := From([]int{1, 2, 3}).Assert(positive, "not positive")
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("From([]int{1, 2, 3}).Assert()=%v expected %v", toSlice(q), w)
+	}
+
+	mustPanicWithError(t, "linq: assertion failed: not positive (element 2: -3)", func() {
+		From([]int{1, 2, -3, 4}).Assert(positive, "not positive").ToSlice(new([]int))
+	})
+
+	mustPanicWithError(t, "linq: assertion failed: not positive (element 1: -2)", func() {
+		From([]int{1, -2, 3}).Assert(positive, "not positive").Count()
+	})
+}
+
+func TestAssertWithCatch(t *testing.T) {
+	var got error
+	q := From([]int{1, -2, 3}).
+		Assert(func(item interface{}) bool { return item.(int) > 0 }, "not positive").
+		Catch(func(err error) Query {
+			got = err
+			return Empty()
+		})
+
+	if w := []interface{}{1}; !validateQuery(q, w) {
+		t.Errorf("Assert().Catch()=%v expected %v", toSlice(q), w)
+	}
+
+	e, ok := got.(AssertionError)
+	if !ok || e.Index != 1 || e.Item != -2 || e.Message != "not positive" {
+		t.Errorf("Assert().Catch() handler called with %#v expected AssertionError for element 1", got)
+	}
+}
+
+func TestAssertT(t *testing.T) {
+	mustPanicWithError(t, "linq: assertion failed: empty name (element 1: )", func() {
+		From([]string{"a", "", "c"}).AssertT(func(s string) bool { return s != "" }, "empty name").ToSlice(new([]string))
+	})
+}
+
+func TestAssertT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "AssertT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 3}).AssertT(func(item int) int { return item }, "invalid")
+	})
+}
@@ -91,3 +91,77 @@ func BenchmarkFromChannelT(b *testing.B) {
 		FromChannelT(ch).All(func(i interface{}) bool { return true })
 	}
 }
+
+func BenchmarkToSlice(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		var result []int
+		Range(1, size).ToSlice(&result)
+	}
+}
+
+func BenchmarkWhereT_newQueries(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		Range(1, 10).WhereT(func(i int) bool {
+			return i%2 == 0
+		}).Count()
+	}
+}
+
+func BenchmarkConcat(b *testing.B) {
+	queries := make([]Query, 50)
+	for i := range queries {
+		queries[i] = Range(1, size/len(queries))
+	}
+
+	b.Run("chained", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			q := Empty()
+			for _, query := range queries {
+				q = q.Concat(query)
+			}
+
+			q.Where(func(interface{}) bool { return true }).Count()
+		}
+	})
+
+	b.Run("flat", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			Concat(queries...).Where(func(interface{}) bool { return true }).Count()
+		}
+	})
+}
+
+func BenchmarkWhereSelectTake(b *testing.B) {
+	even := func(i interface{}) bool { return i.(int)%2 == 0 }
+	double := func(i interface{}) interface{} { return i.(int) * 2 }
+
+	b.Run("short", func(b *testing.B) {
+		q := Range(1, 20).Where(even).Select(double).Take(5)
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			q.Count()
+		}
+	})
+
+	b.Run("deep", func(b *testing.B) {
+		// Small ints are boxed without allocating, so that only the
+		// allocations of the pipeline itself are measured.
+		q := Range(0, 200)
+		for i := 0; i < 10; i++ {
+			q = q.Where(even).Select(func(i interface{}) interface{} { return i }).Take(100)
+		}
+
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			q.Count()
+		}
+	})
+
+	b.Run("long", func(b *testing.B) {
+		q := Range(1, size).Where(even).Select(double).Take(size)
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			q.Count()
+		}
+	})
+}
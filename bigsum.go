@@ -0,0 +1,105 @@
+package linq
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// SumBigInt computes the sum of a collection of integral values with arbitrary
+// precision.
+//
+// Values can be of any integer or unsigned integer type, *big.Int, big.Int or a
+// string holding a base 10 integer. The result never overflows. Method returns
+// zero if collection contains no elements and panics if an element cannot be
+// converted to *big.Int.
+func (q Query) SumBigInt() *big.Int {
+	next := q.Iterate()
+	r := new(big.Int)
+	v := new(big.Int)
+
+	for item, ok := next(); ok; item, ok = next() {
+		if !setBigInt(v, item) {
+			panic(fmt.Errorf("SumBigInt: element [%v] of type '%T' cannot be converted to *big.Int", item, item))
+		}
+
+		r.Add(r, v)
+	}
+
+	return r
+}
+
+// SumBigFloat computes the sum of a collection of numeric values with
+// arbitrary precision.
+//
+// Values can be of any integer, unsigned integer or float type, *big.Int,
+// *big.Float, big.Float, *big.Rat or a string holding a floating-point number.
+// The result has the precision of big.Float's default for each operand, which
+// is at least 64 bits. Method returns zero if collection contains no elements
+// and panics if an element cannot be converted to *big.Float.
+func (q Query) SumBigFloat() *big.Float {
+	next := q.Iterate()
+	r := new(big.Float)
+
+	for item, ok := next(); ok; item, ok = next() {
+		v, ok := toBigFloat(item)
+		if !ok {
+			panic(fmt.Errorf("SumBigFloat: element [%v] of type '%T' cannot be converted to *big.Float", item, item))
+		}
+
+		if v.Prec() > r.Prec() {
+			r.SetPrec(v.Prec())
+		}
+
+		r.Add(r, v)
+	}
+
+	return r
+}
+
+// setBigInt sets z to the value of item and reports whether item could be
+// converted.
+func setBigInt(z *big.Int, item interface{}) bool {
+	switch v := item.(type) {
+	case int, int8, int16, int32, int64:
+		z.SetInt64(getIntConverter(v)(v))
+	case uint, uint8, uint16, uint32, uint64:
+		z.SetUint64(getUIntConverter(v)(v))
+	case *big.Int:
+		z.Set(v)
+	case big.Int:
+		z.Set(&v)
+	case string:
+		_, ok := z.SetString(v, 10)
+		return ok
+	default:
+		return false
+	}
+
+	return true
+}
+
+// toBigFloat converts item to a *big.Float and reports whether item could be
+// converted.
+func toBigFloat(item interface{}) (*big.Float, bool) {
+	switch v := item.(type) {
+	case int, int8, int16, int32, int64:
+		return new(big.Float).SetInt64(getIntConverter(v)(v)), true
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Float).SetUint64(getUIntConverter(v)(v)), true
+	case float32, float64:
+		return new(big.Float).SetFloat64(getFloatConverter(v)(v)), true
+	case *big.Int:
+		return new(big.Float).SetInt(v), true
+	case *big.Float:
+		return v, true
+	case big.Float:
+		return &v, true
+	case *big.Rat:
+		return new(big.Float).SetRat(v), true
+	case string:
+		f, _, err := big.ParseFloat(v, 10, 0, big.ToNearestEven)
+		return f, err == nil
+	}
+
+	return nil, false
+}
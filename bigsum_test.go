@@ -0,0 +1,58 @@
+package linq
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSumBigInt(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{[]int{1, 2, 3}, "6"},
+		{[]int64{math.MaxInt64, math.MaxInt64}, "18446744073709551614"},
+		{[]uint64{math.MaxUint64, 1}, "18446744073709551616"},
+		{[]interface{}{1, uint8(2), "3", huge}, "100000000000000000006"},
+		{[]int{}, "0"},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SumBigInt(); r.String() != test.want {
+			t.Errorf("From(%v).SumBigInt()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestSumBigInt_PanicWhenElementIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SumBigInt: element [1.5] of type 'float64' cannot be converted to *big.Int", func() {
+		From([]interface{}{1, 1.5}).SumBigInt()
+	})
+}
+
+func TestSumBigFloat(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{[]float64{1.5, 2.25}, "3.75"},
+		{[]interface{}{1, uint(2), float32(0.5), "0.25", big.NewInt(10), big.NewFloat(0.125), big.NewRat(1, 2)}, "14.375"},
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, "3.595386269724631e+308"},
+		{[]float64{}, "0"},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SumBigFloat(); r.Text('g', 16) != test.want {
+			t.Errorf("From(%v).SumBigFloat()=%v expected %v", test.input, r.Text('g', 16), test.want)
+		}
+	}
+}
+
+func TestSumBigFloat_PanicWhenElementIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SumBigFloat: element [abc] of type 'string' cannot be converted to *big.Float", func() {
+		From([]string{"1", "abc"}).SumBigFloat()
+	})
+}
@@ -0,0 +1,92 @@
+package linq
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// BufferByTime returns a query that emits, once every window, a []interface{}
+// slice of the elements produced by a collection during that window. Windows
+// during which the collection produced no element emit an empty slice if
+// emitEmpty is true, and nothing otherwise. When the collection ends, the
+// elements of the last, partial window are emitted if there are any, then the
+// query ends.
+//
+// The collection is iterated in its own goroutine as fast as it produces
+// elements, so BufferByTime is meant for channel-backed sources driving
+// periodic batch processing. Like in SampleEvery, the goroutine stops once the
+// collection ends, once the iterator of the returned query has been abandoned
+// and garbage collected, or once the Context of the query passed to
+// BufferByTime, set with WithOptions, is done, which also ends the returned
+// query. It stops at the latest when the collection produces its next
+// element, without handing it on.
+//
+// If iterating over the collection panics, the iterator of the returned query
+// panics with the same value. BufferByTime panics if window is not positive.
+func (q Query) BufferByTime(window time.Duration, emitEmpty bool) Query {
+	if window <= 0 {
+		panic(errors.New("BufferByTime: non-positive window"))
+	}
+
+	ctx := q.context()
+
+	return Query{
+		desc: q.chain("BufferByTime"),
+		Iterate: func() Iterator {
+			var mu sync.Mutex
+			var buffer []interface{}
+
+			h := startPump(q, func(item interface{}) {
+				mu.Lock()
+				buffer = append(buffer, item)
+				mu.Unlock()
+			})
+
+			// take returns the elements collected since the last call.
+			take := func() []interface{} {
+				mu.Lock()
+				defer mu.Unlock()
+
+				items := buffer
+				buffer = nil
+				return items
+			}
+
+			tick := newTicks(window)
+			finished := false
+
+			return func() (item interface{}, ok bool) {
+				for !finished {
+					if ctx.Err() != nil {
+						finished = true
+						h.p.stop()
+						break
+					}
+
+					select {
+					case <-tick.wait():
+						if items := take(); len(items) > 0 || emitEmpty {
+							if items == nil {
+								items = []interface{}{}
+							}
+
+							return items, true
+						}
+					case <-h.p.done:
+						finished = true
+						h.p.rethrow()
+						if items := take(); len(items) > 0 {
+							return items, true
+						}
+					case <-ctx.Done():
+						finished = true
+						h.p.stop()
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
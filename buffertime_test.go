@@ -0,0 +1,102 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBufferByTime(t *testing.T) {
+	const window = 50 * time.Millisecond
+
+	for _, emitEmpty := range []bool{false, true} {
+		ch := make(chan interface{})
+
+		go func() {
+			ch <- 1
+			ch <- 2
+			time.Sleep(3 * window)
+			ch <- 3
+			close(ch)
+		}()
+
+		var batches [][]interface{}
+		FromChannel(ch).BufferByTime(window, emitEmpty).ToSlice(&batches)
+
+		if len(batches) < 2 || !reflect.DeepEqual(batches[0], []interface{}{1, 2}) ||
+			!reflect.DeepEqual(batches[len(batches)-1], []interface{}{3}) {
+			t.Fatalf("BufferByTime(%v)=%v expected [[1 2] ... [3]]", emitEmpty, batches)
+		}
+
+		empty := 0
+		for _, batch := range batches[1 : len(batches)-1] {
+			if len(batch) != 0 {
+				t.Errorf("BufferByTime(%v)=%v expected empty batches between [1 2] and [3]", emitEmpty, batches)
+			}
+
+			empty++
+		}
+
+		if emitEmpty && empty == 0 {
+			t.Errorf("BufferByTime(true)=%v expected empty batches", batches)
+		}
+
+		if !emitEmpty && empty != 0 {
+			t.Errorf("BufferByTime(false)=%v expected no empty batches", batches)
+		}
+	}
+}
+
+func TestBufferByTimeStopsWhenAbandoned(t *testing.T) {
+	var produced int64
+	source := Generate(0, func(i interface{}) interface{} {
+		atomic.AddInt64(&produced, 1)
+		return i.(int) + 1
+	})
+
+	if first := source.BufferByTime(time.Millisecond, false).First(); first == nil {
+		t.Fatalf("BufferByTime().First()=nil expected a batch")
+	}
+
+	if !pumpStops(&produced) {
+		t.Errorf("BufferByTime() kept iterating over the collection after First()")
+	}
+}
+
+func TestBufferByTimeWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var produced int64
+	source := Generate(0, func(i interface{}) interface{} {
+		atomic.AddInt64(&produced, 1)
+		return i.(int) + 1
+	})
+
+	next := source.WithOptions(Options{Context: ctx}).BufferByTime(time.Millisecond, true).Iterate()
+	if _, ok := next(); !ok {
+		t.Fatalf("BufferByTime() ended before the context was canceled")
+	}
+
+	cancel()
+	if item, ok := next(); ok {
+		t.Errorf("BufferByTime()=%v after the context was canceled expected no batch", item)
+	}
+
+	if !pumpStops(&produced) {
+		t.Errorf("BufferByTime() kept iterating over the collection after the context was canceled")
+	}
+}
+
+func TestBufferByTime_PanicWhenSourcePanics(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		Range(1, 3).Select(func(interface{}) interface{} {
+			panic(errors.New("read failed"))
+		}).BufferByTime(time.Millisecond, false).Results()
+	})
+
+	mustPanicWithError(t, "BufferByTime: non-positive window", func() {
+		Range(1, 3).BufferByTime(0, false)
+	})
+}
@@ -0,0 +1,174 @@
+package linq
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheStore stores the results of queries marked with Cached. Implementations
+// must be safe for concurrent use. Use NewMemoryCacheStore for an in-process
+// store, or implement CacheStore to share results through an external cache.
+type CacheStore interface {
+	// Get returns the elements stored with key and true, or false if there
+	// are none or they have expired.
+	Get(key string) ([]interface{}, bool)
+
+	// Set stores the elements with key for ttl, or until they are replaced if
+	// ttl is not positive.
+	Set(key string, items []interface{}, ttl time.Duration)
+}
+
+// cacheStoreHolder wraps the global cache store, since atomic.Value can't
+// store nil.
+type cacheStoreHolder struct {
+	store CacheStore
+}
+
+var globalCacheStore atomic.Value
+
+// SetCacheStore sets the store used by Cached, such as one returned by
+// NewMemoryCacheStore. Caching is disabled by default and when nil is passed,
+// so cached queries are computed every time they are iterated until a store
+// is set.
+func SetCacheStore(store CacheStore) {
+	globalCacheStore.Store(cacheStoreHolder{store: store})
+}
+
+// Cached returns a query whose results are stored in the store set with
+// SetCacheStore, if any, under the specified key for ttl, so an expensive query over
+// immutable data, such as a configuration snapshot or a reference table, is
+// computed once and then read from the store until its results expire. If ttl
+// is not positive, the results don't expire.
+//
+// The key has to identify both the query and the data it is computed from,
+// for example by including the version of a snapshot or a fingerprint of the
+// source obtained with HashSequence. When the store has no results for the
+// key, the query is iterated to the end when the first element is requested,
+// and all its elements are stored. Concurrent iterations may both compute the
+// results.
+func (q Query) Cached(key string, ttl time.Duration) Query {
+	return q.cachedIn(func() CacheStore {
+		holder, _ := globalCacheStore.Load().(cacheStoreHolder)
+		return holder.store
+	}, key, ttl)
+}
+
+// CachedIn is like Cached, but stores the results in the specified store
+// instead of the one set with SetCacheStore.
+func (q Query) CachedIn(store CacheStore, key string, ttl time.Duration) Query {
+	return q.cachedIn(func() CacheStore { return store }, key, ttl)
+}
+
+// cachedIn returns q cached in the store returned by store at the time the
+// query is iterated.
+func (q Query) cachedIn(store func() CacheStore, key string, ttl time.Duration) Query {
+	return Query{
+		desc: q.chain("Cached"),
+		Iterate: func() Iterator {
+			var items []interface{}
+			loaded := false
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if !loaded {
+					loaded = true
+
+					s := store()
+					if s == nil {
+						items = q.Results()
+					} else if items, ok = s.Get(key); !ok {
+						items = q.Results()
+						s.Set(key, items, ttl)
+					}
+				}
+
+				ok = index < len(items)
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// memoryCacheStore is the CacheStore returned by NewMemoryCacheStore.
+type memoryCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+	now     func() time.Time
+
+	// sweepAt is the number of entries at which Set removes the expired
+	// entries.
+	sweepAt int
+}
+
+type memoryCacheEntry struct {
+	items   []interface{}
+	expires time.Time
+}
+
+// NewMemoryCacheStore returns a CacheStore that keeps results in memory.
+// Expired results are removed when they are looked up, and all of them
+// whenever the number of stored results has doubled since the last sweep, so
+// results stored under keys that are never looked up again don't pile up once
+// they have expired. Results stored without a ttl are kept until they are
+// replaced.
+func NewMemoryCacheStore() CacheStore {
+	return newMemoryCacheStore(time.Now)
+}
+
+func newMemoryCacheStore(now func() time.Time) *memoryCacheStore {
+	return &memoryCacheStore{entries: make(map[string]memoryCacheEntry), now: now, sweepAt: 64}
+}
+
+func (s *memoryCacheStore) Get(key string) ([]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !e.expires.IsZero() && !s.now().Before(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+
+	return e.items, true
+}
+
+func (s *memoryCacheStore) Set(key string, items []interface{}, ttl time.Duration) {
+	e := memoryCacheEntry{items: items}
+	if ttl > 0 {
+		e.expires = s.now().Add(ttl)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = e
+	if len(s.entries) >= s.sweepAt {
+		s.sweep()
+	}
+}
+
+// sweep removes the expired entries, and sets the number of entries of the
+// next sweep to twice the number of remaining ones.
+func (s *memoryCacheStore) sweep() {
+	now := s.now()
+	for key, e := range s.entries {
+		if !e.expires.IsZero() && !now.Before(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+
+	s.sweepAt = 2 * len(s.entries)
+	if s.sweepAt < 64 {
+		s.sweepAt = 64
+	}
+}
@@ -0,0 +1,87 @@
+package linq
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCached(t *testing.T) {
+	computed := 0
+	q := Range(1, 3).Select(func(i interface{}) interface{} {
+		computed++
+		return i.(int) * 10
+	}).CachedIn(NewMemoryCacheStore(), "tens", 0)
+
+	for i := 0; i < 3; i++ {
+		if w := []interface{}{10, 20, 30}; !validateQuery(q, w) {
+			t.Errorf("Cached()=%v expected %v", toSlice(q), w)
+		}
+	}
+
+	if computed != 3 {
+		t.Errorf("Cached() computed %d elements expected 3", computed)
+	}
+}
+
+func TestCachedExpiry(t *testing.T) {
+	now := time.Unix(0, 0)
+	store := newMemoryCacheStore(func() time.Time { return now })
+
+	computed := 0
+	q := FromFunc(func() (interface{}, bool) {
+		computed++
+		return computed, computed%2 == 1
+	}).CachedIn(store, "counter", time.Minute)
+
+	if w := []interface{}{1}; !validateQuery(q, w) || !validateQuery(q, w) {
+		t.Errorf("Cached()=%v expected %v", toSlice(q), w)
+	}
+
+	now = now.Add(time.Minute)
+	if w := []interface{}{3}; !validateQuery(q, w) {
+		t.Errorf("Cached() after expiry=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestMemoryCacheStoreSweepsExpiredEntries(t *testing.T) {
+	now := time.Unix(0, 0)
+	store := newMemoryCacheStore(func() time.Time { return now })
+
+	store.Set("forever", []interface{}{0}, 0)
+	for i := 0; i < 1000; i++ {
+		store.Set("version "+strconv.Itoa(i), []interface{}{i}, time.Minute)
+		now = now.Add(time.Second)
+	}
+
+	if n := len(store.entries); n > 130 {
+		t.Errorf("memoryCacheStore kept %d entries expected at most 130", n)
+	}
+
+	if _, ok := store.Get("forever"); !ok {
+		t.Errorf("memoryCacheStore removed an entry without a ttl")
+	}
+}
+
+func TestSetCacheStore(t *testing.T) {
+	defer SetCacheStore(nil)
+
+	computed := 0
+	q := Range(1, 2).Select(func(i interface{}) interface{} {
+		computed++
+		return i
+	})
+
+	// Caching is disabled by default.
+	q.Cached("numbers", 0).Results()
+	q.Cached("numbers", 0).Results()
+	if computed != 4 {
+		t.Errorf("Cached() without a store computed %d elements expected 4", computed)
+	}
+
+	SetCacheStore(NewMemoryCacheStore())
+	q.Cached("numbers", 0).Results()
+	if r := q.Cached("numbers", 0).Results(); computed != 6 || len(r) != 2 {
+		t.Errorf("Cached()=%v computed %d elements expected [1 2], 6", r, computed)
+	}
+}
@@ -0,0 +1,28 @@
+package linq
+
+// WithCapacityHint returns the query with a hint of the approximate number of
+// its elements. Operators that build maps or slices from the elements of the
+// query, such as Distinct, DistinctBy, GroupBy, ToMap and ToMapBy, use the
+// hint to pre-size them, which avoids repeated rehashing and regrowing when
+// the number of elements is large.
+//
+// The hint doesn't change the elements of the query and applies only to the
+// operator it is directly passed to. A hint that is not positive is ignored.
+func (q Query) WithCapacityHint(n int) Query {
+	q.capacityHint = n
+	return q
+}
+
+// capacity returns the approximate number of elements of the query: the hint
+// set by WithCapacityHint, or the known length of the query, or zero.
+func (q Query) capacity() int {
+	if q.capacityHint > 0 {
+		return q.capacityHint
+	}
+
+	if q.length != nil {
+		return q.length()
+	}
+
+	return 0
+}
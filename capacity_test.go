@@ -0,0 +1,49 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithCapacityHint(t *testing.T) {
+	tests := []struct {
+		input Query
+		want  int
+	}{
+		{From([]int{1, 2, 3}), 3},
+		{From([]int{1, 2, 3}).WithCapacityHint(100), 100},
+		{From([]int{1, 2, 3}).WithCapacityHint(-1), 3},
+		{From([]int{1, 2, 3}).Where(func(interface{}) bool { return true }), 0},
+		{From([]int{1, 2, 3}).Where(func(interface{}) bool { return true }).WithCapacityHint(10), 10},
+	}
+
+	for _, test := range tests {
+		if c := test.input.capacity(); c != test.want {
+			t.Errorf("capacity()=%v expected %v", c, test.want)
+		}
+	}
+
+	q := From([]int{1, 2, 2, 3}).WithCapacityHint(100)
+	if w := []interface{}{1, 2, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("WithCapacityHint(100)=%v expected %v", toSlice(q), w)
+	}
+
+	if c := q.Count(); c != 4 {
+		t.Errorf("WithCapacityHint(100).Count()=%v expected 4", c)
+	}
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q.Distinct(), w) {
+		t.Errorf("WithCapacityHint(100).Distinct()=%v expected %v", toSlice(q.Distinct()), w)
+	}
+}
+
+func TestToMapByWithNilMap(t *testing.T) {
+	var m map[int]bool
+	From([]int{1, 2}).WithCapacityHint(10).ToMapBy(&m,
+		func(i interface{}) interface{} { return i },
+		func(i interface{}) interface{} { return true })
+
+	if w := map[int]bool{1: true, 2: true}; !reflect.DeepEqual(m, w) {
+		t.Errorf("ToMapBy()=%v expected %v", m, w)
+	}
+}
@@ -0,0 +1,96 @@
+package linq
+
+import "strconv"
+
+// CartesianProduct returns the Cartesian product of passed queries: every
+// combination of one element from each query, as a []interface{} with the
+// elements in the order of the queries. The combinations are produced lazily,
+// with the element of the rightmost query varying fastest, so the product of
+// [1 2] and [a b] is [1 a], [1 b], [2 a], [2 b].
+//
+// Every query but the first is iterated once for each combination of the
+// elements of the preceding queries, so they have to support being iterated
+// several times, like queries created from slices. If no queries are passed
+// or any of them is empty, the result is empty.
+func CartesianProduct(queries ...Query) Query {
+	queries = append([]Query(nil), queries...)
+
+	var length func() int
+	if len(queries) > 0 {
+		length = func() int {
+			n := 1
+			for _, q := range queries {
+				n *= q.length()
+			}
+
+			return n
+		}
+
+		for _, q := range queries {
+			if q.length == nil {
+				length = nil
+				break
+			}
+		}
+	}
+
+	return Query{
+		desc:   "CartesianProduct(" + strconv.Itoa(len(queries)) + ")",
+		length: length,
+		Iterate: func() Iterator {
+			nexts := make([]Iterator, len(queries))
+			current := make([]interface{}, len(queries))
+			started := false
+			done := len(queries) == 0
+
+			advance := func() bool {
+				if !started {
+					started = true
+					for i, q := range queries {
+						nexts[i] = q.Iterate()
+						item, ok := nexts[i]()
+						if !ok {
+							return false
+						}
+
+						current[i] = item
+					}
+
+					return true
+				}
+
+				for i := len(queries) - 1; ; i-- {
+					if item, ok := nexts[i](); ok {
+						current[i] = item
+						return true
+					}
+
+					if i == 0 {
+						return false
+					}
+
+					nexts[i] = queries[i].Iterate()
+					item, ok := nexts[i]()
+					if !ok {
+						return false
+					}
+
+					current[i] = item
+				}
+			}
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				if !advance() {
+					done = true
+					return nil, false
+				}
+
+				return append([]interface{}(nil), current...), true
+			}
+		},
+	}
+}
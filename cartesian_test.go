@@ -0,0 +1,39 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	tests := []struct {
+		input []Query
+		want  []interface{}
+	}{
+		{[]Query{From([]int{1, 2}), From([]string{"a", "b"})}, []interface{}{
+			[]interface{}{1, "a"}, []interface{}{1, "b"}, []interface{}{2, "a"}, []interface{}{2, "b"},
+		}},
+		{[]Query{Range(1, 2), Repeat(0, 1), FromString("xy")}, []interface{}{
+			[]interface{}{1, 0, 'x'}, []interface{}{1, 0, 'y'}, []interface{}{2, 0, 'x'}, []interface{}{2, 0, 'y'},
+		}},
+		{[]Query{Range(1, 3)}, []interface{}{[]interface{}{1}, []interface{}{2}, []interface{}{3}}},
+		{[]Query{Range(1, 3), Empty()}, nil},
+		{[]Query{From([]int{}), Range(1, 3)}, nil},
+		{nil, nil},
+	}
+
+	for _, test := range tests {
+		if q := CartesianProduct(test.input...); !reflect.DeepEqual(toSlice(q), test.want) {
+			t.Errorf("CartesianProduct(%v)=%v expected %v", test.input, toSlice(q), test.want)
+		}
+	}
+
+	q := CartesianProduct(Range(1, 3), From([]int{1, 2}), FromString("abcd"))
+	if c := q.Count(); c != 24 || q.Where(func(interface{}) bool { return true }).Count() != 24 {
+		t.Errorf("CartesianProduct().Count()=%d expected 24", c)
+	}
+
+	if s := q.String(); s != "CartesianProduct(3)" {
+		t.Errorf("CartesianProduct().String()=%s expected CartesianProduct(3)", s)
+	}
+}
@@ -0,0 +1,43 @@
+package linq
+
+// Catch recovers a collection that fails while it is iterated. If iterating
+// over the collection panics with an error, as the sources reading from an
+// io.Reader do, handler is called with the error and the elements of the
+// collection it returns follow the elements produced so far.
+//
+// Runtime panics and panics with values that are not errors are not
+// recovered. Errors of the collection returned by handler are not recovered
+// either; chain another Catch to handle them.
+func (q Query) Catch(handler func(error) Query) Query {
+	return Query{
+		desc: q.chain("Catch"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			recovered := false
+
+			return func() (item interface{}, ok bool) {
+				if recovered {
+					return next()
+				}
+
+				item, ok, err := tryNext(next)
+				if err != nil {
+					recovered = true
+					next = handler(err).Iterate()
+					return next()
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// OnErrorResumeNext recovers a collection that fails while it is iterated by
+// continuing with the elements of fallback. It is a shorthand for Catch with a
+// handler that ignores the error and returns fallback.
+func (q Query) OnErrorResumeNext(fallback Query) Query {
+	return q.Catch(func(error) Query {
+		return fallback
+	})
+}
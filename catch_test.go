@@ -0,0 +1,57 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+var errCatch = errors.New("read failed")
+
+func failAt(n int) Query {
+	return Range(1, 5).Select(func(i interface{}) interface{} {
+		if i.(int) == n {
+			panic(errCatch)
+		}
+
+		return i
+	})
+}
+
+func TestCatch(t *testing.T) {
+	var caught error
+	q := failAt(3).Catch(func(err error) Query {
+		caught = err
+		return From([]int{10, 11})
+	})
+
+	if w := []interface{}{1, 2, 10, 11}; !validateQuery(q, w) {
+		t.Errorf("Catch()=%v expected %v", toSlice(q), w)
+	}
+
+	if caught != errCatch {
+		t.Errorf("Catch() handler got %v expected %v", caught, errCatch)
+	}
+
+	q = Range(1, 3).Catch(func(err error) Query {
+		t.Errorf("Catch() handler called for a collection that doesn't fail")
+		return Empty()
+	})
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("Catch()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestCatch_PanicWhenHandlerQueryFails(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		failAt(1).Catch(func(error) Query { return failAt(2) }).Results()
+	})
+}
+
+func TestOnErrorResumeNext(t *testing.T) {
+	q := failAt(2).OnErrorResumeNext(failAt(3).OnErrorResumeNext(From([]int{0})))
+
+	if w := []interface{}{1, 1, 2, 0}; !validateQuery(q, w) {
+		t.Errorf("OnErrorResumeNext()=%v expected %v", toSlice(q), w)
+	}
+}
@@ -0,0 +1,49 @@
+package linq
+
+import "sync/atomic"
+
+// Clone returns a copy of the query that can be iterated independently of it,
+// and true, if iterating over the query doesn't consume its source, such as a
+// query created from a slice, a map, a string or Range, or built from one by
+// operators that keep track of the number of elements, like Select.
+//
+// Otherwise, Clone returns an empty query and false, since the query may be
+// created from a single-use source, such as a channel or an io.Reader, that
+// the copy would consume too. Use Memoize or Fork to iterate over such a query
+// several times.
+func (q Query) Clone() (Query, bool) {
+	if q.length == nil {
+		return Empty(), false
+	}
+
+	return q, true
+}
+
+// singleUse returns the Iterate function of a query created from a single-use
+// source, which is consumed while the query is iterated. Iterating again
+// before an iteration reached the end continues where it stopped, but the
+// iterator of an iteration that starts after the end panics with
+// ErrSourceExhausted on its first call.
+func singleUse(iterate func() Iterator) func() Iterator {
+	var exhausted int32
+
+	return func() Iterator {
+		next := iterate()
+		started := false
+
+		return func() (item interface{}, ok bool) {
+			if !started {
+				started = true
+				if atomic.LoadInt32(&exhausted) != 0 {
+					panic(ErrSourceExhausted)
+				}
+			}
+
+			if item, ok = next(); !ok {
+				atomic.StoreInt32(&exhausted, 1)
+			}
+
+			return
+		}
+	}
+}
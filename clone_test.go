@@ -0,0 +1,47 @@
+package linq
+
+import "testing"
+
+func TestClone(t *testing.T) {
+	for _, q := range []Query{
+		From([]int{1, 2, 3}),
+		Range(1, 3).Select(func(i interface{}) interface{} { return i }),
+		From(map[int]bool{1: true}),
+	} {
+		if c, ok := q.Clone(); !ok || !validateQuery(c, toSlice(q)) {
+			t.Errorf("%v.Clone()=%v, %v expected %v, true", q, toSlice(c), ok, toSlice(q))
+		}
+	}
+
+	ch := make(chan interface{}, 1)
+	ch <- 1
+	if c, ok := FromChannel(ch).Clone(); ok || !validateQuery(c, []interface{}{}) || len(ch) != 1 {
+		t.Errorf("FromChannel().Clone()=%v, %v expected [], false without consuming the channel", toSlice(c), ok)
+	}
+}
+
+func TestSingleUse(t *testing.T) {
+	ch := make(chan interface{}, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	q := FromChannel(ch).Select(func(i interface{}) interface{} { return i })
+	if first := q.First(); first != 1 {
+		t.Errorf("FromChannel().First()=%v expected 1", first)
+	}
+
+	if w := []interface{}{2, 3}; !validateQuery(q, w) {
+		t.Errorf("FromChannel() iterated again before the end expected %v", w)
+	}
+
+	mustPanicWithError(t, ErrSourceExhausted.Error(), func() {
+		q.Results()
+	})
+
+	var caught error
+	if r := q.Catch(func(err error) Query { caught = err; return Empty() }); !validateQuery(r, []interface{}{}) || caught != ErrSourceExhausted {
+		t.Errorf("FromChannel().Catch() caught %v expected %v", caught, ErrSourceExhausted)
+	}
+}
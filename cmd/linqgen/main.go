@@ -0,0 +1,236 @@
+// Command linqgen generates typed wrappers around go-linq operators for a set
+// of element types, so hot paths can avoid the reflection used by the methods
+// with T suffix.
+//
+// Usage:
+//
+//	linqgen -package main -types int,string,User=*model.User -imports example.com/model -output linq_gen.go
+//
+// For every type T named N it generates:
+//
+//	func FromNSlice(source []T) linq.Query
+//	func WhereN(q linq.Query, predicate func(T) bool) linq.Query
+//	func FirstN(q linq.Query) (T, bool)
+//	func ForEachN(q linq.Query, action func(T))
+//	func ToNSlice(q linq.Query) []T
+//
+// and for every pair of types T and U named N and M:
+//
+//	func SelectNToM(q linq.Query, selector func(T) U) linq.Query
+//
+// A type is given either as a Go type expression, in which case its name is
+// derived from the last identifier of the expression, or as Name=Type. It is
+// typically invoked from a go:generate directive.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// elemType is an element type the wrappers are generated for.
+type elemType struct {
+	Name string
+	Type string
+}
+
+// config holds the parameters of a generated file.
+type config struct {
+	Package string
+	Imports []string
+	Types   []elemType
+}
+
+func main() {
+	pkg := flag.String("package", "", "package name of the generated file")
+	types := flag.String("types", "", "comma-separated list of element types, as Type or Name=Type")
+	imports := flag.String("imports", "", "comma-separated list of import paths the types need")
+	output := flag.String("output", "linq_gen.go", "output file name")
+	flag.Parse()
+
+	if *pkg == "" || *types == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := newConfig(*pkg, *types, *imports)
+	if err != nil {
+		fatal(err)
+	}
+
+	src, err := generate(cfg)
+	if err != nil {
+		fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "linqgen:", err)
+	os.Exit(1)
+}
+
+// newConfig parses the command line lists into a config.
+func newConfig(pkg, types, imports string) (config, error) {
+	cfg := config{Package: pkg}
+	seen := make(map[string]bool)
+
+	for _, t := range splitList(types) {
+		et := elemType{Type: t}
+		if i := strings.Index(t, "="); i >= 0 {
+			et = elemType{Name: strings.TrimSpace(t[:i]), Type: strings.TrimSpace(t[i+1:])}
+		} else {
+			et.Name = typeName(t)
+		}
+
+		if et.Name == "" || et.Type == "" {
+			return config{}, fmt.Errorf("invalid type %q", t)
+		}
+
+		if seen[et.Name] {
+			return config{}, fmt.Errorf("duplicate type name %q", et.Name)
+		}
+
+		seen[et.Name] = true
+		cfg.Types = append(cfg.Types, et)
+	}
+
+	cfg.Imports = splitList(imports)
+	return cfg, nil
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
+
+// typeName derives the name used in the generated identifiers from a type
+// expression, such as Int for int, User for *model.User and StringSlice for
+// []string.
+func typeName(t string) string {
+	suffix := ""
+	for {
+		switch {
+		case strings.HasPrefix(t, "*"):
+			t = t[1:]
+			suffix = "Ptr" + suffix
+			continue
+		case strings.HasPrefix(t, "[]"):
+			t = t[2:]
+			suffix = "Slice" + suffix
+			continue
+		}
+
+		break
+	}
+
+	if i := strings.LastIndex(t, "."); i >= 0 {
+		t = t[i+1:]
+	}
+
+	for _, r := range t {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			return ""
+		}
+	}
+
+	if t == "" {
+		return ""
+	}
+
+	return strings.ToUpper(t[:1]) + t[1:] + suffix
+}
+
+// generate returns the gofmt-ed source of the wrappers described by cfg.
+func generate(cfg config) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, cfg); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by linqgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"github.com/ahmetb/go-linq/v3"
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Types}}
+// From{{.Name}}Slice initializes a linq query with the passed slice of {{.Type}}
+// values without using reflection. Like a query created with linq.From, it
+// supports random access to the elements of the slice.
+func From{{.Name}}Slice(source []{{.Type}}) linq.Query {
+	return linq.NewRandomAccessQuery(len(source), func(i int) interface{} {
+		return source[i]
+	})
+}
+
+// Where{{.Name}} filters a collection of {{.Type}} values based on a predicate.
+func Where{{.Name}}(q linq.Query, predicate func({{.Type}}) bool) linq.Query {
+	return q.Where(func(item interface{}) bool {
+		return predicate(item.({{.Type}}))
+	})
+}
+
+// First{{.Name}} returns the first element of a collection of {{.Type}} values,
+// and whether the collection has any elements.
+func First{{.Name}}(q linq.Query) (result {{.Type}}, ok bool) {
+	item, ok := q.Iterate()()
+	if ok {
+		result = item.({{.Type}})
+	}
+
+	return
+}
+
+// ForEach{{.Name}} performs the specified action on each element of a
+// collection of {{.Type}} values.
+func ForEach{{.Name}}(q linq.Query, action func({{.Type}})) {
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		action(item.({{.Type}}))
+	}
+}
+
+// To{{.Name}}Slice iterates over a collection of {{.Type}} values and returns
+// its elements as a slice.
+func To{{.Name}}Slice(q linq.Query) []{{.Type}} {
+	var result []{{.Type}}
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		result = append(result, item.({{.Type}}))
+	}
+
+	return result
+}
+{{$from := .}}{{range $.Types}}
+// Select{{$from.Name}}To{{.Name}} projects each {{$from.Type}} element of a
+// collection to a value of type {{.Type}}.
+func Select{{$from.Name}}To{{.Name}}(q linq.Query, selector func({{$from.Type}}) {{.Type}}) linq.Query {
+	return q.Select(func(item interface{}) interface{} {
+		return selector(item.({{$from.Type}}))
+	})
+}
+{{end}}{{end}}`))
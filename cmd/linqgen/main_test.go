@@ -0,0 +1,124 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestTypeName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"int", "Int"},
+		{"string", "String"},
+		{"*model.User", "UserPtr"},
+		{"[]string", "StringSlice"},
+		{"[]*model.User", "UserPtrSlice"},
+		{"map[string]int", ""},
+		{"func()", ""},
+	}
+
+	for _, test := range tests {
+		if name := typeName(test.input); name != test.want {
+			t.Errorf("typeName(%q)=%q expected %q", test.input, name, test.want)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	cfg, err := newConfig("x", "int, User=*model.User", "example.com/model")
+	if err != nil {
+		t.Fatalf("newConfig()=%v expected nil", err)
+	}
+
+	want := []elemType{{"Int", "int"}, {"User", "*model.User"}}
+	if len(cfg.Types) != len(want) || cfg.Types[0] != want[0] || cfg.Types[1] != want[1] {
+		t.Errorf("newConfig().Types=%v expected %v", cfg.Types, want)
+	}
+
+	if len(cfg.Imports) != 1 || cfg.Imports[0] != "example.com/model" {
+		t.Errorf("newConfig().Imports=%v expected [example.com/model]", cfg.Imports)
+	}
+
+	for _, types := range []string{"int,int", "map[int]int", "=int"} {
+		if _, err := newConfig("x", types, ""); err == nil {
+			t.Errorf("newConfig(%q) expected an error", types)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	cfg, err := newConfig("x", "int,User=*model.User", "example.com/model")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := generate(cfg)
+	if err != nil {
+		t.Fatalf("generate()=%v expected nil", err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "linq_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("generate() produced invalid source: %v", err)
+	}
+
+	model, err := checkSource(fset, "example.com/model", "package model\n\ntype User struct{ Name string }\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imp := testImporter{importer.ForCompiler(fset, "source", nil), map[string]*types.Package{"example.com/model": model}}
+	if _, err := (&types.Config{Importer: imp}).Check("x", fset, []*ast.File{f}, nil); err != nil {
+		t.Errorf("generate() produced source that doesn't type-check: %v", err)
+	}
+
+	funcs := make(map[string]bool)
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			funcs[fn.Name.Name] = true
+		}
+	}
+
+	for _, name := range []string{
+		"FromIntSlice", "WhereInt", "FirstInt", "ForEachInt", "ToIntSlice",
+		"FromUserSlice", "WhereUser", "FirstUser", "ForEachUser", "ToUserSlice",
+		"SelectIntToInt", "SelectIntToUser", "SelectUserToInt", "SelectUserToUser",
+	} {
+		if !funcs[name] {
+			t.Errorf("generate() didn't generate %s", name)
+		}
+	}
+}
+
+// testImporter imports the packages of pkgs, and the other packages with
+// Importer.
+type testImporter struct {
+	types.Importer
+	pkgs map[string]*types.Package
+}
+
+func (i testImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := i.pkgs[path]; ok {
+		return pkg, nil
+	}
+
+	return i.Importer.Import(path)
+}
+
+// checkSource parses and type-checks the source of a package with the
+// specified import path that doesn't import other packages.
+func checkSource(fset *token.FileSet, path, src string) (*types.Package, error) {
+	f, err := parser.ParseFile(fset, path+".go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(types.Config).Check(path, fset, []*ast.File{f}, nil)
+}
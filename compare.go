@@ -1,9 +1,13 @@
 package linq
 
+import "time"
+
 type comparer func(interface{}, interface{}) int
 
 // Comparable is an interface that has to be implemented by a custom collection
-// elements in order to work with linq.
+// elements in order to work with linq. Elements that implement it can be used
+// with the methods that compare elements, such as Min, Max, OrderBy and ThenBy,
+// without a selector.
 //
 // Example:
 // 	func (f foo) CompareTo(c Comparable) int {
@@ -191,6 +195,30 @@ func getComparer(data interface{}) comparer {
 				return -1
 			}
 		}
+	case time.Time:
+		return func(x, y interface{}) int {
+			a, b := x.(time.Time), y.(time.Time)
+			switch {
+			case a.After(b):
+				return 1
+			case a.Before(b):
+				return -1
+			default:
+				return 0
+			}
+		}
+	case time.Duration:
+		return func(x, y interface{}) int {
+			a, b := x.(time.Duration), y.(time.Duration)
+			switch {
+			case a > b:
+				return 1
+			case b > a:
+				return -1
+			default:
+				return 0
+			}
+		}
 	default:
 		return func(x, y interface{}) int {
 			a, b := x.(Comparable), y.(Comparable)
@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestGetComparer(t *testing.T) {
 	tests := []struct {
@@ -55,6 +58,12 @@ func TestGetComparer(t *testing.T) {
 		{foo{f1: 1}, foo{f1: 5}, -1},
 		{foo{f1: 5}, foo{f1: 1}, 1},
 		{foo{f1: 1}, foo{f1: 1}, 0},
+		{time.Unix(100, 0), time.Unix(200, 0), -1},
+		{time.Unix(200, 0), time.Unix(100, 0), 1},
+		{time.Unix(100, 0).UTC(), time.Unix(100, 0).In(time.FixedZone("X", 3600)), 0},
+		{time.Second, time.Minute, -1},
+		{time.Minute, time.Second, 1},
+		{time.Second, time.Second, 0},
 	}
 
 	for _, test := range tests {
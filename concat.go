@@ -1,22 +1,32 @@
 package linq
 
-// Append inserts an item to the end of a collection, so it becomes the last
-// item.
-func (q Query) Append(item interface{}) Query {
+import "strconv"
+
+// Append inserts items to the end of a collection, so the last of them becomes
+// the last item.
+func (q Query) Append(items ...interface{}) Query {
+	items = append([]interface{}(nil), items...)
+
 	return Query{
+		desc:   q.chain("Append"),
+		length: addedLength(q, len(items)),
 		Iterate: func() Iterator {
 			next := q.Iterate()
-			appended := false
+			index := -1
 
 			return func() (interface{}, bool) {
-				i, ok := next()
-				if ok {
-					return i, ok
+				if index < 0 {
+					i, ok := next()
+					if ok {
+						return i, ok
+					}
+
+					index = 0
 				}
 
-				if !appended {
-					appended = true
-					return item, true
+				if index < len(items) {
+					index++
+					return items[index-1], true
 				}
 
 				return nil, false
@@ -32,6 +42,7 @@ func (q Query) Append(item interface{}) Query {
 // returns only unique elements.
 func (q Query) Concat(q2 Query) Query {
 	return Query{
+		desc: q.chain("Concat"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			next2 := q2.Iterate()
@@ -53,22 +64,92 @@ func (q Query) Concat(q2 Query) Query {
 	}
 }
 
-// Prepend inserts an item to the beginning of a collection, so it becomes the
-// first item.
-func (q Query) Prepend(item interface{}) Query {
+// Concat concatenates any number of collections into one.
+//
+// Unlike chaining the Concat method, which adds one level of nested iterators
+// per collection, Concat iterates over the collections from a flat list, so
+// the cost of producing an element doesn't grow with the number of
+// collections. Each collection is iterated only when the previous ones have
+// been exhausted.
+func Concat(queries ...Query) Query {
+	queries = append([]Query(nil), queries...)
+
+	q := Query{
+		desc: "Concat(" + strconv.Itoa(len(queries)) + ")",
+		Iterate: func() Iterator {
+			index := 0
+			var next Iterator
+
+			return func() (item interface{}, ok bool) {
+				for index < len(queries) {
+					if next == nil {
+						next = queries[index].Iterate()
+					}
+
+					if item, ok = next(); ok {
+						return
+					}
+
+					next = nil
+					index++
+				}
+
+				return
+			}
+		},
+	}
+
+	for _, query := range queries {
+		if query.length == nil {
+			return q
+		}
+	}
+
+	q.length = func() (n int) {
+		for _, query := range queries {
+			n += query.length()
+		}
+
+		return
+	}
+
+	return q
+}
+
+// Prepend inserts items to the beginning of a collection, so the first of them
+// becomes the first item.
+func (q Query) Prepend(items ...interface{}) Query {
+	items = append([]interface{}(nil), items...)
+
 	return Query{
+		desc:   q.chain("Prepend"),
+		length: addedLength(q, len(items)),
 		Iterate: func() Iterator {
-			next := q.Iterate()
-			prepended := false
+			var next Iterator
+			index := 0
 
 			return func() (interface{}, bool) {
-				if prepended {
-					return next()
+				if index < len(items) {
+					index++
+					return items[index-1], true
+				}
+
+				if next == nil {
+					next = q.Iterate()
 				}
 
-				prepended = true
-				return item, true
+				return next()
 			}
 		},
 	}
 }
+
+// addedLength returns the length function of a query built by adding n
+// elements to q, or nil if the length of q is unknown.
+func addedLength(q Query, n int) func() int {
+	if q.length == nil {
+		return nil
+	}
+
+	return func() int { return q.length() + n }
+}
@@ -9,6 +9,15 @@ func TestAppend(t *testing.T) {
 	if q := From(input).Append(5); !validateQuery(q, want) {
 		t.Errorf("From(%v).Append()=%v expected %v", input, toSlice(q), want)
 	}
+
+	want = []interface{}{1, 2, 3, 4, 5, 6, 7}
+	if q := From(input).Append(5, 6, 7); !validateQuery(q, want) || q.Count() != 7 {
+		t.Errorf("From(%v).Append(5, 6, 7)=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From(input).Append(); !validateQuery(q, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("From(%v).Append()=%v expected %v", input, toSlice(q), input)
+	}
 }
 
 func TestConcat(t *testing.T) {
@@ -21,6 +30,32 @@ func TestConcat(t *testing.T) {
 	}
 }
 
+func TestConcatMany(t *testing.T) {
+	tests := []struct {
+		input  []Query
+		output []interface{}
+	}{
+		{nil, []interface{}{}},
+		{[]Query{From([]int{1, 2}), Empty(), From([]int{3}), Range(4, 2)}, []interface{}{1, 2, 3, 4, 5}},
+		{[]Query{Empty(), Empty()}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := Concat(test.input...); !validateQuery(q, test.output) {
+			t.Errorf("Concat(%v)=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+
+	if c := Concat(From([]int{1, 2}), Range(3, 3)).Count(); c != 5 {
+		t.Errorf("Concat().Count()=%v expected 5", c)
+	}
+
+	where := From([]int{1, 2}).Where(func(interface{}) bool { return true })
+	if c := Concat(where, Range(3, 3)).Count(); c != 5 {
+		t.Errorf("Concat(Where()).Count()=%v expected 5", c)
+	}
+}
+
 func TestPrepend(t *testing.T) {
 	input := []int{1, 2, 3, 4}
 	want := []interface{}{0, 1, 2, 3, 4}
@@ -28,4 +63,9 @@ func TestPrepend(t *testing.T) {
 	if q := From(input).Prepend(0); !validateQuery(q, want) {
 		t.Errorf("From(%v).Prepend()=%v expected %v", input, toSlice(q), want)
 	}
+
+	want = []interface{}{-1, 0, 1, 2, 3, 4}
+	if q := From(input).Prepend(-1, 0); !validateQuery(q, want) || q.Count() != 6 {
+		t.Errorf("From(%v).Prepend(-1, 0)=%v expected %v", input, toSlice(q), want)
+	}
 }
@@ -1,5 +1,7 @@
 package linq
 
+import "time"
+
 type intConverter func(interface{}) int64
 
 func getIntConverter(data interface{}) intConverter {
@@ -20,6 +22,10 @@ func getIntConverter(data interface{}) intConverter {
 		return func(i interface{}) int64 {
 			return int64(i.(int32))
 		}
+	case (time.Duration):
+		return func(i interface{}) int64 {
+			return int64(i.(time.Duration))
+		}
 	}
 
 	return func(i interface{}) int64 {
@@ -68,3 +74,20 @@ func getFloatConverter(data interface{}) floatConverter {
 		return i.(float64)
 	}
 }
+
+func getNumericConverter(data interface{}) floatConverter {
+	switch data.(type) {
+	case int, int8, int16, int32, int64, time.Duration:
+		conv := getIntConverter(data)
+		return func(i interface{}) float64 {
+			return float64(conv(i))
+		}
+	case uint, uint8, uint16, uint32, uint64:
+		conv := getUIntConverter(data)
+		return func(i interface{}) float64 {
+			return float64(conv(i))
+		}
+	}
+
+	return getFloatConverter(data)
+}
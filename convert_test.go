@@ -1,6 +1,9 @@
 package linq
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestIntConverter(t *testing.T) {
 	tests := []struct {
@@ -12,6 +15,7 @@ func TestIntConverter(t *testing.T) {
 		{int16(0), 0},
 		{int32(10), 10},
 		{int64(5), 5},
+		{time.Duration(7), 7},
 	}
 
 	for _, test := range tests {
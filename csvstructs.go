@@ -0,0 +1,236 @@
+package linq
+
+import (
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// FromCSVStructs initializes a linq query that lazily reads CSV records from r
+// and decodes each of them into a new value of the struct type of prototype,
+// which is a struct or a pointer to struct. The elements of the query are
+// structs, or pointers to structs if prototype is a pointer.
+//
+// The first record is the header. Columns are mapped to the exported fields of
+// the struct like in ScanStructs: by the name in the struct tag with the
+// specified key, e.g. "csv", or by the Go name of fields without a tag name,
+// ignoring case if no name matches exactly. Columns without a matching field
+// are ignored.
+//
+// Fields of type string, bool, integer, float, time.Time (in RFC 3339 format)
+// and types implementing encoding.TextUnmarshaler are supported; pointers to
+// them are set to nil for empty values. The records written by ToCSVStructs can
+// be read back with FromCSVStructs.
+//
+// Like FromCSV, the query can be iterated only once. If reading or decoding a
+// record fails, the iterator panics with the error. FromCSVStructs panics if
+// prototype is not a struct or a pointer to struct.
+func FromCSVStructs(r io.Reader, prototype interface{}, tag string) Query {
+	t, pointer := structPrototype("FromCSVStructs", prototype)
+
+	reader := csv.NewReader(r)
+	var header []string
+	var indexes [][]int
+	records := 0
+
+	return Query{
+		desc: "FromCSVStructs",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				record, err := reader.Read()
+				if err == nil && header == nil {
+					header = record
+					indexes = make([][]int, len(header))
+					for i, column := range header {
+						indexes[i] = columnField(t, tag, column)
+					}
+
+					record, err = reader.Read()
+				}
+
+				if err == io.EOF {
+					return nil, false
+				}
+
+				if err != nil {
+					panic(err)
+				}
+
+				records++
+				v := reflect.New(t)
+				for i, index := range indexes {
+					if index == nil {
+						continue
+					}
+
+					if err := parseCSVValue(v.Elem().FieldByIndex(index), record[i]); err != nil {
+						panic(fmt.Errorf("linq: record %d, column %s: %v", records, header[i], err))
+					}
+				}
+
+				if pointer {
+					return v.Interface(), true
+				}
+
+				return v.Elem().Interface(), true
+			}
+		}),
+	}
+}
+
+// ToCSVStructs iterates over a collection of structs, or pointers to structs,
+// and writes them to w as CSV records with a header record. The columns are
+// the exported fields of the first element, named after the struct tag with
+// the specified key, e.g. "csv", or after the fields. Fields tagged with "-"
+// are skipped.
+//
+// Values are formatted so that FromCSVStructs parses them back: time.Time in
+// RFC 3339 format with nanoseconds, floats with the minimal number of digits,
+// types implementing encoding.TextMarshaler with MarshalText, nil pointers as
+// empty fields and any other value with fmt.Sprint.
+//
+// ToCSVStructs stops iterating and returns the error if an element is not a
+// struct or writing to w fails.
+func (q Query) ToCSVStructs(w io.Writer, tag string) error {
+	writer := csv.NewWriter(w)
+	next := q.Iterate()
+
+	item, ok := next()
+	if !ok {
+		return nil
+	}
+
+	t := reflect.Indirect(reflect.ValueOf(item)).Type()
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("linq: ToCSVStructs: element of type %T is not a struct", item)
+	}
+
+	fields := structFields(t, tag)
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.Name
+	}
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(fields))
+	for ; ok; item, ok = next() {
+		v := reflect.Indirect(reflect.ValueOf(item))
+		if v.Type() != t {
+			return fmt.Errorf("linq: ToCSVStructs: element of type %T is not a %v", item, t)
+		}
+
+		for i, f := range fields {
+			s, err := formatCSVValue(v.Field(f.Index))
+			if err != nil {
+				return err
+			}
+
+			record[i] = s
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// parseCSVValue parses s into the field v.
+func parseCSVValue(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Ptr {
+		if s == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	if reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err == nil {
+			v.Set(reflect.ValueOf(t))
+		}
+
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %v", v.Type())
+	}
+
+	return nil
+}
+
+// formatCSVValue formats the field v so that parseCSVValue parses it back.
+func formatCSVValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", nil
+		}
+
+		v = v.Elem()
+	}
+
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339Nano), nil
+	case encoding.TextMarshaler:
+		b, err := value.MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+
+	return fmt.Sprint(v.Interface()), nil
+}
@@ -0,0 +1,115 @@
+package linq
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type csvStructsTestRow struct {
+	ID      int64     `csv:"id"`
+	Name    string    `csv:"name"`
+	Score   float64   `csv:"score"`
+	Active  bool      `csv:"active"`
+	Created time.Time `csv:"created"`
+	IP      net.IP    `csv:"ip"`
+	Parent  *int      `csv:"parent"`
+	Secret  string    `csv:"-"`
+	Note    string
+}
+
+func TestCSVStructsRoundTrip(t *testing.T) {
+	parent := 7
+	rows := []csvStructsTestRow{
+		{1, "Ann, Jr.", 0.1, true, time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC), net.ParseIP("10.0.0.1"), &parent, "x", "first"},
+		{2, "Bob", -2.5e10, false, time.Date(2021, 6, 7, 8, 9, 10, 0, time.FixedZone("", 3600)), net.ParseIP("::1"), nil, "y", ""},
+	}
+
+	var buf bytes.Buffer
+	if err := From(rows).ToCSVStructs(&buf, "csv"); err != nil {
+		t.Fatalf("ToCSVStructs()=%v expected nil", err)
+	}
+
+	want := "id,name,score,active,created,ip,parent,Note\n" +
+		"1,\"Ann, Jr.\",0.1,true,2020-01-02T03:04:05.000000006Z,10.0.0.1,7,first\n" +
+		"2,Bob,-2.5e+10,false,2021-06-07T08:09:10+01:00,::1,,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("ToCSVStructs() wrote %q expected %q", got, want)
+	}
+
+	var got []csvStructsTestRow
+	FromCSVStructs(&buf, csvStructsTestRow{}, "csv").ToSlice(&got)
+
+	for i := range rows {
+		rows[i].Secret = ""
+	}
+
+	if len(got) != 2 || !got[0].Created.Equal(rows[0].Created) || !got[1].Created.Equal(rows[1].Created) {
+		t.Fatalf("FromCSVStructs()=%+v expected %+v", got, rows)
+	}
+
+	for i := range got {
+		got[i].Created = rows[i].Created
+	}
+
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("FromCSVStructs()=%+v expected %+v", got, rows)
+	}
+}
+
+func TestFromCSVStructs(t *testing.T) {
+	type row struct {
+		Name string `csv:"full_name"`
+		Age  int
+	}
+
+	input := "AGE,full_name,unknown\n30,Ann,x\n25,Bob,y\n"
+	q := FromCSVStructs(strings.NewReader(input), &row{}, "csv")
+
+	var got []*row
+	q.ToSlice(&got)
+
+	if len(got) != 2 || *got[0] != (row{"Ann", 30}) || *got[1] != (row{"Bob", 25}) {
+		t.Errorf("FromCSVStructs()=%v expected [&{Ann 30} &{Bob 25}]", got)
+	}
+}
+
+func TestFromCSVStructs_PanicWhenValueIsInvalid(t *testing.T) {
+	type row struct {
+		Age int
+	}
+
+	mustPanicWithError(t, `linq: record 2, column Age: strconv.ParseInt: parsing "old": invalid syntax`, func() {
+		FromCSVStructs(strings.NewReader("Age\n30\nold\n"), row{}, "").Results()
+	})
+}
+
+func TestFromCSVStructs_PanicWhenPrototypeIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "FromCSVStructs: parameter [prototype] has an invalid type. Expected: 'struct or pointer to struct', actual: '<nil>'", func() {
+		FromCSVStructs(strings.NewReader(""), nil, "")
+	})
+
+	mustPanicWithError(t, "FromCSVStructs: parameter [prototype] has an invalid type. Expected: 'struct or pointer to struct', actual: 'string'", func() {
+		FromCSVStructs(strings.NewReader("Age\n30\n"), "", "")
+	})
+}
+
+func TestToCSVStructs_ReturnsErrorWhenElementIsInvalid(t *testing.T) {
+	if err := From([]int{1}).ToCSVStructs(&bytes.Buffer{}, ""); err == nil || err.Error() != "linq: ToCSVStructs: element of type int is not a struct" {
+		t.Errorf("ToCSVStructs()=%v expected not a struct error", err)
+	}
+
+	type row struct{ A int }
+	type other struct{ B int }
+	err := From([]interface{}{row{1}, other{2}}).ToCSVStructs(&bytes.Buffer{}, "")
+	if err == nil || !strings.Contains(err.Error(), "is not a linq.row") {
+		t.Errorf("ToCSVStructs()=%v expected type mismatch error", err)
+	}
+
+	if err := From([]row{{1}}).ToCSVStructs(failingWriter{}, ""); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToCSVStructs()=%v expected write failed", err)
+	}
+}
@@ -0,0 +1,61 @@
+package linq
+
+// CumulativeSum returns a query with the running totals of a collection of
+// numeric values.
+//
+// Values can be of any integer, unsigned integer or float type. Following the
+// conventions of SumInts, SumUInts and SumFloats, the running totals are of
+// type int64, uint64 or float64 respectively.
+func (q Query) CumulativeSum() Query {
+	return q.Scan(nil, func(r interface{}, i interface{}) interface{} {
+		switch i.(type) {
+		case int, int8, int16, int32, int64:
+			v := getIntConverter(i)(i)
+			if r == nil {
+				return v
+			}
+
+			return r.(int64) + v
+		case uint, uint8, uint16, uint32, uint64:
+			v := getUIntConverter(i)(i)
+			if r == nil {
+				return v
+			}
+
+			return r.(uint64) + v
+		default:
+			v := getFloatConverter(i)(i)
+			if r == nil {
+				return v
+			}
+
+			return r.(float64) + v
+		}
+	})
+}
+
+// CumulativeMax returns a query with the running maximum of a collection of
+// values. Each element of the result is the maximum of all the source
+// elements seen so far.
+func (q Query) CumulativeMax() Query {
+	return q.Scan(nil, func(r interface{}, i interface{}) interface{} {
+		if r == nil || getComparer(i)(i, r) > 0 {
+			return i
+		}
+
+		return r
+	})
+}
+
+// CumulativeMin returns a query with the running minimum of a collection of
+// values. Each element of the result is the minimum of all the source
+// elements seen so far.
+func (q Query) CumulativeMin() Query {
+	return q.Scan(nil, func(r interface{}, i interface{}) interface{} {
+		if r == nil || getComparer(i)(i, r) < 0 {
+			return i
+		}
+
+		return r
+	})
+}
@@ -0,0 +1,56 @@
+package linq
+
+import "testing"
+
+func TestCumulativeSum(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4}, []interface{}{int64(1), int64(3), int64(6), int64(10)}},
+		{[]int8{-1, 2}, []interface{}{int64(-1), int64(1)}},
+		{[]uint{1, 2, 3}, []interface{}{uint64(1), uint64(3), uint64(6)}},
+		{[]float32{0.5, 1.5}, []interface{}{float64(0.5), float64(2)}},
+		{[]float64{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).CumulativeSum(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).CumulativeSum()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestCumulativeMax(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{3, 1, 4, 1, 5}, []interface{}{3, 3, 4, 4, 5}},
+		{[]string{"b", "a", "c"}, []interface{}{"b", "b", "c"}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).CumulativeMax(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).CumulativeMax()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestCumulativeMin(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{3, 1, 4, 0, 5}, []interface{}{3, 1, 1, 0, 0}},
+		{[]float64{2.5, 3.5, 1.5}, []interface{}{2.5, 2.5, 1.5}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).CumulativeMin(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).CumulativeMin()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package linq
+
+// Cycle returns a query that repeats the elements of a collection forever. The
+// elements are buffered while the collection is iterated for the first time,
+// so the source is iterated only once. Use Take or Zip to bound the result.
+//
+// If the collection contains no elements, the result is empty.
+func (q Query) Cycle() Query {
+	return Query{
+		desc: q.chain("Cycle"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var items []interface{}
+			buffered := false
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if !buffered {
+					item, ok = next()
+					if ok {
+						items = append(items, item)
+						return
+					}
+
+					buffered = true
+				}
+
+				if len(items) == 0 {
+					return nil, false
+				}
+
+				item, ok = items[index], true
+				index = (index + 1) % len(items)
+				return
+			}
+		},
+	}
+}
+
+// CycleSlice initializes a linq query that repeats the elements of passed
+// slice or array forever. Use Take or Zip to bound the result.
+//
+// If the slice contains no elements, the result is empty.
+func CycleSlice(source interface{}) Query {
+	return From(source).Cycle()
+}
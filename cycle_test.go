@@ -0,0 +1,32 @@
+package linq
+
+import "testing"
+
+func TestCycle(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 3}, []interface{}{1, 2, 3, 1, 2, 3, 1}},
+		{[]int{1}, []interface{}{1, 1, 1, 1, 1, 1, 1}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Cycle().Take(7); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Cycle()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestCycleSlice(t *testing.T) {
+	workers := []string{"a", "b"}
+	w := []interface{}{"1:a", "2:b", "3:a"}
+
+	q := Range(1, 3).Zip(CycleSlice(workers), func(i, j interface{}) interface{} {
+		return string(rune('0'+i.(int))) + ":" + j.(string)
+	})
+	if !validateQuery(q, w) {
+		t.Errorf("Range(1, 3).Zip(CycleSlice(%v))=%v expected %v", workers, toSlice(q), w)
+	}
+}
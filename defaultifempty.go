@@ -4,6 +4,7 @@ package linq
 // if the sequence is empty.
 func (q Query) DefaultIfEmpty(defaultValue interface{}) Query {
 	return Query{
+		desc: q.chain("DefaultIfEmpty"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			state := 1
@@ -0,0 +1,56 @@
+package linq
+
+import "math"
+
+// Summary is a type that is used to store the result of Describe method.
+type Summary struct {
+	Count  int
+	Sum    float64
+	Min    float64
+	Max    float64
+	Mean   float64
+	StdDev float64
+}
+
+// Describe computes the number of elements, sum, minimum, maximum, mean and
+// population standard deviation of a collection of numeric values in a single
+// iteration.
+//
+// Values can be of any integer, unsigned integer or float type, and are
+// converted to float64. The mean and variance are computed with Welford's
+// online algorithm. If collection contains no elements, Count and Sum are zero
+// and all other fields are NaN.
+func (q Query) Describe() (s Summary) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		nan := math.NaN()
+		return Summary{Min: nan, Max: nan, Mean: nan, StdDev: nan}
+	}
+
+	conv := getNumericConverter(item)
+	v := conv(item)
+	s = Summary{Count: 1, Sum: v, Min: v, Max: v, Mean: v}
+	var m2 float64
+
+	for item, ok = next(); ok; item, ok = next() {
+		v = conv(item)
+		s.Count++
+		s.Sum += v
+
+		if v < s.Min {
+			s.Min = v
+		}
+
+		if v > s.Max {
+			s.Max = v
+		}
+
+		delta := v - s.Mean
+		s.Mean += delta / float64(s.Count)
+		m2 += delta * (v - s.Mean)
+	}
+
+	s.StdDev = math.Sqrt(m2 / float64(s.Count))
+	return
+}
@@ -0,0 +1,30 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  Summary
+	}{
+		{[]int{2, 4, 4, 4, 5, 5, 7, 9}, Summary{Count: 8, Sum: 40, Min: 2, Max: 9, Mean: 5, StdDev: 2}},
+		{[]uint8{3}, Summary{Count: 1, Sum: 3, Min: 3, Max: 3, Mean: 3, StdDev: 0}},
+		{[]float64{-1.5, 1.5}, Summary{Count: 2, Sum: 0, Min: -1.5, Max: 1.5, Mean: 0, StdDev: 1.5}},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).Describe(); r != test.want {
+			t.Errorf("From(%v).Describe()=%+v expected %+v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestDescribeForEmpty(t *testing.T) {
+	r := From([]int{}).Describe()
+	if r.Count != 0 || r.Sum != 0 || !math.IsNaN(r.Min) || !math.IsNaN(r.Max) || !math.IsNaN(r.Mean) || !math.IsNaN(r.StdDev) {
+		t.Errorf("From([]).Describe()=%+v expected zero count and NaN statistics", r)
+	}
+}
@@ -0,0 +1,106 @@
+package linq
+
+// ChangeKind is the kind of a Change.
+type ChangeKind int
+
+const (
+	// Added is the kind of a change for an element that appears only in the
+	// new collection.
+	Added ChangeKind = iota
+	// Removed is the kind of a change for an element that appears only in
+	// the old collection.
+	Removed
+	// Changed is the kind of a change for an element whose key appears in
+	// both collections with elements that are not equal.
+	Changed
+	// Unchanged is the kind of a change for an element whose key appears in
+	// both collections with equal elements.
+	Unchanged
+)
+
+// Change is a type that is used to store the result of Diff function. Old is
+// nil for an Added change and New is nil for a Removed change.
+type Change struct {
+	Kind ChangeKind
+	Key  interface{}
+	Old  interface{}
+	New  interface{}
+}
+
+// Diff compares two versions of a collection whose elements are identified by
+// the key returned by keySelector, and produces a Change for every key: Added
+// for keys that appear only in after, Removed for keys that appear only in
+// before, and Changed or Unchanged for keys that appear in both, depending on
+// whether equal reports their elements as equal. If equal is nil, elements
+// are compared like in SequenceEqual.
+//
+// Changes for the keys of before come first, in the order of before, followed
+// by the Added changes in the order of after. Keys have to be comparable and
+// should be unique within each collection; if a key appears several times in
+// a collection, only its first element is used. after is buffered when the
+// first change is requested, while before is iterated lazily.
+func Diff(before, after Query,
+	keySelector func(interface{}) interface{},
+	equal func(interface{}, interface{}) bool) Query {
+	if equal == nil {
+		equal = equalItems
+	}
+
+	return Query{
+		desc: "Diff",
+		Iterate: func() Iterator {
+			var next Iterator
+			var afterKeys []interface{}
+			var afterItems map[interface{}]interface{}
+			seen := make(map[interface{}]bool)
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if next == nil {
+					afterItems = make(map[interface{}]interface{})
+
+					nextAfter := after.Iterate()
+					for item, ok := nextAfter(); ok; item, ok = nextAfter() {
+						key := keySelector(item)
+						if _, has := afterItems[key]; !has {
+							afterItems[key] = item
+							afterKeys = append(afterKeys, key)
+						}
+					}
+
+					next = before.Iterate()
+				}
+
+				for item, ok = next(); ok; item, ok = next() {
+					key := keySelector(item)
+					if seen[key] {
+						continue
+					}
+
+					seen[key] = true
+					newItem, has := afterItems[key]
+					if !has {
+						return Change{Kind: Removed, Key: key, Old: item}, true
+					}
+
+					kind := Changed
+					if equal(item, newItem) {
+						kind = Unchanged
+					}
+
+					return Change{Kind: kind, Key: key, Old: item, New: newItem}, true
+				}
+
+				for index < len(afterKeys) {
+					key := afterKeys[index]
+					index++
+					if !seen[key] {
+						return Change{Kind: Added, Key: key, New: afterItems[key]}, true
+					}
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package linq
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	before := []foo{{f1: 1, f3: "a"}, {f1: 2, f3: "b"}, {f1: 3, f3: "c"}, {f1: 1, f3: "z"}}
+	after := []foo{{f1: 4, f3: "d"}, {f1: 3, f3: "c"}, {f1: 2, f3: "B"}, {f1: 4, f3: "z"}}
+	key := func(i interface{}) interface{} { return i.(foo).f1 }
+
+	want := []interface{}{
+		Change{Kind: Removed, Key: 1, Old: before[0]},
+		Change{Kind: Changed, Key: 2, Old: before[1], New: after[2]},
+		Change{Kind: Unchanged, Key: 3, Old: before[2], New: after[1]},
+		Change{Kind: Added, Key: 4, New: after[0]},
+	}
+
+	if q := Diff(From(before), From(after), key, nil); !validateQuery(q, want) {
+		t.Errorf("Diff(%v, %v)=%v expected %v", before, after, toSlice(q), want)
+	}
+
+	foldCase := func(a, b interface{}) bool {
+		return a.(foo).f3 == b.(foo).f3 || a.(foo).f3 == "b" && b.(foo).f3 == "B"
+	}
+
+	want[1] = Change{Kind: Unchanged, Key: 2, Old: before[1], New: after[2]}
+	if q := Diff(From(before), From(after), key, foldCase); !validateQuery(q, want) {
+		t.Errorf("Diff(%v, %v)=%v expected %v", before, after, toSlice(q), want)
+	}
+
+	if q := Diff(Empty(), Empty(), key, nil); !validateQuery(q, []interface{}{}) {
+		t.Errorf("Diff(Empty(), Empty())=%v expected []", toSlice(q))
+	}
+}
@@ -2,11 +2,20 @@ package linq
 
 // Distinct method returns distinct elements from a collection. The result is an
 // unordered collection that contains no duplicate values.
+//
+// If the query has a FloatTolerance set with WithFloatTolerance, a float
+// element is dropped if it differs by at most the tolerance from a float
+// element returned before.
 func (q Query) Distinct() Query {
+	if eps := q.floatTolerance(); eps > 0 {
+		return q.distinctWithin(eps).elementsOf(q)
+	}
+
 	return Query{
+		desc: q.chain("Distinct"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
-			set := make(map[interface{}]bool)
+			set := make(map[interface{}]bool, q.capacity())
 
 			return func() (item interface{}, ok bool) {
 				for item, ok = next(); ok; item, ok = next() {
@@ -19,7 +28,7 @@ func (q Query) Distinct() Query {
 				return
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // Distinct method returns distinct elements from a collection. The result is an
@@ -31,6 +40,7 @@ func (oq OrderedQuery) Distinct() OrderedQuery {
 	return OrderedQuery{
 		orders: oq.orders,
 		Query: Query{
+			desc: oq.chain("Distinct"),
 			Iterate: func() Iterator {
 				next := oq.Iterate()
 				var prev interface{}
@@ -55,9 +65,10 @@ func (oq OrderedQuery) Distinct() OrderedQuery {
 // The result is an unordered collection that contains no duplicate values.
 func (q Query) DistinctBy(selector func(interface{}) interface{}) Query {
 	return Query{
+		desc: q.chain("DistinctBy"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
-			set := make(map[interface{}]bool)
+			set := make(map[interface{}]bool, q.capacity())
 
 			return func() (item interface{}, ok bool) {
 				for item, ok = next(); ok; item, ok = next() {
@@ -0,0 +1,130 @@
+package linq
+
+import (
+	"container/list"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// DistinctWithin returns the elements of a collection without the ones that
+// are equal to one of the window most recently seen distinct elements. Unlike
+// Distinct, which remembers every element it has returned, DistinctWithin
+// keeps only window elements in a least recently used cache, so it can
+// deduplicate infinite channel-backed streams with bounded memory. An element
+// that has been evicted from the cache is returned again when it reappears.
+//
+// Both returned and dropped elements count as seen, so an element that keeps
+// reappearing within the window is returned only once. Elements have to be
+// comparable. DistinctWithin panics if window is not positive.
+func (q Query) DistinctWithin(window int) Query {
+	if window <= 0 {
+		panic(errors.New("DistinctWithin: non-positive window"))
+	}
+
+	return Query{
+		desc: q.chain("DistinctWithin(" + strconv.Itoa(window) + ")"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			cache := newSeenCache()
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					seen := cache.see(item, time.Time{})
+					if cache.order.Len() > window {
+						cache.evictOldest()
+					}
+
+					if !seen {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// DistinctTTL returns the elements of a collection without the ones that are
+// equal to an element seen less than d ago. Like DistinctWithin, it forgets
+// elements that have not been seen for d, so it can deduplicate infinite
+// channel-backed streams with memory bounded by the number of distinct
+// elements seen within d.
+//
+// Both returned and dropped elements count as seen, so an element that keeps
+// reappearing more often than every d is returned only once. Elements have to
+// be comparable. DistinctTTL panics if d is not positive.
+func (q Query) DistinctTTL(d time.Duration) Query {
+	if d <= 0 {
+		panic(errors.New("DistinctTTL: non-positive duration"))
+	}
+
+	return q.distinctTTL(d, time.Now).describe(q.chain("DistinctTTL"))
+}
+
+// distinctTTL is DistinctTTL with the clock returning the current time.
+func (q Query) distinctTTL(d time.Duration, now func() time.Time) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			cache := newSeenCache()
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					t := now()
+					for cache.order.Len() > 0 && t.Sub(cache.oldest().at) >= d {
+						cache.evictOldest()
+					}
+
+					if !cache.see(item, t) {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// seenCache is a least recently used cache of elements.
+type seenCache struct {
+	order   *list.List
+	entries map[interface{}]*list.Element
+}
+
+// seenEntry is an element of a seenCache with the time it was last seen.
+type seenEntry struct {
+	item interface{}
+	at   time.Time
+}
+
+func newSeenCache() *seenCache {
+	return &seenCache{order: list.New(), entries: make(map[interface{}]*list.Element)}
+}
+
+// see marks item as the most recently seen element, at time t, and reports
+// whether it was already in the cache.
+func (c *seenCache) see(item interface{}, t time.Time) bool {
+	if e, has := c.entries[item]; has {
+		e.Value.(*seenEntry).at = t
+		c.order.MoveToFront(e)
+		return true
+	}
+
+	c.entries[item] = c.order.PushFront(&seenEntry{item: item, at: t})
+	return false
+}
+
+// oldest returns the least recently seen element of a non-empty cache.
+func (c *seenCache) oldest() *seenEntry {
+	return c.order.Back().Value.(*seenEntry)
+}
+
+// evictOldest removes the least recently seen element from a non-empty cache.
+func (c *seenCache) evictOldest() {
+	e := c.order.Back()
+	c.order.Remove(e)
+	delete(c.entries, e.Value.(*seenEntry).item)
+}
@@ -0,0 +1,57 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDistinctWithin(t *testing.T) {
+	tests := []struct {
+		input  []int
+		window int
+		want   []interface{}
+	}{
+		{[]int{1, 2, 1, 3, 1, 4, 2}, 2, []interface{}{1, 2, 3, 4, 2}},
+		{[]int{1, 2, 3, 1, 2, 3}, 2, []interface{}{1, 2, 3, 1, 2, 3}},
+		{[]int{1, 2, 3, 1, 2, 3}, 3, []interface{}{1, 2, 3}},
+		{[]int{1, 1, 2, 2, 1}, 1, []interface{}{1, 2, 1}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).DistinctWithin(test.window); !validateQuery(q, test.want) {
+			t.Errorf("From(%v).DistinctWithin(%d)=%v expected %v", test.input, test.window, toSlice(q), test.want)
+		}
+	}
+}
+
+func TestDistinctTTL(t *testing.T) {
+	input := []string{"a", "b", "a", "a", "b", "a"}
+	times := []int{0, 1, 5, 12, 12, 30}
+
+	i := 0
+	now := func() time.Time {
+		t := time.Unix(int64(times[i]), 0)
+		i++
+		return t
+	}
+
+	// a is seen at 0, 5 and 12, so it is dropped until it is not seen for 10s.
+	want := []interface{}{"a", "b", "b", "a"}
+	if q := From(input).distinctTTL(10*time.Second, now); !validateQuery(q, want) {
+		t.Errorf("From(%v).distinctTTL(10s)=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := Range(1, 3).Concat(Range(1, 3)).DistinctTTL(time.Hour); !validateQuery(q, []interface{}{1, 2, 3}) {
+		t.Errorf("DistinctTTL(1h)=%v expected [1 2 3]", toSlice(q))
+	}
+}
+
+func TestDistinctWithin_PanicWhenWindowIsNotPositive(t *testing.T) {
+	mustPanicWithError(t, "DistinctWithin: non-positive window", func() {
+		Range(1, 3).DistinctWithin(0)
+	})
+
+	mustPanicWithError(t, "DistinctTTL: non-positive duration", func() {
+		Range(1, 3).DistinctTTL(0)
+	})
+}
@@ -0,0 +1,90 @@
+package linq
+
+import (
+	"errors"
+	"strconv"
+)
+
+// InsertAt inserts an item into a collection at the specified zero-based
+// index, so it comes right before the element that was at that index. If the
+// collection contains index elements or less, the item is appended to the end
+// of the collection.
+//
+// InsertAt panics if index is negative.
+func (q Query) InsertAt(index int, item interface{}) Query {
+	if index < 0 {
+		panic(errors.New("InsertAt: negative index"))
+	}
+
+	return Query{
+		desc:   q.chain("InsertAt(" + strconv.Itoa(index) + ")"),
+		length: addedLength(q, 1),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			position := 0
+			inserted := false
+
+			return func() (interface{}, bool) {
+				if !inserted && position == index {
+					inserted = true
+					return item, true
+				}
+
+				i, ok := next()
+				if ok {
+					position++
+					return i, ok
+				}
+
+				if !inserted {
+					inserted = true
+					return item, true
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// RemoveAt removes the element at the specified zero-based index from a
+// collection. If the collection contains index elements or less, it is
+// returned unchanged.
+//
+// RemoveAt panics if index is negative.
+func (q Query) RemoveAt(index int) Query {
+	if index < 0 {
+		panic(errors.New("RemoveAt: negative index"))
+	}
+
+	var length func() int
+	if q.length != nil {
+		length = func() int {
+			n := q.length()
+			if n > index {
+				return n - 1
+			}
+
+			return n
+		}
+	}
+
+	return Query{
+		desc:   q.chain("RemoveAt(" + strconv.Itoa(index) + ")"),
+		length: length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			position := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok && position == index {
+					item, ok = next()
+				}
+
+				position++
+				return
+			}
+		},
+	}
+}
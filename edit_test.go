@@ -0,0 +1,57 @@
+package linq
+
+import "testing"
+
+func TestInsertAt(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	tests := []struct {
+		index int
+		want  []interface{}
+	}{
+		{0, []interface{}{0, 1, 2, 3}},
+		{1, []interface{}{1, 0, 2, 3}},
+		{3, []interface{}{1, 2, 3, 0}},
+		{10, []interface{}{1, 2, 3, 0}},
+	}
+
+	for _, test := range tests {
+		if q := From(input).InsertAt(test.index, 0); !validateQuery(q, test.want) || q.Count() != 4 {
+			t.Errorf("From(%v).InsertAt(%d)=%v expected %v", input, test.index, toSlice(q), test.want)
+		}
+	}
+
+	if q := Empty().InsertAt(2, 0); !validateQuery(q, []interface{}{0}) {
+		t.Errorf("Empty().InsertAt(2)=%v expected [0]", toSlice(q))
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	input := []int{1, 2, 3}
+
+	tests := []struct {
+		index int
+		want  []interface{}
+	}{
+		{0, []interface{}{2, 3}},
+		{1, []interface{}{1, 3}},
+		{2, []interface{}{1, 2}},
+		{3, []interface{}{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		if q := From(input).RemoveAt(test.index); !validateQuery(q, test.want) || q.Count() != len(test.want) {
+			t.Errorf("From(%v).RemoveAt(%d)=%v expected %v", input, test.index, toSlice(q), test.want)
+		}
+	}
+}
+
+func TestInsertAtRemoveAt_PanicWhenIndexIsNegative(t *testing.T) {
+	mustPanicWithError(t, "InsertAt: negative index", func() {
+		Range(1, 3).InsertAt(-1, 0)
+	})
+
+	mustPanicWithError(t, "RemoveAt: negative index", func() {
+		Range(1, 3).RemoveAt(-1)
+	})
+}
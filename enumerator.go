@@ -0,0 +1,56 @@
+package linq
+
+// Enumerator is an alternative, interface-based iteration protocol. Custom
+// sources can implement it with a struct that keeps the iteration state in its
+// fields, and use FromEnumerator to plug it into linq.
+type Enumerator interface {
+	// Next returns the next element, and false when there are no more
+	// elements.
+	Next() (item interface{}, ok bool)
+}
+
+// iteratorEnumerator adapts an Iterator to the Enumerator interface.
+type iteratorEnumerator struct {
+	next Iterator
+}
+
+func (e iteratorEnumerator) Next() (interface{}, bool) {
+	return e.next()
+}
+
+// Enumerator starts a new iteration over a collection and returns it as an
+// Enumerator. Where, Select and Take are implemented as enumerators, so the
+// enumerator of a query built with them is returned without wrapping.
+func (q Query) Enumerator() Enumerator {
+	return q.enumerator()
+}
+
+// enumerator starts a new iteration over q as an Enumerator, using the
+// enumerators of the operators that built q if they are implemented as
+// structs.
+func (q Query) enumerator() Enumerator {
+	if q.enumerate != nil {
+		return q.enumerate()
+	}
+
+	return iteratorEnumerator{q.Iterate()}
+}
+
+// FromEnumerator initializes a linq query with a source that implements
+// Enumerator interface. Function newEnumerator is executed each time the query
+// is iterated and must return an Enumerator positioned before the first
+// element.
+func FromEnumerator(newEnumerator func() Enumerator) Query {
+	return Query{
+		desc:      "FromEnumerator",
+		enumerate: newEnumerator,
+		Iterate: func() Iterator {
+			e := newEnumerator()
+			if ie, ok := e.(iteratorEnumerator); ok {
+				return ie.next
+			}
+
+			return e.Next
+		},
+	}
+}
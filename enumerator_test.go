@@ -0,0 +1,76 @@
+package linq
+
+import (
+	"context"
+	"testing"
+)
+
+type countdown struct {
+	n int
+}
+
+func (c *countdown) Next() (item interface{}, ok bool) {
+	if c.n <= 0 {
+		return nil, false
+	}
+
+	item, ok = c.n, true
+	c.n--
+	return
+}
+
+func TestFromEnumerator(t *testing.T) {
+	q := FromEnumerator(func() Enumerator {
+		return &countdown{3}
+	})
+
+	w := []interface{}{3, 2, 1}
+	if !validateQuery(q, w) || !validateQuery(q, w) {
+		t.Errorf("FromEnumerator()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestEnumerator(t *testing.T) {
+	e := From([]int{1, 2}).Enumerator()
+
+	for _, want := range []interface{}{1, 2} {
+		if item, ok := e.Next(); !ok || item != want {
+			t.Errorf("Enumerator().Next()=%v,%v expected %v,true", item, ok, want)
+		}
+	}
+
+	if item, ok := e.Next(); ok {
+		t.Errorf("Enumerator().Next()=%v,%v expected nil,false", item, ok)
+	}
+
+	w := []interface{}{1, 2}
+	q := From(w)
+	if r := FromEnumerator(q.Enumerator); !validateQuery(r, w) {
+		t.Errorf("FromEnumerator(q.Enumerator)=%v expected %v", toSlice(r), w)
+	}
+}
+
+func TestEnumeratorOfStructOperators(t *testing.T) {
+	q := Range(1, 10).Where(func(i interface{}) bool {
+		return i.(int)%2 == 0
+	}).Select(func(i interface{}) interface{} {
+		return i.(int) * 10
+	}).Take(3)
+
+	if _, ok := q.Enumerator().(*takeEnumerator); !ok {
+		t.Errorf("Take().Enumerator() is %T expected *takeEnumerator", q.Enumerator())
+	}
+
+	w := []interface{}{20, 40, 60}
+	if !validateQuery(q, w) || !validateQuery(FromEnumerator(q.Enumerator), w) {
+		t.Errorf("Range().Where().Select().Take()=%v expected %v", toSlice(q), w)
+	}
+
+	// Operators built on a query whose iteration is replaced must not use the
+	// enumerators of the operators before it.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if n := q.WithOptions(Options{Context: ctx}).Where(func(interface{}) bool { return true }).Count(); n != 0 {
+		t.Errorf("WithOptions(canceled).Where().Count()=%d expected 0", n)
+	}
+}
@@ -0,0 +1,43 @@
+package linq
+
+import "errors"
+
+// ErrOverflow is returned by checked aggregation methods, such as
+// SumIntsChecked, when the result does not fit into the result type.
+var ErrOverflow = errors.New("linq: integer overflow")
+
+// ErrNonFinite is returned by the Finite variants of float aggregation
+// methods, such as AverageFinite, when the collection contains a NaN or an
+// infinite value.
+var ErrNonFinite = errors.New("linq: non-finite float value")
+
+// ErrNotNumeric is returned by methods that accept elements of mixed numeric
+// types, such as SumNumeric, when an element is not a number.
+var ErrNotNumeric = errors.New("linq: non-numeric value")
+
+// ErrEmpty is returned by strict element methods, such as SingleStrict, when
+// the collection contains no matching elements.
+var ErrEmpty = errors.New("linq: no elements")
+
+// ErrMoreThanOne is returned by strict element methods, such as SingleStrict,
+// when the collection contains more than one matching element.
+var ErrMoreThanOne = errors.New("linq: more than one element")
+
+// ErrChannelFull is returned by ToChannelWithOverflow with the OverflowError
+// policy when an element can not be sent because the channel is full.
+var ErrChannelFull = errors.New("linq: channel is full")
+
+// ErrInvalidPrecision is returned by approximate methods, such as
+// CountDistinctApprox, when the requested precision is out of range.
+var ErrInvalidPrecision = errors.New("linq: precision out of range")
+
+// ErrSourceExhausted is the error the iterator of a query created from a
+// single-use source, such as a channel or an io.Reader, panics with when the
+// query is iterated again after an iteration reached its end, instead of
+// silently returning no elements.
+var ErrSourceExhausted = errors.New("linq: single-use source iterated again after it was exhausted")
+
+// ErrNotResumable is returned by IterateFrom when the query can't resume an
+// iteration from a position token, because neither its source nor one of its
+// operators supports it.
+var ErrNotResumable = errors.New("linq: query is not resumable")
@@ -2625,3 +2625,52 @@ func ExampleFromChannelT() {
 	// Output:
 	// [one two three]
 }
+
+// The following code example demonstrates how to use Scan
+// to compute a running total.
+func ExampleQuery_Scan() {
+	deposits := []int{100, 20, -50, 30}
+
+	var balances []int
+	From(deposits).
+		Scan(0, func(balance interface{}, deposit interface{}) interface{} {
+			return balance.(int) + deposit.(int)
+		}).
+		ToSlice(&balances)
+
+	fmt.Println(balances)
+	// Output:
+	// [100 120 70 100]
+}
+
+// intStack is a custom collection that implements Iterable interface and
+// yields its items from the top of the stack.
+type intStack []int
+
+func (s intStack) Iterate() Iterator {
+	index := len(s) - 1
+
+	return func() (item interface{}, ok bool) {
+		if index < 0 {
+			return nil, false
+		}
+
+		item, ok = s[index], true
+		index--
+		return
+	}
+}
+
+// The following code example demonstrates how to use FromIterable
+// to query a custom collection.
+func ExampleFromIterable() {
+	stack := intStack{1, 2, 3, 4, 5}
+
+	evens := FromIterable(stack).
+		WhereT(func(i int) bool { return i%2 == 0 }).
+		Results()
+
+	fmt.Println(evens)
+	// Output:
+	// [4 2]
+}
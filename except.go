@@ -1,9 +1,12 @@
 package linq
 
+import "errors"
+
 // Except produces the set difference of two sequences. The set difference is
 // the members of the first sequence that don't appear in the second sequence.
 func (q Query) Except(q2 Query) Query {
 	return Query{
+		desc: q.chain("Except"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 
@@ -26,12 +29,67 @@ func (q Query) Except(q2 Query) Query {
 	}
 }
 
+// ExceptApprox produces the approximate set difference of two sequences: the
+// members of the first sequence that don't appear in the second sequence,
+// except for a fraction of them that are dropped as false positives.
+//
+// Unlike Except, which keeps the elements of the second sequence in a hash
+// set, ExceptApprox adds them to a Bloom filter sized for expectedN elements
+// with a false-positive rate of fpRate, so filtering against hundreds of
+// millions of keys takes about 1.44*log2(1/fpRate) bits per key, such as 1.2
+// bytes for a rate of 1%. Elements that appear in the second sequence are
+// always removed, and each other element is removed with a probability of
+// about fpRate, which grows if the second sequence contains more than
+// expectedN elements. Elements are told apart by value like with ==, through a
+// 64-bit hash of their value.
+//
+// The second sequence is iterated when the first element is requested.
+// ExceptApprox panics if expectedN is not positive or fpRate is not between 0
+// and 1.
+func (q Query) ExceptApprox(q2 Query, expectedN int, fpRate float64) Query {
+	if expectedN <= 0 {
+		panic(errors.New("ExceptApprox: non-positive expected count"))
+	}
+
+	if !(fpRate > 0 && fpRate < 1) {
+		panic(errors.New("ExceptApprox: false-positive rate out of range"))
+	}
+
+	return Query{
+		desc: q.chain("ExceptApprox"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var filter *bloomFilter
+
+			return func() (item interface{}, ok bool) {
+				if filter == nil {
+					filter = newBloomFilter(expectedN, fpRate)
+
+					next2 := q2.Iterate()
+					for i, ok := next2(); ok; i, ok = next2() {
+						filter.add(hashItem(i))
+					}
+				}
+
+				for item, ok = next(); ok; item, ok = next() {
+					if !filter.contains(hashItem(item)) {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
 // ExceptBy invokes a transform function on each element of a collection and
 // produces the set difference of two sequences. The set difference is the
 // members of the first sequence that don't appear in the second sequence.
 func (q Query) ExceptBy(q2 Query,
 	selector func(interface{}) interface{}) Query {
 	return Query{
+		desc: q.chain("ExceptBy"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 
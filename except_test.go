@@ -29,3 +29,34 @@ func TestExceptByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).ExceptByT(From([]int{1}), func(x, item int) int { return item + 2 })
 	})
 }
+
+func TestExceptApprox(t *testing.T) {
+	input1 := []int{1, 2, 3, 4, 5, 1, 2, 5}
+	input2 := []int{1, 2}
+	want := []interface{}{3, 4, 5, 5}
+
+	if q := From(input1).ExceptApprox(From(input2), 100, 0.001); !validateQuery(q, want) {
+		t.Errorf("From(%v).ExceptApprox(%v)=%v expected %v", input1, input2, toSlice(q), want)
+	}
+
+	excluded := Range(0, 10000).Select(func(i interface{}) interface{} { return i.(int) * 2 })
+	r := Range(0, 20000).ExceptApprox(excluded, 10000, 0.01).Results()
+
+	if !From(r).All(func(i interface{}) bool { return i.(int)%2 == 1 }) {
+		t.Errorf("ExceptApprox() kept an excluded element")
+	}
+
+	if kept := len(r); kept > 10000 || kept < 9700 {
+		t.Errorf("ExceptApprox() kept %d of 10000 elements expected about 9900", kept)
+	}
+}
+
+func TestExceptApprox_PanicWhenParametersAreInvalid(t *testing.T) {
+	mustPanicWithError(t, "ExceptApprox: non-positive expected count", func() {
+		Range(1, 3).ExceptApprox(Empty(), 0, 0.01)
+	})
+
+	mustPanicWithError(t, "ExceptApprox: false-positive rate out of range", func() {
+		Range(1, 3).ExceptApprox(Empty(), 10, 1)
+	})
+}
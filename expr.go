@@ -0,0 +1,415 @@
+package linq
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// CompilePredicate compiles a filter expression into a predicate that can be
+// passed to Where. It returns an error if the expression is invalid, so that
+// expressions provided by users, for example in HTTP query parameters, can be
+// validated before they are used.
+//
+// An expression compares fields of the elements to literal values, such as
+//
+//	age > 18 && country == 'DE'
+//
+// and consists of:
+//
+//   - comparisons of a field to a literal with the operators of WhereField:
+//     ==, !=, <, <=, >, >=, contains and in;
+//   - names of bool fields alone, which match if the field is true;
+//   - string literals in single or double quotes, numbers, true, false, null
+//     and lists of literals in square brackets, e.g. [1, 2, 3];
+//   - the logical operators &&, || and !, and parentheses.
+//
+// Fields are resolved as in SelectField. A struct field can also be named by
+// its json tag or by its name in any letter case with or without underscores,
+// so created_at refers to a field CreatedAt.
+func CompilePredicate(expr string) (func(interface{}) bool, error) {
+	p := &exprParser{expr: expr}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	predicate, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != exprEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+
+	return predicate, nil
+}
+
+// WhereExpr filters a collection of records with a filter expression, which is
+// compiled once when WhereExpr is called. See CompilePredicate for the syntax
+// of expressions. WhereExpr panics if the expression is invalid; use
+// CompilePredicate and Where to handle the error instead.
+func (q Query) WhereExpr(expr string) Query {
+	predicate, err := CompilePredicate(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return q.Where(predicate).describe(q.chain("WhereExpr"))
+}
+
+// OrderByExpr sorts a collection of records by the fields listed in spec,
+// separated by commas, such as "-created_at, name". A field prefixed with -
+// sorts in descending order, otherwise in ascending order. Fields are resolved
+// as in CompilePredicate and their values have to be of a basic type or
+// implement Comparable.
+//
+// OrderByExpr panics if spec doesn't list any field.
+func (q Query) OrderByExpr(spec string) OrderedQuery {
+	var oq OrderedQuery
+	for i, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		desc := strings.HasPrefix(part, "-")
+		name := strings.TrimSpace(strings.TrimLeft(part, "+-"))
+		if name == "" {
+			panic(fmt.Errorf("OrderByExpr: parameter [spec] has an invalid value. Expected: comma-separated field names, actual: '%s'", spec))
+		}
+
+		selector := func(item interface{}) interface{} {
+			value, _ := exprFieldValue(item, name)
+			return value
+		}
+
+		switch {
+		case i == 0 && desc:
+			oq = q.OrderByDescending(selector)
+		case i == 0:
+			oq = q.OrderBy(selector)
+		case desc:
+			oq = oq.ThenByDescending(selector)
+		default:
+			oq = oq.ThenBy(selector)
+		}
+	}
+
+	oq.Query = oq.Query.describe(q.chain("OrderByExpr"))
+	return oq
+}
+
+// exprFieldIndexes caches the index sequences of struct fields resolved by
+// exprFieldValue.
+var exprFieldIndexes sync.Map
+
+// exprFieldValue returns the value of the field or map entry with the
+// specified name of item like fieldValue, but also resolves struct fields by
+// their json tag or by their name in any letter case, ignoring underscores.
+func exprFieldValue(item interface{}, name string) (interface{}, bool) {
+	if value, ok := fieldValue(item, name); ok {
+		return value, true
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	key := fieldKey{v.Type(), name}
+	resolved, ok := exprFieldIndexes.Load(key)
+	if !ok {
+		resolved = resolveExprField(v.Type(), name)
+		exprFieldIndexes.Store(key, resolved)
+	}
+
+	if resolved.(string) == "" {
+		return nil, false
+	}
+
+	return fieldValue(item, resolved.(string))
+}
+
+// resolveExprField returns the name of the exported field of struct type t
+// that name refers to in an expression, or an empty string.
+func resolveExprField(t reflect.Type, name string) string {
+	for _, f := range structFields(t, "json") {
+		if f.Name == name {
+			return t.Field(f.Index).Name
+		}
+	}
+
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Replace(s, "_", "", -1))
+	}
+
+	n := normalize(name)
+	f, ok := t.FieldByNameFunc(func(s string) bool { return normalize(s) == n })
+	if !ok || f.PkgPath != "" {
+		return ""
+	}
+
+	return f.Name
+}
+
+type exprTokenKind int
+
+const (
+	exprEOF exprTokenKind = iota
+	exprIdent
+	exprString
+	exprNumber
+	exprOp
+	exprPunct
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// exprParser is a recursive descent parser of filter expressions.
+type exprParser struct {
+	expr   string
+	tokens []exprToken
+	next   int
+}
+
+func (p *exprParser) errorf(t exprToken, format string, args ...interface{}) error {
+	return fmt.Errorf("linq: invalid expression at position %d: %s", t.pos+1, fmt.Sprintf(format, args...))
+}
+
+// tokenize splits the expression into tokens.
+func (p *exprParser) tokenize() error {
+	s := p.expr
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '\'' || c == '"':
+			j := i + 1
+			var b strings.Builder
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+
+				b.WriteByte(s[j])
+			}
+
+			if j >= len(s) {
+				return p.errorf(exprToken{pos: i}, "unterminated string")
+			}
+
+			p.tokens = append(p.tokens, exprToken{exprString, b.String(), i})
+			i = j + 1
+		case c == '-' || c == '.' || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '.' || s[j] == 'e' || s[j] == 'E' || unicode.IsDigit(rune(s[j])) ||
+				((s[j] == '-' || s[j] == '+') && (s[j-1] == 'e' || s[j-1] == 'E'))) {
+				j++
+			}
+
+			p.tokens = append(p.tokens, exprToken{exprNumber, s[i:j], i})
+			i = j
+		case c == '_' || unicode.IsLetter(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || s[j] == '.' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+
+			word := s[i:j]
+			kind := exprIdent
+			if word == "contains" || word == "in" {
+				kind = exprOp
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind, word, i})
+			i = j
+		default:
+			var op string
+			for _, o := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ","} {
+				if strings.HasPrefix(s[i:], o) {
+					op = o
+					break
+				}
+			}
+
+			if op == "" {
+				return p.errorf(exprToken{pos: i}, "unexpected character %q", c)
+			}
+
+			kind := exprPunct
+			switch op {
+			case "==", "!=", "<=", ">=", "<", ">":
+				kind = exprOp
+			}
+
+			p.tokens = append(p.tokens, exprToken{kind, op, i})
+			i += len(op)
+		}
+	}
+
+	return nil
+}
+
+func (p *exprParser) peek() exprToken {
+	if p.next < len(p.tokens) {
+		return p.tokens[p.next]
+	}
+
+	return exprToken{kind: exprEOF, text: "end of expression", pos: len(p.expr)}
+}
+
+func (p *exprParser) accept(text string) bool {
+	if t := p.peek(); t.kind != exprString && t.text == text {
+		p.next++
+		return true
+	}
+
+	return false
+}
+
+func (p *exprParser) parseOr() (func(interface{}) bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(item interface{}) bool { return l(item) || right(item) }
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (func(interface{}) bool, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		l := left
+		left = func(item interface{}) bool { return l(item) && right(item) }
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (func(interface{}) bool, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(item interface{}) bool { return !operand(item) }, nil
+	}
+
+	if p.accept("(") {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if t := p.peek(); !p.accept(")") {
+			return nil, p.errorf(t, "expected ) instead of %q", t.text)
+		}
+
+		return inner, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (func(interface{}) bool, error) {
+	field := p.peek()
+	if field.kind != exprIdent {
+		return nil, p.errorf(field, "expected field name instead of %q", field.text)
+	}
+	p.next++
+
+	op := p.peek()
+	if op.kind != exprOp {
+		return func(item interface{}) bool {
+			v, ok := exprFieldValue(item, field.text)
+			return ok && v == true
+		}, nil
+	}
+	p.next++
+
+	value, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+
+	match := fieldMatcher(op.text, value)
+	return func(item interface{}) bool {
+		v, ok := exprFieldValue(item, field.text)
+		return ok && match(v)
+	}, nil
+}
+
+func (p *exprParser) parseLiteral() (interface{}, error) {
+	t := p.peek()
+	p.next++
+
+	switch {
+	case t.kind == exprString:
+		return t.text, nil
+	case t.kind == exprNumber:
+		if i, err := strconv.Atoi(t.text); err == nil {
+			return i, nil
+		}
+
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %q", t.text)
+		}
+
+		return f, nil
+	case t.kind == exprIdent && t.text == "true":
+		return true, nil
+	case t.kind == exprIdent && t.text == "false":
+		return false, nil
+	case t.kind == exprIdent && t.text == "null":
+		return nil, nil
+	case t.kind == exprPunct && t.text == "[":
+		values := []interface{}{}
+		if p.accept("]") {
+			return values, nil
+		}
+
+		for {
+			value, err := p.parseLiteral()
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+			if p.accept("]") {
+				return values, nil
+			}
+
+			if t := p.peek(); !p.accept(",") {
+				return nil, p.errorf(t, "expected , or ] instead of %q", t.text)
+			}
+		}
+	}
+
+	return nil, p.errorf(t, "expected literal value instead of %q", t.text)
+}
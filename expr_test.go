@@ -0,0 +1,121 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+type exprTestUser struct {
+	Name      string
+	Age       int
+	Country   string `json:"country_code"`
+	Verified  bool
+	Roles     []string
+	CreatedAt time.Time
+}
+
+func exprTestUsers() []exprTestUser {
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []exprTestUser{
+		{"Ann", 30, "DE", true, []string{"admin"}, created},
+		{"Bob", 17, "DE", false, nil, created.AddDate(0, 0, 2)},
+		{"Cid", 45, "FR", true, []string{"editor"}, created.AddDate(0, 0, 1)},
+		{"Dee", 19, "IT", false, []string{"admin", "editor"}, created.AddDate(0, 0, 3)},
+	}
+}
+
+func TestWhereExpr(t *testing.T) {
+	tests := []struct {
+		expr   string
+		output []interface{}
+	}{
+		{"age > 18 && country_code == 'DE'", []interface{}{"Ann"}},
+		{"Age >= 18 && (country == \"FR\" || country == 'IT')", []interface{}{"Cid", "Dee"}},
+		{"!verified", []interface{}{"Bob", "Dee"}},
+		{"!(age < 20) && verified == true", []interface{}{"Ann", "Cid"}},
+		{"roles contains 'admin'", []interface{}{"Ann", "Dee"}},
+		{"name in ['Bob', 'Dee'] || age == 45.0", []interface{}{"Bob", "Cid", "Dee"}},
+		{"created_at > '2020-01-02T12:00:00Z'", []interface{}{"Bob", "Dee"}},
+		{"age > -1 && unknown == 1", []interface{}{}},
+		{"name == 'O\\'Brien'", []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := From(exprTestUsers()).WhereExpr(test.expr).SelectField("Name")
+		if !validateQuery(q, test.output) {
+			t.Errorf("WhereExpr(%q)=%v expected %v", test.expr, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestWhereExprWithMaps(t *testing.T) {
+	records := []map[string]interface{}{
+		{"id": 1, "status": "open"},
+		{"id": 2, "status": "closed"},
+	}
+
+	q := From(records).WhereExpr("status != 'closed'").SelectField("id")
+	if w := []interface{}{1}; !validateQuery(q, w) {
+		t.Errorf("WhereExpr(status != 'closed')=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestCompilePredicate(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{"", "linq: invalid expression at position 1: expected field name instead of \"end of expression\""},
+		{"age 18", "linq: invalid expression at position 5: unexpected \"18\""},
+		{"age >", "linq: invalid expression at position 6: expected literal value instead of \"end of expression\""},
+		{"age > 18 &&", "linq: invalid expression at position 12: expected field name instead of \"end of expression\""},
+		{"(age > 18", "linq: invalid expression at position 10: expected ) instead of \"end of expression\""},
+		{"name == 'Ann", "linq: invalid expression at position 9: unterminated string"},
+		{"age > 18 # comment", "linq: invalid expression at position 10: unexpected character '#'"},
+		{"age > 18 age", "linq: invalid expression at position 10: unexpected \"age\""},
+		{"age in [1 2]", "linq: invalid expression at position 11: expected , or ] instead of \"2\""},
+		{"age == 1.2.3", "linq: invalid expression at position 8: invalid number \"1.2.3\""},
+	}
+
+	for _, test := range tests {
+		if _, err := CompilePredicate(test.expr); err == nil || err.Error() != test.err {
+			t.Errorf("CompilePredicate(%q) error=%v expected %s", test.expr, err, test.err)
+		}
+	}
+}
+
+func TestWhereExpr_PanicWhenExprIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "linq: invalid expression at position 7: expected literal value instead of \"&&\"", func() {
+		From(exprTestUsers()).WhereExpr("age > && verified")
+	})
+}
+
+func TestOrderByExpr(t *testing.T) {
+	tests := []struct {
+		spec   string
+		output []interface{}
+	}{
+		{"age", []interface{}{"Bob", "Dee", "Ann", "Cid"}},
+		{"-created_at", []interface{}{"Dee", "Bob", "Cid", "Ann"}},
+		{"country, -name", []interface{}{"Bob", "Ann", "Cid", "Dee"}},
+		{"-verified, +Name", []interface{}{"Ann", "Cid", "Bob", "Dee"}},
+	}
+
+	for _, test := range tests {
+		q := From(exprTestUsers()).OrderByExpr(test.spec).SelectField("Name")
+		if !validateQuery(q, test.output) {
+			t.Errorf("OrderByExpr(%q)=%v expected %v", test.spec, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestOrderByExpr_PanicWhenSpecIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "OrderByExpr: parameter [spec] has an invalid value. Expected: comma-separated field names, actual: 'age,'", func() {
+		From(exprTestUsers()).OrderByExpr("age,")
+	})
+
+	_, err := ValidateQuery(func() Query { return From(exprTestUsers()).OrderByExpr("age,").Query })
+	if err == nil {
+		t.Errorf("ValidateQuery(OrderByExpr(age,)) expected an error")
+	}
+}
@@ -0,0 +1,175 @@
+package linq
+
+import (
+	"context"
+	"strconv"
+	"sync"
+)
+
+// FanOut returns a query with the results of transform for each element of a
+// collection, computed concurrently by a pool of workers goroutines, such as
+// to call an external service for many elements at once. If workers is not
+// positive, the Parallelism option set with WithOptions or
+// runtime.GOMAXPROCS(0) goroutines are used. transform must be safe for
+// concurrent use. The collection itself is iterated over by another
+// goroutine, one element at a time, so the functions of the operators it was
+// built with, such as the selector of Select, run on that goroutine rather
+// than on the calling one.
+//
+// The results are returned in the order of the collection, keeping the
+// results that are ready early in memory until the preceding ones are
+// returned, unless the query passed to FanOut has the Unordered option set
+// with WithOptions, in which case they are returned as soon as they are
+// ready.
+//
+// The goroutines are started when the first result is requested. If transform
+// returns an error, the iterator panics with it and the remaining elements are
+// not transformed; use Catch to handle it. If iterating over the collection or
+// transform panics, the iterator panics with the same value. If the consumer
+// stops iterating before the end, the goroutines stay blocked, unless the
+// query passed to FanOut has a Context set with WithOptions, which ends them
+// when it is done.
+func (q Query) FanOut(workers int, transform func(interface{}) (interface{}, error)) Query {
+	if workers <= 0 {
+		workers = q.parallelism()
+	}
+
+	ctx := q.context()
+	ordered := q.options == nil || !q.options.Unordered
+
+	return Query{
+		desc:   q.chain("FanOut(" + strconv.Itoa(workers) + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			var results chan fanOutResult
+			var h *pumpHandle
+			stop := make(chan struct{})
+			pending := make(map[int]fanOutResult)
+			position := 0
+			failed := false
+
+			// emit returns the value of r, or stops the workers and panics if
+			// transform failed for its element.
+			emit := func(r fanOutResult) (interface{}, bool) {
+				if r.err != nil || r.panicked {
+					failed = true
+					close(stop)
+
+					if r.panicked {
+						panic(r.reason)
+					}
+
+					panic(r.err)
+				}
+
+				return r.value, true
+			}
+
+			return func() (item interface{}, ok bool) {
+				if failed {
+					return nil, false
+				}
+
+				if results == nil {
+					results, h = q.startFanOut(ctx, workers, transform, stop)
+				}
+
+				for {
+					if r, has := pending[position]; ordered && has {
+						delete(pending, position)
+						position++
+						return emit(r)
+					}
+
+					r, open := <-results
+					if !open {
+						if _, canceled := h.p.reason.(prefetchCanceled); !canceled {
+							h.p.rethrow()
+						}
+
+						return nil, false
+					}
+
+					if !ordered {
+						return emit(r)
+					}
+
+					pending[r.index] = r
+				}
+			}
+		},
+	}
+}
+
+// fanOutResult is an element of the collection passed to FanOut, with its
+// index, or the result of transform for it.
+type fanOutResult struct {
+	index    int
+	value    interface{}
+	err      error
+	panicked bool
+	reason   interface{}
+}
+
+// startFanOut starts the goroutines of FanOut: one iterating over q and the
+// workers transforming its elements. The returned channel receives the
+// results and is closed once all of them have been sent, or when stop is
+// closed or ctx is done.
+func (q Query) startFanOut(ctx context.Context, workers int,
+	transform func(interface{}) (interface{}, error), stop chan struct{}) (chan fanOutResult, *pumpHandle) {
+	jobs := make(chan fanOutResult)
+	results := make(chan fanOutResult, workers)
+
+	index := 0
+	h := startPump(q, func(item interface{}) {
+		select {
+		case jobs <- fanOutResult{index: index, value: item}:
+			index++
+		case <-stop:
+			panic(prefetchCanceled{})
+		case <-ctx.Done():
+			panic(prefetchCanceled{})
+		}
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for job := range jobs {
+				r := fanOutTransform(transform, job)
+				select {
+				case results <- r:
+				case <-stop:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+
+	p := h.p
+	go func() {
+		<-p.done
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	return results, h
+}
+
+// fanOutTransform returns the result of transform for the element of job,
+// recovering a panic of transform.
+func fanOutTransform(transform func(interface{}) (interface{}, error), job fanOutResult) (r fanOutResult) {
+	r.index = job.index
+	defer func() {
+		if reason := recover(); reason != nil {
+			r.panicked, r.reason = true, reason
+		}
+	}()
+
+	r.value, r.err = transform(job.value)
+	return
+}
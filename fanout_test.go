@@ -0,0 +1,103 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFanOut(t *testing.T) {
+	var running, most int32
+	transform := func(i interface{}) (interface{}, error) {
+		n := atomic.AddInt32(&running, 1)
+		for m := atomic.LoadInt32(&most); n > m && !atomic.CompareAndSwapInt32(&most, m, n); m = atomic.LoadInt32(&most) {
+		}
+
+		// Later elements finish first, so ordering has to be restored.
+		time.Sleep(time.Duration(10-i.(int)) * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return i.(int) * 2, nil
+	}
+
+	q := Range(1, 8).FanOut(4, transform)
+	if w := []interface{}{2, 4, 6, 8, 10, 12, 14, 16}; !validateQuery(q, w) {
+		t.Errorf("FanOut()=%v expected %v", toSlice(q), w)
+	}
+
+	if most > 4 || most < 2 {
+		t.Errorf("FanOut(4) ran %d transforms concurrently expected 2 to 4", most)
+	}
+}
+
+func TestFanOutUnordered(t *testing.T) {
+	q := Range(1, 8).WithOptions(Options{Unordered: true}).FanOut(4, func(i interface{}) (interface{}, error) {
+		time.Sleep(time.Duration(10-i.(int)) * time.Millisecond)
+		return i, nil
+	})
+
+	var got []int
+	q.ToSlice(&got)
+	sorted := sort.IntsAreSorted(got)
+	sort.Ints(got)
+	if len(got) != 8 || got[0] != 1 || got[7] != 8 || sorted {
+		t.Errorf("FanOut() unordered=%v expected 1 to 8 out of order", got)
+	}
+}
+
+func TestFanOutWithError(t *testing.T) {
+	q := Range(1, 100).FanOut(2, func(i interface{}) (interface{}, error) {
+		if i.(int) == 3 {
+			return nil, errors.New("transform failed")
+		}
+
+		return i, nil
+	})
+
+	mustPanicWithError(t, "transform failed", func() {
+		toSlice(q)
+	})
+
+	got := q.Catch(func(error) Query { return From([]int{-1}) })
+	if w := []interface{}{1, 2, -1}; !validateQuery(got, w) {
+		t.Errorf("FanOut().Catch()=%v expected %v", toSlice(got), w)
+	}
+
+	mustPanicWithError(t, "source failed", func() {
+		Range(1, 3).Select(func(i interface{}) interface{} {
+			if i.(int) == 2 {
+				panic(errors.New("source failed"))
+			}
+
+			return i
+		}).FanOut(2, func(i interface{}) (interface{}, error) { return i, nil }).ToSlice(new([]int))
+	})
+}
+
+func TestFanOutCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := Generate(1, func(i interface{}) interface{} { return i.(int) + 1 }).
+		WithOptions(Options{Context: ctx}).
+		FanOut(2, func(i interface{}) (interface{}, error) { return i, nil })
+
+	next := q.Iterate()
+	if item, ok := next(); !ok || item != 1 {
+		t.Errorf("FanOut() first element=%v, %v expected 1, true", item, ok)
+	}
+
+	cancel()
+
+	// Elements in flight at the time of the cancellation may still be
+	// returned, but the iteration has to end.
+	for i := 0; ; i++ {
+		if _, ok := next(); !ok {
+			break
+		}
+
+		if i > 1000 {
+			t.Fatalf("FanOut() kept returning elements after cancellation")
+		}
+	}
+}
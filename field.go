@@ -0,0 +1,120 @@
+package linq
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldKey identifies a named field of a struct type.
+type fieldKey struct {
+	t    reflect.Type
+	name string
+}
+
+// fieldIndexes caches the index sequences of struct fields looked up by name,
+// so that the reflection lookup is done once per type and name.
+var fieldIndexes sync.Map
+
+// fieldIndex returns the index sequence of the exported field of struct type t
+// with the specified name, including fields promoted from embedded structs,
+// and whether such a field exists.
+func fieldIndex(t reflect.Type, name string) ([]int, bool) {
+	key := fieldKey{t, name}
+	if index, ok := fieldIndexes.Load(key); ok {
+		return index.([]int), index.([]int) != nil
+	}
+
+	var index []int
+	if f, ok := t.FieldByName(name); ok && f.PkgPath == "" {
+		index = f.Index
+	}
+
+	fieldIndexes.Store(key, index)
+	return index, index != nil
+}
+
+// fieldValue returns the value of the exported field with the specified name
+// of item, which is a struct or a pointer to struct, or the value stored under
+// the key name of item, which is a map with string keys. ok is false if item
+// has no such field or key.
+func fieldValue(item interface{}, name string) (value interface{}, ok bool) {
+	v := reflect.ValueOf(item)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		index, ok := fieldIndex(v.Type(), name)
+		if !ok {
+			return nil, false
+		}
+
+		for i, x := range index {
+			if i > 0 && v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return nil, false
+				}
+
+				v = v.Elem()
+			}
+
+			v = v.Field(x)
+		}
+
+		return v.Interface(), true
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !value.IsValid() {
+			return nil, false
+		}
+
+		return value.Interface(), true
+	}
+
+	return nil, false
+}
+
+// SelectField projects each element of a collection into the value of its
+// field or map entry with the specified name. Elements can be structs,
+// pointers to structs or maps with string keys, and can be of different types.
+// Only exported fields are accessible, including fields promoted from embedded
+// structs. Like in WhereField, a struct field can also be named by its json tag
+// or by its name in any letter case with or without underscores, so that the
+// names of database columns can be used. If an element has no field or entry
+// with that name, it is projected into nil.
+//
+// The fields of each struct type are looked up by reflection only once.
+func (q Query) SelectField(name string) Query {
+	return q.Select(func(item interface{}) interface{} {
+		value, _ := exprFieldValue(item, name)
+		return value
+	}).describe(q.chain("SelectField(" + name + ")"))
+}
+
+// SelectFields projects each element of a collection into a
+// map[string]interface{} holding the values of its fields or map entries with
+// the specified names. Names the element has no field or entry for are
+// omitted from the map. See SelectField for the supported element types.
+func (q Query) SelectFields(names ...string) Query {
+	names = append([]string(nil), names...)
+
+	return q.Select(func(item interface{}) interface{} {
+		values := make(map[string]interface{}, len(names))
+		for _, name := range names {
+			if value, ok := exprFieldValue(item, name); ok {
+				values[name] = value
+			}
+		}
+
+		return values
+	}).describe(q.chain("SelectFields"))
+}
@@ -0,0 +1,64 @@
+package linq
+
+import "testing"
+
+type fieldTestBase struct {
+	ID int
+}
+
+type fieldTestUser struct {
+	*fieldTestBase
+	Name      string
+	Age       int
+	CreatedAt string `json:"created"`
+	private   string
+}
+
+func TestSelectField(t *testing.T) {
+	records := []interface{}{
+		fieldTestUser{fieldTestBase: &fieldTestBase{1}, Name: "Ann", Age: 30, CreatedAt: "today"},
+		&fieldTestUser{Name: "Bob", Age: 25},
+		map[string]interface{}{"Name": "Cid"},
+		map[string]int{"Age": 40},
+		42,
+		nil,
+	}
+
+	tests := []struct {
+		name   string
+		output []interface{}
+	}{
+		{"Name", []interface{}{"Ann", "Bob", "Cid", nil, nil, nil}},
+		{"Age", []interface{}{30, 25, nil, 40, nil, nil}},
+		{"ID", []interface{}{1, nil, nil, nil, nil, nil}},
+		{"private", []interface{}{nil, nil, nil, nil, nil, nil}},
+		{"Missing", []interface{}{nil, nil, nil, nil, nil, nil}},
+		{"created_at", []interface{}{"today", "", nil, nil, nil, nil}},
+		{"created", []interface{}{"today", "", nil, nil, nil, nil}},
+		{"name", []interface{}{"Ann", "Bob", nil, nil, nil, nil}},
+	}
+
+	for _, test := range tests {
+		q := From(records).SelectField(test.name)
+		for i := 0; i < 2; i++ {
+			if !validateQuery(q, test.output) {
+				t.Errorf("SelectField(%q)=%v expected %v", test.name, toSlice(q), test.output)
+			}
+		}
+	}
+}
+
+func TestSelectFields(t *testing.T) {
+	records := []interface{}{
+		fieldTestUser{Name: "Ann", Age: 30},
+		map[string]interface{}{"Name": "Bob"},
+	}
+
+	var got []map[string]interface{}
+	From(records).SelectFields("Name", "Age").ToSlice(&got)
+
+	if len(got) != 2 || len(got[0]) != 2 || got[0]["Name"] != "Ann" || got[0]["Age"] != 30 ||
+		len(got[1]) != 1 || got[1]["Name"] != "Bob" {
+		t.Errorf("SelectFields(Name, Age)=%v expected [map[Age:30 Name:Ann] map[Name:Bob]]", got)
+	}
+}
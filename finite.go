@@ -0,0 +1,99 @@
+package linq
+
+import "math"
+
+// SkipNonFinite filters out the float32 and float64 elements of a collection
+// that are NaN or infinite. Elements of other types are kept.
+//
+// A single NaN makes the result of Average and SumFloats NaN and the result of
+// Min and Max depend on its position, so SkipNonFinite can be used before them
+// to ignore such values:
+//
+//	avg := From(samples).SkipNonFinite().Average()
+func (q Query) SkipNonFinite() Query {
+	return q.Where(func(item interface{}) bool {
+		return !isNonFinite(item)
+	})
+}
+
+// AverageFinite is like Average, but stops iterating and returns ErrNonFinite
+// as soon as a NaN or infinite value is found.
+func (q Query) AverageFinite() (float64, error) {
+	var err error
+	r := q.stopAtNonFinite("AverageFinite", &err).Average()
+	if err != nil {
+		return 0, err
+	}
+
+	return r, nil
+}
+
+// MaxFinite is like Max, but stops iterating and returns ErrNonFinite as soon
+// as a NaN or infinite value is found.
+func (q Query) MaxFinite() (interface{}, error) {
+	var err error
+	r := q.stopAtNonFinite("MaxFinite", &err).Max()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MinFinite is like Min, but stops iterating and returns ErrNonFinite as soon
+// as a NaN or infinite value is found.
+func (q Query) MinFinite() (interface{}, error) {
+	var err error
+	r := q.stopAtNonFinite("MinFinite", &err).Min()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// SumFloatsFinite is like SumFloats, but stops iterating and returns
+// ErrNonFinite as soon as a NaN or infinite value is found.
+func (q Query) SumFloatsFinite() (float64, error) {
+	var err error
+	r := q.stopAtNonFinite("SumFloatsFinite", &err).SumFloats()
+	if err != nil {
+		return 0, err
+	}
+
+	return r, nil
+}
+
+// stopAtNonFinite returns a query, described as the operator op applied to q,
+// that ends before the first NaN or infinite element of the collection, and
+// sets err to ErrNonFinite if it does.
+func (q Query) stopAtNonFinite(op string, err *error) Query {
+	return Query{
+		desc: q.chain(op),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok && isNonFinite(item) {
+					*err = ErrNonFinite
+					return nil, false
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// isNonFinite reports whether item is a float32 or float64 NaN or infinity.
+func isNonFinite(item interface{}) bool {
+	switch f := item.(type) {
+	case float64:
+		return math.IsNaN(f) || math.IsInf(f, 0)
+	case float32:
+		return math.IsNaN(float64(f)) || math.IsInf(float64(f), 0)
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSkipNonFinite(t *testing.T) {
+	input := []interface{}{1.5, math.NaN(), float32(2), math.Inf(1), float32(math.Inf(-1)), 3}
+	want := []interface{}{1.5, float32(2), 3}
+
+	if q := From(input).SkipNonFinite(); !validateQuery(q, want) {
+		t.Errorf("From(%v).SkipNonFinite()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if r := From([]float64{1, math.NaN(), 3}).SkipNonFinite().Average(); r != 2 {
+		t.Errorf("SkipNonFinite().Average()=%v expected 2", r)
+	}
+}
+
+func TestFinite(t *testing.T) {
+	finite := []float64{2, 4, 1, 5}
+	tests := []struct {
+		input []float64
+		err   error
+	}{
+		{finite, nil},
+		{[]float64{2, math.NaN(), 1}, ErrNonFinite},
+		{[]float64{math.Inf(-1)}, ErrNonFinite},
+	}
+
+	for _, test := range tests {
+		q := From(test.input)
+
+		if _, err := q.AverageFinite(); err != test.err {
+			t.Errorf("From(%v).AverageFinite() err=%v expected %v", test.input, err, test.err)
+		}
+
+		if _, err := q.SumFloatsFinite(); err != test.err {
+			t.Errorf("From(%v).SumFloatsFinite() err=%v expected %v", test.input, err, test.err)
+		}
+
+		if _, err := q.MinFinite(); err != test.err {
+			t.Errorf("From(%v).MinFinite() err=%v expected %v", test.input, err, test.err)
+		}
+
+		if _, err := q.MaxFinite(); err != test.err {
+			t.Errorf("From(%v).MaxFinite() err=%v expected %v", test.input, err, test.err)
+		}
+	}
+
+	q := From(finite)
+	if r, _ := q.AverageFinite(); r != 3 {
+		t.Errorf("From(%v).AverageFinite()=%v expected 3", finite, r)
+	}
+
+	if r, _ := q.SumFloatsFinite(); r != 12 {
+		t.Errorf("From(%v).SumFloatsFinite()=%v expected 12", finite, r)
+	}
+
+	if r, _ := q.MinFinite(); r != 1.0 {
+		t.Errorf("From(%v).MinFinite()=%v expected 1", finite, r)
+	}
+
+	if r, _ := q.MaxFinite(); r != 5.0 {
+		t.Errorf("From(%v).MaxFinite()=%v expected 5", finite, r)
+	}
+}
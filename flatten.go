@@ -0,0 +1,63 @@
+package linq
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// Flatten replaces every element of a collection that is a slice, an array or
+// a Query with its elements, down to the specified depth. For example, with a
+// depth of 1 a collection of [][]int values becomes a collection of []int
+// values, and with a depth of 2 a collection of int values.
+//
+// Other elements, including strings, are returned as they are. If depth is not
+// positive, the collection is returned unchanged.
+func (q Query) Flatten(depth int) Query {
+	desc := q.chain("Flatten(" + strconv.Itoa(depth) + ")")
+	if depth <= 0 {
+		return q.describe(desc)
+	}
+
+	return Query{
+		desc: desc,
+		Iterate: func() Iterator {
+			stack := []Iterator{q.Iterate()}
+
+			return func() (item interface{}, ok bool) {
+				for len(stack) > 0 {
+					item, ok = stack[len(stack)-1]()
+					if !ok {
+						stack = stack[:len(stack)-1]
+						continue
+					}
+
+					if len(stack) <= depth {
+						if next, nested := nestedIterator(item); nested {
+							stack = append(stack, next)
+							continue
+						}
+					}
+
+					return
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// nestedIterator returns an iterator over the elements of item if it is a
+// slice, an array or a Query.
+func nestedIterator(item interface{}) (Iterator, bool) {
+	if q, ok := item.(Query); ok {
+		return q.Iterate(), true
+	}
+
+	switch reflect.ValueOf(item).Kind() {
+	case reflect.Slice, reflect.Array:
+		return From(item).Iterate(), true
+	}
+
+	return nil, false
+}
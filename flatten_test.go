@@ -0,0 +1,44 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	nested := []interface{}{
+		1,
+		[]int{2, 3},
+		[][]int{{4}, {5, 6}},
+		[]interface{}{},
+		From([]interface{}{7, []int{8}}),
+		"ab",
+	}
+
+	tests := []struct {
+		depth int
+		want  []interface{}
+	}{
+		{1, []interface{}{1, 2, 3, []int{4}, []int{5, 6}, 7, []int{8}, "ab"}},
+		{2, []interface{}{1, 2, 3, 4, 5, 6, 7, 8, "ab"}},
+		{5, []interface{}{1, 2, 3, 4, 5, 6, 7, 8, "ab"}},
+	}
+
+	for _, test := range tests {
+		if q := From(nested).Flatten(test.depth); !reflect.DeepEqual(toSlice(q), test.want) {
+			t.Errorf("From(%v).Flatten(%d)=%v expected %v", nested, test.depth, toSlice(q), test.want)
+		}
+	}
+
+	if q := From(nested).Flatten(0); q.Count() != len(nested) {
+		t.Errorf("From(%v).Flatten(0)=%v expected %v", nested, toSlice(q), nested)
+	}
+
+	if q := From([][2]int{{1, 2}, {3, 4}}).Flatten(1); !validateQuery(q, []interface{}{1, 2, 3, 4}) {
+		t.Errorf("From([[1 2] [3 4]]).Flatten(1)=%v expected [1 2 3 4]", toSlice(q))
+	}
+
+	if s := From(nested).Flatten(2).String(); s != "From(slice[6]).Flatten(2)" {
+		t.Errorf("Flatten(2).String()=%s expected From(slice[6]).Flatten(2)", s)
+	}
+}
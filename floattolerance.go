@@ -0,0 +1,104 @@
+package linq
+
+import "math"
+
+// WithFloatTolerance returns the query with the FloatTolerance option set to
+// eps, keeping the other options set with WithOptions. Contains,
+// SequenceEqual, Distinct and IndexOfSequence then consider float elements
+// that differ by at most eps equal, since computed floats are rarely exactly
+// equal. Methods ordering elements, such as OrderBy, Min and Max, are not
+// affected, since elements within eps of each other are not ordered
+// consistently. Like the other options, the tolerance applies only to the
+// operator the query is directly passed to.
+func (q Query) WithFloatTolerance(eps float64) Query {
+	opts := Options{}
+	if q.options != nil {
+		opts = *q.options
+	}
+
+	opts.FloatTolerance = eps
+	q.options = &opts
+	return q
+}
+
+// floatTolerance returns the FloatTolerance option of the query, or zero.
+func (q Query) floatTolerance() float64 {
+	if q.options == nil || q.options.FloatTolerance <= 0 {
+		return 0
+	}
+
+	return q.options.FloatTolerance
+}
+
+// isFloat reports whether v is a float32 or a float64.
+func isFloat(v interface{}) bool {
+	switch v.(type) {
+	case float32, float64:
+		return true
+	}
+
+	return false
+}
+
+// floatsWithin reports whether a and b are both floats that differ by at most
+// eps.
+func floatsWithin(a, b interface{}, eps float64) bool {
+	if !isFloat(a) || !isFloat(b) {
+		return false
+	}
+
+	x, y := getFloatConverter(a)(a), getFloatConverter(b)(b)
+	return x == y || math.Abs(x-y) <= eps
+}
+
+// distinctWithin returns the distinct elements of q, considering finite
+// floats that differ by at most eps equal. Floats are kept in buckets of width
+// eps, so that only the floats of adjacent buckets are compared.
+func (q Query) distinctWithin(eps float64) Query {
+	return Query{
+		desc: q.chain("Distinct"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			set := make(map[interface{}]bool, q.capacity())
+			buckets := make(map[float64][]float64)
+
+			// seen reports whether a float close to f has been returned, and
+			// records f otherwise.
+			seen := func(f float64) bool {
+				bucket := math.Floor(f / eps)
+				for _, b := range [...]float64{bucket - 1, bucket, bucket + 1} {
+					for _, g := range buckets[b] {
+						if math.Abs(f-g) <= eps {
+							return true
+						}
+					}
+				}
+
+				buckets[bucket] = append(buckets[bucket], f)
+				return false
+			}
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if isFloat(item) {
+						f := getFloatConverter(item)(item)
+						if !math.IsNaN(f) && !math.IsInf(f, 0) {
+							if !seen(f) {
+								return
+							}
+
+							continue
+						}
+					}
+
+					if _, has := set[item]; !has {
+						set[item] = true
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWithFloatTolerance(t *testing.T) {
+	a, b := 0.1, 0.2
+	computed := a + b
+
+	if From([]float64{1, computed}).Contains(0.3) {
+		t.Errorf("Contains(0.3) without tolerance expected false")
+	}
+
+	q := From([]float64{1, computed}).WithFloatTolerance(1e-9)
+	if !q.Contains(0.3) || q.Contains(0.31) {
+		t.Errorf("WithFloatTolerance(1e-9).Contains() expected to find only 0.3")
+	}
+
+	if !q.SequenceEqual(From([]float64{1, 0.3})) || q.SequenceEqual(From([]float64{1, 0.4})) {
+		t.Errorf("WithFloatTolerance(1e-9).SequenceEqual() expected true for [1 0.3] only")
+	}
+
+	if !From([]interface{}{"a", 0.3}).WithFloatTolerance(1e-9).SequenceEqual(From([]interface{}{"a", computed})) {
+		t.Errorf("WithFloatTolerance(1e-9).SequenceEqual() of mixed elements expected true")
+	}
+}
+
+func TestDistinctWithFloatTolerance(t *testing.T) {
+	input := []interface{}{0.3, 0.1 + 0.2, 1.0, 1.05, 1.1, math.NaN(), math.Inf(1), math.Inf(1), "a", "a"}
+	q := From(input).WithFloatTolerance(0.06).Distinct()
+
+	got := toSlice(q)
+	if len(got) != 6 || got[0] != 0.3 || got[1] != 1.0 || got[2] != 1.1 || got[4] != math.Inf(1) || got[5] != "a" {
+		t.Errorf("WithFloatTolerance(0.06).Distinct()=%v expected [0.3 1 1.1 NaN +Inf a]", got)
+	}
+
+	// Floats too large for distinct neighbor buckets must not hang.
+	if n := From([]float64{1e300, 1e300}).WithFloatTolerance(1e-300).Distinct().Count(); n != 1 {
+		t.Errorf("WithFloatTolerance().Distinct().Count()=%v expected 1", n)
+	}
+}
+
+func TestOrderByIgnoresFloatTolerance(t *testing.T) {
+	input := []KeyValue{{1.0000001, 1}, {1.0, 2}, {0.5, 3}}
+	key := func(i interface{}) interface{} { return i.(KeyValue).Key }
+	value := func(i interface{}) interface{} { return i.(KeyValue).Value }
+
+	// The first two keys are equal within the tolerance, but ordering stays
+	// exact, since a tolerant comparison is not transitive.
+	q := From(input).WithFloatTolerance(1e-6).OrderBy(key).ThenBy(value)
+	if w := []interface{}{input[2], input[1], input[0]}; !validateQuery(q.Query, w) {
+		t.Errorf("WithFloatTolerance().OrderBy().ThenBy()=%v expected %v", toSlice(q.Query), w)
+	}
+
+	// With a tolerance of 1, 0 ~ 0.9 and 0.9 ~ 1.8 but not 0 ~ 1.8.
+	if m := From([]float64{0.9, 1.8, 0}).WithFloatTolerance(1).Max(); m != 1.8 {
+		t.Errorf("WithFloatTolerance(1).Max()=%v expected 1.8", m)
+	}
+}
+
+func TestIndexOfSequenceWithFloatTolerance(t *testing.T) {
+	a, b := 0.1, 0.2
+	input := []float64{0.3, 0.3, a + b, 1}
+
+	if i := From(input).IndexOfSequence(From([]float64{0.3, 1})); i != -1 {
+		t.Errorf("IndexOfSequence() without tolerance=%d expected -1", i)
+	}
+
+	q := From(input).WithFloatTolerance(1e-9)
+	tests := []struct {
+		needle []float64
+		want   int
+	}{
+		{[]float64{0.3, 1}, 2},
+		{[]float64{0.3, 0.3, 0.3, 1}, 0},
+		{[]float64{1}, 3},
+		{[]float64{0.3, 2}, -1},
+		{[]float64{0.3, 0.3, 0.3, 1, 1}, -1},
+	}
+
+	for _, test := range tests {
+		if i := q.IndexOfSequence(From(test.needle)); i != test.want {
+			t.Errorf("WithFloatTolerance(1e-9).IndexOfSequence(%v)=%d expected %d", test.needle, i, test.want)
+		}
+	}
+}
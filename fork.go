@@ -0,0 +1,99 @@
+package linq
+
+import (
+	"strconv"
+	"sync"
+)
+
+// Fork splits a collection into n queries that iterate over the same elements
+// while iterating over the collection only once, so expensive work done by the
+// collection isn't repeated for every consumer.
+//
+// The elements produced by the collection are buffered until every query has
+// iterated past them, so a consumer that falls behind makes the buffer grow.
+// The queries can be iterated from different goroutines. Each query returned
+// by Fork is meant to be iterated once; iterating it again continues where the
+// previous iteration stopped. Fork returns nil if n is not positive.
+func (q Query) Fork(n int) []Query {
+	if n <= 0 {
+		return nil
+	}
+
+	f := &fork{source: q, positions: make([]int, n)}
+	queries := make([]Query, n)
+	desc := q.chain("Fork(" + strconv.Itoa(n) + ")")
+
+	for i := range queries {
+		consumer := i
+		queries[i] = Query{
+			desc: desc + "[" + strconv.Itoa(i) + "]",
+			Iterate: func() Iterator {
+				return func() (interface{}, bool) {
+					return f.next(consumer)
+				}
+			},
+		}
+	}
+
+	return queries
+}
+
+// fork holds the state shared by the queries returned by Fork.
+type fork struct {
+	mu        sync.Mutex
+	source    Query
+	iter      Iterator
+	done      bool
+	buffer    []interface{}
+	base      int
+	positions []int
+}
+
+// next returns the next element for the specified consumer, producing it from
+// the source if no consumer has reached it yet.
+func (f *fork) next(consumer int) (item interface{}, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pos := f.positions[consumer]
+	if pos-f.base >= len(f.buffer) {
+		if f.done {
+			return nil, false
+		}
+
+		if f.iter == nil {
+			f.iter = f.source.Iterate()
+		}
+
+		if item, ok = f.iter(); !ok {
+			f.done = true
+			return nil, false
+		}
+
+		f.buffer = append(f.buffer, item)
+	}
+
+	item = f.buffer[pos-f.base]
+	f.positions[consumer]++
+	f.trim()
+	return item, true
+}
+
+// trim drops the buffered elements every consumer has iterated past.
+func (f *fork) trim() {
+	min := f.positions[0]
+	for _, pos := range f.positions[1:] {
+		if pos < min {
+			min = pos
+		}
+	}
+
+	if drop := min - f.base; drop > 0 {
+		for i := 0; i < drop; i++ {
+			f.buffer[i] = nil
+		}
+
+		f.buffer = f.buffer[drop:]
+		f.base = min
+	}
+}
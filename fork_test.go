@@ -0,0 +1,66 @@
+package linq
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestFork(t *testing.T) {
+	calls := 0
+	source := Range(1, 5).Select(func(i interface{}) interface{} {
+		calls++
+		return i
+	})
+
+	forks := source.Fork(3)
+	if len(forks) != 3 {
+		t.Fatalf("Fork(3) returned %d queries expected 3", len(forks))
+	}
+
+	w := []interface{}{1, 2, 3, 4, 5}
+	first := forks[0].Iterate()
+	first()
+	first()
+
+	if !validateQuery(forks[1], w) {
+		t.Errorf("Fork(3)[1]=%v expected %v", toSlice(forks[1]), w)
+	}
+
+	if item, _ := first(); item != 3 {
+		t.Errorf("Fork(3)[0] resumed at %v expected 3", item)
+	}
+
+	if !validateQuery(forks[2], w) {
+		t.Errorf("Fork(3)[2]=%v expected %v", toSlice(forks[2]), w)
+	}
+
+	if calls != 5 {
+		t.Errorf("Fork(3) iterated the source %d times expected 5", calls)
+	}
+
+	if forks := source.Fork(0); forks != nil {
+		t.Errorf("Fork(0)=%v expected nil", forks)
+	}
+}
+
+func TestForkConcurrent(t *testing.T) {
+	forks := Range(1, 1000).Fork(4)
+	sums := make([]int64, len(forks))
+
+	var wg sync.WaitGroup
+	for i, q := range forks {
+		wg.Add(1)
+		go func(i int, q Query) {
+			defer wg.Done()
+			sums[i] = q.SumInts()
+		}(i, q)
+	}
+
+	wg.Wait()
+
+	for i, sum := range sums {
+		if sum != 500500 {
+			t.Errorf("Fork(4)[%d].SumInts()=%v expected 500500", i, sum)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package linq
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
 
 // Iterator is an alias for function to iterate over data.
 type Iterator func() (item interface{}, ok bool)
@@ -9,6 +14,74 @@ type Iterator func() (item interface{}, ok bool)
 // as shown in the example.
 type Query struct {
 	Iterate func() Iterator
+
+	// length, if set, returns the number of elements of the query without
+	// iterating over it. It is set by sources with a known length and
+	// preserved by operators that don't change the number of elements.
+	length func() int
+
+	// index, if set, returns the element at the specified position without
+	// iterating over the query. It is set together with length by sources
+	// that support random access, such as slices, arrays and strings.
+	index func(int) interface{}
+
+	// capacityHint, if positive, is the approximate number of elements of
+	// the query set by WithCapacityHint.
+	capacityHint int
+
+	// desc describes the pipeline that built the query, as returned by
+	// String.
+	desc string
+
+	// options, if set, are the options set by WithOptions.
+	options *Options
+
+	// resume, if set, resumes the iteration from a position token, as
+	// returned by IterateFrom. It is set by resumable sources and preserved
+	// by operators that transform each element independently.
+	resume func(token string) (Iterator, func() string, error)
+
+	// enumerate, if set, starts a new iteration like Iterate, but returns
+	// the Enumerator of an operator implemented as a struct, so that chained
+	// operators call each other's Next method instead of building a chain of
+	// closures. It must be cleared whenever Iterate is replaced.
+	enumerate func() Enumerator
+
+	// elemType, if set, is the type of all the elements of the query, known
+	// when the query is built: the element type of a typed slice, array or
+	// channel, or the result type of a typed selector. It is preserved by
+	// operators that filter, partition or sort the elements, and checked by
+	// Validate.
+	elemType reflect.Type
+
+	// buildErr, if set, is an error found when the query was built that would
+	// only show when it is iterated, such as a typed function that can't
+	// accept the elements of the query. It is reported by Validate.
+	buildErr error
+}
+
+// String returns a description of the pipeline that built the query, such as
+// From(slice[1000]).Where.Select.OrderBy.Take(10), to show in logs and error
+// messages which query was running. Queries built by sources and operators
+// defined outside of this package are described as Query.
+func (q Query) String() string {
+	if q.desc == "" {
+		return "Query"
+	}
+
+	return q.desc
+}
+
+// chain returns the description of a query built by applying the operator op
+// to q.
+func (q Query) chain(op string) string {
+	return q.String() + "." + op
+}
+
+// describe returns q with the specified description.
+func (q Query) describe(desc string) Query {
+	q.desc = desc
+	return q
 }
 
 // KeyValue is a type that is used to iterate over a map (if query is created
@@ -37,6 +110,10 @@ func From(source interface{}) Query {
 		len := src.Len()
 
 		return Query{
+			desc:     "From(" + src.Kind().String() + "[" + strconv.Itoa(len) + "])",
+			length:   func() int { return len },
+			index:    func(i int) interface{} { return src.Index(i).Interface() },
+			elemType: knownType(src.Type().Elem()),
 			Iterate: func() Iterator {
 				index := 0
 
@@ -55,6 +132,9 @@ func From(source interface{}) Query {
 		len := src.Len()
 
 		return Query{
+			desc:     "From(map[" + strconv.Itoa(len) + "])",
+			length:   func() int { return len },
+			elemType: keyValueType,
 			Iterate: func() Iterator {
 				index := 0
 				keys := src.MapKeys()
@@ -90,14 +170,20 @@ func From(source interface{}) Query {
 
 // FromChannel initializes a linq query with passed channel, linq iterates over
 // channel until it is closed.
+//
+// The channel is consumed while the query is iterated, so the query can be
+// iterated only once: iterating it again continues where the previous
+// iteration stopped, and once the channel has been closed and drained, the
+// iterator of a new iteration panics with ErrSourceExhausted.
 func FromChannel(source <-chan interface{}) Query {
 	return Query{
-		Iterate: func() Iterator {
+		desc: "FromChannel",
+		Iterate: singleUse(func() Iterator {
 			return func() (item interface{}, ok bool) {
 				item, ok = <-source
 				return
 			}
-		},
+		}),
 	}
 }
 
@@ -109,12 +195,14 @@ func FromChannel(source <-chan interface{}) Query {
 func FromChannelT(source interface{}) Query {
 	src := reflect.ValueOf(source)
 	return Query{
-		Iterate: func() Iterator {
+		desc:     "FromChannelT",
+		elemType: knownType(src.Type().Elem()),
+		Iterate: singleUse(func() Iterator {
 			return func() (interface{}, bool) {
 				value, ok := src.Recv()
 				return value.Interface(), ok
 			}
-		},
+		}),
 	}
 }
 
@@ -125,6 +213,10 @@ func FromString(source string) Query {
 	len := len(runes)
 
 	return Query{
+		desc:     "FromString",
+		length:   func() int { return len },
+		index:    func(i int) interface{} { return runes[i] },
+		elemType: reflect.TypeOf(rune(0)),
 		Iterate: func() Iterator {
 			index := 0
 
@@ -141,53 +233,267 @@ func FromString(source string) Query {
 	}
 }
 
+// FromRunes initializes a linq query with passed string, linq iterates over
+// runes of string. Unlike FromString, the string is decoded as it is iterated
+// instead of being copied to a slice of runes first.
+//
+// Count doesn't iterate over the query, and if the string contains only ASCII
+// characters, ElementAt returns elements without iterating over the preceding
+// ones.
+func FromRunes(source string) Query {
+	ascii := isASCII(source)
+	count := len(source)
+	if !ascii {
+		count = utf8.RuneCountInString(source)
+	}
+
+	q := Query{
+		desc:   "FromRunes",
+		length: func() int { return count },
+		Iterate: func() Iterator {
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(source)
+				if ok {
+					r, size := utf8.DecodeRuneInString(source[index:])
+					item = r
+					index += size
+				}
+
+				return
+			}
+		},
+	}
+
+	if ascii {
+		q.index = func(i int) interface{} { return rune(source[i]) }
+	}
+
+	return q
+}
+
+// FromBytes initializes a linq query with passed slice of bytes, linq iterates
+// over the bytes without using reflection. Count and ElementAt don't iterate
+// over the query.
+func FromBytes(source []byte) Query {
+	return fromIndex(len(source), func(i int) interface{} { return source[i] }).describe("FromBytes")
+}
+
 // FromIterable initializes a linq query with custom collection passed. This
 // collection has to implement Iterable interface, linq iterates over items,
 // that has to implement Comparable interface or be basic types.
 func FromIterable(source Iterable) Query {
 	return Query{
+		desc:    "FromIterable",
 		Iterate: source.Iterate,
 	}
 }
 
 // Range generates a sequence of integral numbers within a specified range.
 func Range(start, count int) Query {
+	enumerate := func() Enumerator {
+		return &rangeEnumerator{current: start, remaining: count}
+	}
+
+	return Query{
+		desc:      "Range(" + strconv.Itoa(start) + ", " + strconv.Itoa(count) + ")",
+		length:    func() int { return knownLength(count) },
+		enumerate: enumerate,
+		elemType:  reflect.TypeOf(0),
+		Iterate: func() Iterator {
+			return enumerate().Next
+		},
+	}
+}
+
+// rangeEnumerator returns remaining integers from current.
+type rangeEnumerator struct {
+	current, remaining int
+}
+
+func (e *rangeEnumerator) Next() (item interface{}, ok bool) {
+	if e.remaining <= 0 {
+		return nil, false
+	}
+
+	item, ok = e.current, true
+	e.current++
+	e.remaining--
+	return
+}
+
+// Repeat generates a sequence that contains one repeated value.
+func Repeat(value interface{}, count int) Query {
 	return Query{
+		desc:   "Repeat(" + strconv.Itoa(count) + ")",
+		length: func() int { return knownLength(count) },
 		Iterate: func() Iterator {
 			index := 0
-			current := start
 
 			return func() (item interface{}, ok bool) {
 				if index >= count {
 					return nil, false
 				}
 
-				item, ok = current, true
+				item, ok = value, true
 
 				index++
-				current++
 				return
 			}
 		},
 	}
 }
 
-// Repeat generates a sequence that contains one repeated value.
-func Repeat(value interface{}, count int) Query {
+// Empty returns an empty sequence.
+func Empty() Query {
 	return Query{
+		desc: "Empty",
 		Iterate: func() Iterator {
+			return func() (item interface{}, ok bool) {
+				return nil, false
+			}
+		},
+	}
+}
+
+// Generate generates a lazy, potentially infinite sequence that starts with
+// seed and in which every subsequent element is computed by passing the
+// previous element to next. Use Take or TakeWhile to bound the sequence.
+func Generate(seed interface{}, next func(interface{}) interface{}) Query {
+	return Query{
+		desc: "Generate",
+		Iterate: func() Iterator {
+			current := seed
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				if started {
+					current = next(current)
+				}
+
+				started = true
+				return current, true
+			}
+		},
+	}
+}
+
+// GenerateT is the typed version of Generate.
+//
+//   - nextFn is of type "func(TSource) TSource"
+//
+// NOTE: Generate has better performance than GenerateT.
+func GenerateT(seed interface{}, nextFn interface{}) Query {
+	nextGenericFunc, err := newGenericFunc(
+		"GenerateT", "nextFn", nextFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	nextFunc := func(item interface{}) interface{} {
+		return nextGenericFunc.Call(item)
+	}
+
+	return Generate(seed, nextFunc)
+}
+
+// FromMapSorted initializes a linq query with passed map as the source. Unlike
+// From, which iterates over a map in random order, FromMapSorted yields the
+// KeyValue pairs of the map in ascending order of keys. Keys have to be of a
+// basic type or implement Comparable interface.
+func FromMapSorted(source interface{}) Query {
+	src := reflect.ValueOf(source)
+
+	return Query{
+		desc: "FromMapSorted",
+		Iterate: func() Iterator {
+			keys := src.MapKeys()
+			if len(keys) > 0 {
+				compare := getComparer(keys[0].Interface())
+				sort.Slice(keys, func(i, j int) bool {
+					return compare(keys[i].Interface(), keys[j].Interface()) < 0
+				})
+			}
+
 			index := 0
 
 			return func() (item interface{}, ok bool) {
-				if index >= count {
-					return nil, false
+				ok = index < len(keys)
+				if ok {
+					key := keys[index]
+					item = KeyValue{
+						Key:   key.Interface(),
+						Value: src.MapIndex(key).Interface(),
+					}
+
+					index++
 				}
 
-				item, ok = value, true
+				return
+			}
+		},
+	}
+}
+
+// FromFunc initializes a linq query with passed pull function as the source.
+// Function next is called each time the query needs a new element and must
+// return false when there are no more elements.
+//
+// Since next keeps its own state, every iteration of the query continues where
+// the previous one stopped. Use FromIterable to create a query that can be
+// iterated several times.
+func FromFunc(next func() (interface{}, bool)) Query {
+	return Query{
+		desc: "FromFunc",
+		Iterate: func() Iterator {
+			return next
+		},
+	}
+}
+
+// knownLength returns the length of a generated sequence with the specified
+// count of elements.
+func knownLength(count int) int {
+	if count < 0 {
+		return 0
+	}
+
+	return count
+}
+
+// fromIndex initializes a linq query with a source of known length that
+// supports random access through the index function.
+func fromIndex(length int, index func(int) interface{}) Query {
+	return Query{
+		length: func() int { return length },
+		index:  index,
+		Iterate: func() Iterator {
+			i := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = i < length
+				if ok {
+					item = index(i)
+					i++
+				}
 
-				index++
 				return
 			}
 		},
 	}
 }
+
+// isASCII reports whether s contains only ASCII characters, in which case
+// each of its bytes is a rune.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
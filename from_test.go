@@ -81,6 +81,52 @@ func TestFromString(t *testing.T) {
 	}
 }
 
+func TestFromRunes(t *testing.T) {
+	tests := []struct {
+		input  string
+		output []interface{}
+	}{
+		{"string", []interface{}{'s', 't', 'r', 'i', 'n', 'g'}},
+		{"héllo, 世界", []interface{}{'h', 'é', 'l', 'l', 'o', ',', ' ', '世', '界'}},
+		{"", []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := FromRunes(test.input)
+		if !validateQuery(q, test.output) {
+			t.Errorf("FromRunes(%v)=%v expected %v", test.input, toSlice(q), test.output)
+		}
+
+		if c := q.Count(); c != len(test.output) {
+			t.Errorf("FromRunes(%v).Count()=%v expected %v", test.input, c, len(test.output))
+		}
+
+		if len(test.output) > 2 {
+			if e := q.ElementAt(2); e != test.output[2] {
+				t.Errorf("FromRunes(%v).ElementAt(2)=%v expected %v", test.input, e, test.output[2])
+			}
+		}
+	}
+}
+
+func TestFromBytes(t *testing.T) {
+	s := []byte("abc")
+	w := []interface{}{byte('a'), byte('b'), byte('c')}
+
+	q := FromBytes(s)
+	if !validateQuery(q, w) {
+		t.Errorf("FromBytes(%v)=%v expected %v", s, toSlice(q), w)
+	}
+
+	if c := q.Count(); c != 3 {
+		t.Errorf("FromBytes(%v).Count()=%v expected 3", s, c)
+	}
+
+	if e := q.ElementAt(1); e != byte('b') {
+		t.Errorf("FromBytes(%v).ElementAt(1)=%v expected b", s, e)
+	}
+}
+
 func TestFromIterable(t *testing.T) {
 	s := foo{f1: 1, f2: true, f3: "string"}
 	w := []interface{}{1, true, "string"}
@@ -105,3 +151,106 @@ func TestRepeat(t *testing.T) {
 		t.Errorf("Repeat(1, 5)=%v expected %v", toSlice(q), w)
 	}
 }
+
+func TestEmptyGenerator(t *testing.T) {
+	w := []interface{}{}
+
+	if q := Empty(); !validateQuery(q, w) {
+		t.Errorf("Empty()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	w := []interface{}{1, 2, 4, 8, 16}
+
+	if q := Generate(1, func(i interface{}) interface{} {
+		return i.(int) * 2
+	}).Take(5); !validateQuery(q, w) {
+		t.Errorf("Generate(1, double).Take(5)=%v expected %v", toSlice(q), w)
+	}
+
+	type pair struct{ a, b int }
+	fib := []interface{}{0, 1, 1, 2, 3, 5, 8}
+
+	if q := GenerateT(pair{0, 1}, func(p pair) pair {
+		return pair{p.b, p.a + p.b}
+	}).SelectT(func(p pair) int {
+		return p.a
+	}).TakeWhileT(func(i int) bool {
+		return i < 10
+	}); !validateQuery(q, fib) {
+		t.Errorf("GenerateT(fibonacci)=%v expected %v", toSlice(q), fib)
+	}
+}
+
+func TestGenerateT_PanicWhenNextFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "GenerateT: parameter [nextFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		GenerateT(1, func(i, j int) int { return i + j })
+	})
+}
+
+func TestFromMapSorted(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{map[string]int{"c": 3, "a": 1, "b": 2}, []interface{}{KeyValue{"a", 1}, KeyValue{"b", 2}, KeyValue{"c", 3}}},
+		{map[int]bool{10: true, -1: false, 3: true}, []interface{}{KeyValue{-1, false}, KeyValue{3, true}, KeyValue{10, true}}},
+		{map[foo]int{{f1: 2}: 2, {f1: 1}: 1}, []interface{}{KeyValue{foo{f1: 1}, 1}, KeyValue{foo{f1: 2}, 2}}},
+		{map[string]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := FromMapSorted(test.input); !validateQuery(q, test.output) {
+			t.Errorf("FromMapSorted(%v)=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestFromFunc(t *testing.T) {
+	i := 0
+	next := func() (interface{}, bool) {
+		if i >= 3 {
+			return nil, false
+		}
+
+		i++
+		return i * 10, true
+	}
+
+	w := []interface{}{10, 20, 30}
+
+	if q := FromFunc(next); !validateQuery(q, w) {
+		t.Errorf("FromFunc()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	identity := func(i interface{}) interface{} { return i }
+
+	tests := []struct {
+		input Query
+		want  string
+	}{
+		{From(make([]int, 1000)).Where(func(interface{}) bool { return true }).Select(identity).OrderBy(identity).Take(10), "From(slice[1000]).Where.Select.OrderBy.Take(10)"},
+		{From([3]int{}).Skip(1).Reverse(), "From(array[3]).Skip(1).Reverse"},
+		{From(map[string]int{"a": 1}).Distinct(), "From(map[1]).Distinct"},
+		{Range(1, 5).OrderBy(identity).ThenByDescending(identity).Query, "Range(1, 5).OrderBy.ThenByDescending"},
+		{Concat(Empty(), FromString("ab")), "Concat(2)"},
+		{NewQuery(Empty().Iterate).Where(func(interface{}) bool { return true }), "Query.Where"},
+		{From([]int{1}).Where(func(interface{}) bool { return true }).Memoize().Take(2), "From(slice[1]).Where.Memoize.Take(2)"},
+		{Range(1, 5).MemoizeSpill(2).Query, "Range(1, 5).MemoizeSpill(2)"},
+		{Range(1, 5).Fork(2)[1].Skip(1), "Range(1, 5).Fork(2)[1].Skip(1)"},
+		{Range(1, 5).WithOptions(Options{FloatTolerance: 0.1}).Distinct(), "Range(1, 5).Distinct"},
+		{Range(1, 5).OrderBy(identity).Distinct().Query, "Range(1, 5).OrderBy.Distinct"},
+		{Range(1, 5).stopAtNonFinite("SumFloatsFinite", new(error)), "Range(1, 5).SumFloatsFinite"},
+		{new(OrderedMap).Query().Take(1), "OrderedMap.Query.Take(1)"},
+		{Query{}, "Query"},
+	}
+
+	for _, test := range tests {
+		if got := test.input.String(); got != test.want {
+			t.Errorf("String()=%q expected %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package linq
+
+import (
+	"container/list"
+	"container/ring"
+	"sync"
+)
+
+// FromSyncMap initializes a linq query with passed sync.Map as the source. The
+// elements of the query are of type KeyValue. Every iteration takes a snapshot
+// of the map with its Range method when it starts, so it is not affected by
+// concurrent updates made while the query is iterated, and the order of the
+// elements is unspecified like for a query created from a map.
+func FromSyncMap(source *sync.Map) Query {
+	return Query{
+		desc: "FromSyncMap",
+		Iterate: func() Iterator {
+			var items []interface{}
+			source.Range(func(key, value interface{}) bool {
+				items = append(items, KeyValue{Key: key, Value: value})
+				return true
+			})
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(items)
+				if ok {
+					item = items[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// FromList initializes a linq query with passed container/list as the source.
+// linq iterates over the values of the elements from the front to the back of
+// the list. The list is read lazily, so it must not be modified while the
+// query is iterated. Count returns the length of the list without iterating
+// over it.
+func FromList(source *list.List) Query {
+	return Query{
+		desc:   "FromList",
+		length: source.Len,
+		Iterate: func() Iterator {
+			e := source.Front()
+
+			return func() (item interface{}, ok bool) {
+				if e == nil {
+					return nil, false
+				}
+
+				item = e.Value
+				e = e.Next()
+				return item, true
+			}
+		},
+	}
+}
+
+// FromRing initializes a linq query with passed container/ring as the source.
+// linq iterates over the values of the elements of the ring once, starting
+// with source. A nil ring is empty. The ring must not be modified while the
+// query is iterated. Count returns the length of the ring without iterating
+// over it.
+func FromRing(source *ring.Ring) Query {
+	return Query{
+		desc:   "FromRing",
+		length: source.Len,
+		Iterate: func() Iterator {
+			r := source
+			n := source.Len()
+
+			return func() (item interface{}, ok bool) {
+				if n <= 0 {
+					return nil, false
+				}
+
+				item = r.Value
+				r = r.Next()
+				n--
+				return item, true
+			}
+		},
+	}
+}
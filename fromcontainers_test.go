@@ -0,0 +1,65 @@
+package linq
+
+import (
+	"container/list"
+	"container/ring"
+	"sync"
+	"testing"
+)
+
+func TestFromSyncMap(t *testing.T) {
+	var m sync.Map
+	m.Store("a", 1)
+	m.Store("b", 2)
+
+	q := FromSyncMap(&m).OrderBy(func(kv interface{}) interface{} {
+		return kv.(KeyValue).Key
+	}).Query
+
+	if w := []interface{}{KeyValue{"a", 1}, KeyValue{"b", 2}}; !validateQuery(q, w) {
+		t.Errorf("FromSyncMap()=%v expected %v", toSlice(q), w)
+	}
+
+	count := 0
+	FromSyncMap(&m).ForEach(func(interface{}) {
+		m.Store(count+10, count)
+		count++
+	})
+
+	if count != 2 {
+		t.Errorf("FromSyncMap() iterated over %d elements while the map was updated expected 2", count)
+	}
+}
+
+func TestFromList(t *testing.T) {
+	l := list.New()
+	l.PushBack(2)
+	l.PushBack(3)
+	l.PushFront(1)
+
+	q := FromList(l)
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) || q.Count() != 3 {
+		t.Errorf("FromList()=%v, Count()=%d expected %v, 3", toSlice(q), q.Count(), w)
+	}
+
+	if q := FromList(list.New()); !validateQuery(q, []interface{}{}) {
+		t.Errorf("FromList(empty)=%v expected []", toSlice(q))
+	}
+}
+
+func TestFromRing(t *testing.T) {
+	r := ring.New(3)
+	for i := 1; i <= 3; i++ {
+		r.Value = i
+		r = r.Next()
+	}
+
+	q := FromRing(r.Next())
+	if w := []interface{}{2, 3, 1}; !validateQuery(q, w) || q.Count() != 3 {
+		t.Errorf("FromRing()=%v, Count()=%d expected %v, 3", toSlice(q), q.Count(), w)
+	}
+
+	if q := FromRing(nil); !validateQuery(q, []interface{}{}) || q.Count() != 0 {
+		t.Errorf("FromRing(nil)=%v expected []", toSlice(q))
+	}
+}
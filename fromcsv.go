@@ -0,0 +1,79 @@
+package linq
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVOptions is a type that is used to configure how FromCSV reads and ToCSV
+// writes CSV data.
+type CSVOptions struct {
+	// Comma is the field delimiter. It defaults to ','.
+	Comma rune
+	// Comment, if not 0, is the comment character. Lines beginning with the
+	// Comment character are ignored.
+	Comment rune
+	// Header indicates that the first record holds the column names.
+	Header bool
+	// LazyQuotes allows quotes to appear in unquoted and quoted fields.
+	LazyQuotes bool
+	// TrimLeadingSpace makes leading white space in a field ignored.
+	TrimLeadingSpace bool
+	// Tag is the key of the struct tag ToCSV takes column names from. If it
+	// is empty, the names of the struct fields are used.
+	Tag string
+}
+
+// FromCSV initializes a linq query that lazily iterates over the records read
+// from r with encoding/csv.
+//
+// If opts.Header is false, the elements of the query are of type []string.
+// Otherwise the first record is used as the header and the elements are of
+// type map[string]string, keyed by column name.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading or parsing
+// fails, the iterator panics with the error returned by encoding/csv.
+func FromCSV(r io.Reader, opts CSVOptions) Query {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.Comment = opts.Comment
+	reader.LazyQuotes = opts.LazyQuotes
+	reader.TrimLeadingSpace = opts.TrimLeadingSpace
+
+	var header []string
+
+	return Query{
+		desc: "FromCSV",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				record, err := reader.Read()
+				if err == nil && opts.Header && header == nil {
+					header = record
+					record, err = reader.Read()
+				}
+
+				if err == io.EOF {
+					return nil, false
+				}
+
+				if err != nil {
+					panic(err)
+				}
+
+				if !opts.Header {
+					return record, true
+				}
+
+				m := make(map[string]string, len(header))
+				for i, name := range header {
+					m[name] = record[i]
+				}
+
+				return m, true
+			}
+		}),
+	}
+}
@@ -0,0 +1,36 @@
+package linq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromCSV(t *testing.T) {
+	tests := []struct {
+		input  string
+		opts   CSVOptions
+		output []interface{}
+	}{
+		{"a,b\n1,2\n", CSVOptions{}, []interface{}{[]string{"a", "b"}, []string{"1", "2"}}},
+		{"a;b\n# skipped\n1; \"2\"\n", CSVOptions{Comma: ';', Comment: '#', TrimLeadingSpace: true}, []interface{}{[]string{"a", "b"}, []string{"1", "2"}}},
+		{"name,age\nalice,30\nbob,25\n", CSVOptions{Header: true}, []interface{}{
+			map[string]string{"name": "alice", "age": "30"},
+			map[string]string{"name": "bob", "age": "25"},
+		}},
+		{"name,age\n", CSVOptions{Header: true}, nil},
+		{"", CSVOptions{}, nil},
+	}
+
+	for _, test := range tests {
+		if r := FromCSV(strings.NewReader(test.input), test.opts).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("FromCSV(%q)=%v expected %v", test.input, r, test.output)
+		}
+	}
+}
+
+func TestFromCSV_PanicWhenRecordIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "record on line 2: wrong number of fields", func() {
+		FromCSV(strings.NewReader("a,b\n1,2,3\n"), CSVOptions{Header: true}).Count()
+	})
+}
@@ -0,0 +1,54 @@
+package linq
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// FromJSONLines initializes a linq query that lazily decodes a stream of JSON
+// values, such as newline delimited JSON (JSON Lines), read from r.
+//
+// Function newElem is executed for each value to allocate the target it is
+// decoded into, and must return a pointer. The pointer returned by newElem is
+// the element of the query. If newElem is nil, values are decoded into
+// interface{} and the elements are of type map[string]interface{},
+// []interface{}, string, float64, bool or nil accordingly.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading or decoding
+// fails, the iterator panics with the error returned by encoding/json.
+func FromJSONLines(r io.Reader, newElem func() interface{}) Query {
+	decoder := json.NewDecoder(r)
+
+	return Query{
+		desc: "FromJSONLines",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				if newElem == nil {
+					err := decoder.Decode(&item)
+					if err == io.EOF {
+						return nil, false
+					}
+
+					if err != nil {
+						panic(err)
+					}
+
+					return item, true
+				}
+
+				item = newElem()
+				err := decoder.Decode(item)
+				if err == io.EOF {
+					return nil, false
+				}
+
+				if err != nil {
+					panic(err)
+				}
+
+				return item, true
+			}
+		}),
+	}
+}
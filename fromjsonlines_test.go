@@ -0,0 +1,42 @@
+package linq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromJSONLines(t *testing.T) {
+	type event struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	input := "{\"id\":1,\"name\":\"start\"}\n{\"id\":2,\"name\":\"stop\"}\n"
+	want := []interface{}{&event{1, "start"}, &event{2, "stop"}}
+
+	r := FromJSONLines(strings.NewReader(input), func() interface{} {
+		return new(event)
+	}).Results()
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("FromJSONLines(%q)=%v expected %v", input, r, want)
+	}
+
+	want = []interface{}{
+		map[string]interface{}{"id": float64(1), "name": "start"},
+		map[string]interface{}{"id": float64(2), "name": "stop"},
+	}
+	if r := FromJSONLines(strings.NewReader(input), nil).Results(); !reflect.DeepEqual(r, want) {
+		t.Errorf("FromJSONLines(%q, nil)=%v expected %v", input, r, want)
+	}
+
+	if c := FromJSONLines(strings.NewReader(""), nil).Count(); c != 0 {
+		t.Errorf("FromJSONLines(\"\").Count()=%v expected 0", c)
+	}
+}
+
+func TestFromJSONLines_PanicWhenValueIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "invalid character '}' looking for beginning of value", func() {
+		FromJSONLines(strings.NewReader("{\"id\":1}\n}\n"), nil).Count()
+	})
+}
@@ -0,0 +1,54 @@
+package linq
+
+import (
+	"bufio"
+	"io"
+)
+
+// FromLines initializes a linq query that lazily iterates over the lines read
+// from r. Line terminators are stripped from the elements, which are of type
+// string. Lines are read with bufio.Scanner, so a single line can not be
+// longer than bufio.MaxScanTokenSize; use FromLinesBuffer to read longer
+// lines.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading fails, the
+// iterator panics with the error returned by the reader.
+func FromLines(r io.Reader) Query {
+	return FromLinesBuffer(r, bufio.MaxScanTokenSize)
+}
+
+// FromLinesBuffer is like FromLines, but allows lines of up to maxLineSize
+// bytes.
+func FromLinesBuffer(r io.Reader, maxLineSize int) Query {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+
+	return FromScanner(scanner).describe("FromLinesBuffer")
+}
+
+// FromScanner initializes a linq query that lazily iterates over the tokens of
+// scanner, as split by its split function, such as bufio.ScanWords or a custom
+// function splitting on a delimiter. The elements are of type string.
+//
+// The scanner is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If scanning fails, the
+// iterator panics with the error returned by scanner.Err.
+func FromScanner(scanner *bufio.Scanner) Query {
+	return Query{
+		desc: "FromScanner",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				if scanner.Scan() {
+					return scanner.Text(), true
+				}
+
+				if err := scanner.Err(); err != nil {
+					panic(err)
+				}
+
+				return nil, false
+			}
+		}),
+	}
+}
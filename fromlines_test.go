@@ -0,0 +1,81 @@
+package linq
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFromLines(t *testing.T) {
+	tests := []struct {
+		input  string
+		output []interface{}
+	}{
+		{"foo\nbar\r\nbaz", []interface{}{"foo", "bar", "baz"}},
+		{"foo\n\nbar\n", []interface{}{"foo", "", "bar"}},
+		{"", []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := FromLines(strings.NewReader(test.input)); !validateQuery(q, test.output) {
+			t.Errorf("FromLines(%q)=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestFromLinesBuffer(t *testing.T) {
+	input := strings.Repeat("x", bufio.MaxScanTokenSize+1)
+
+	if r := FromLinesBuffer(strings.NewReader(input), 2*bufio.MaxScanTokenSize).First(); r != input {
+		t.Errorf("FromLinesBuffer() failed to read a long line")
+	}
+
+	mustPanicWithError(t, bufio.ErrTooLong.Error(), func() {
+		FromLines(strings.NewReader(input)).Count()
+	})
+}
+
+func TestFromLines_PanicWhenReaderFails(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		FromLines(iotest.ErrReader(errors.New("read failed"))).Count()
+	})
+}
+
+func TestFromScanner(t *testing.T) {
+	words := bufio.NewScanner(strings.NewReader("  the quick\tbrown\n fox "))
+	words.Split(bufio.ScanWords)
+
+	if q, w := FromScanner(words), []interface{}{"the", "quick", "brown", "fox"}; !validateQuery(q, w) {
+		t.Errorf("FromScanner(ScanWords)=%v expected %v", toSlice(q), w)
+	}
+
+	fields := bufio.NewScanner(strings.NewReader("a;b;;c"))
+	fields.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := strings.IndexByte(string(data), ';'); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	})
+
+	if q, w := FromScanner(fields), []interface{}{"a", "b", "", "c"}; !validateQuery(q, w) {
+		t.Errorf("FromScanner(custom split)=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestFromScanner_PanicWhenSplitFails(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("a b"))
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		return 0, nil, errors.New("split failed")
+	})
+
+	mustPanicWithError(t, "split failed", func() {
+		FromScanner(scanner).Count()
+	})
+}
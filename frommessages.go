@@ -0,0 +1,62 @@
+package linq
+
+import "context"
+
+// FromMessages initializes a linq query that consumes messages of a message
+// queue, such as a Kafka or SQS consumer, with at-least-once processing.
+//
+// Function fetch is called with ctx each time the query needs a new element
+// and all the messages of the previous batch have been consumed. It should
+// block until messages are available; empty batches are fetched again.
+//
+// A message is acknowledged by calling ack when the iterator is asked for the
+// next element, that is after the rest of the pipeline has processed it. A
+// message that is filtered out by a later operator is acknowledged too, but a
+// message whose processing panics, or that is the last one returned before the
+// iteration is abandoned, is not, so the queue delivers it again.
+//
+// The query ends when ctx is done, after acknowledging the last message; the
+// rest of the current batch is left unacknowledged. Like
+// a query created from a channel, it can be iterated only once. If fetch
+// returns an error other than the error of ctx, or ack returns an error, the
+// iterator panics with it.
+func FromMessages(ctx context.Context, fetch func(context.Context) ([]interface{}, error),
+	ack func(interface{}) error) Query {
+	var batch []interface{}
+	var pending interface{}
+	hasPending := false
+
+	return Query{
+		desc: "FromMessages",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				if hasPending {
+					hasPending = false
+					if err := ack(pending); err != nil {
+						panic(err)
+					}
+				}
+
+				for len(batch) == 0 || ctx.Err() != nil {
+					if ctx.Err() != nil {
+						return nil, false
+					}
+
+					var err error
+					batch, err = fetch(ctx)
+					if err != nil {
+						if ctx.Err() != nil && err == ctx.Err() {
+							return nil, false
+						}
+
+						panic(err)
+					}
+				}
+
+				item, batch = batch[0], batch[1:]
+				pending, hasPending = item, true
+				return item, true
+			}
+		}),
+	}
+}
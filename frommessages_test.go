@@ -0,0 +1,98 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeQueue is a message queue delivering batches of messages.
+type fakeQueue struct {
+	batches [][]interface{}
+	acked   []interface{}
+	cancel  context.CancelFunc
+}
+
+func (q *fakeQueue) fetch(ctx context.Context) ([]interface{}, error) {
+	if len(q.batches) == 0 {
+		q.cancel()
+		return nil, ctx.Err()
+	}
+
+	batch := q.batches[0]
+	q.batches = q.batches[1:]
+	return batch, nil
+}
+
+func (q *fakeQueue) ack(message interface{}) error {
+	q.acked = append(q.acked, message)
+	return nil
+}
+
+func TestFromMessages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := &fakeQueue{batches: [][]interface{}{{1, 2}, {}, {3}}, cancel: cancel}
+
+	var processed []interface{}
+	FromMessages(ctx, queue.fetch, queue.ack).
+		Where(func(i interface{}) bool { return i.(int) != 2 }).
+		ForEach(func(i interface{}) {
+			if From(queue.acked).Contains(i) {
+				t.Errorf("FromMessages() acknowledged %v before processing it", i)
+			}
+
+			processed = append(processed, i)
+		})
+
+	if w := []interface{}{1, 3}; !validateQuery(From(processed), w) {
+		t.Errorf("FromMessages() processed %v expected %v", processed, w)
+	}
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(From(queue.acked), w) {
+		t.Errorf("FromMessages() acknowledged %v expected %v", queue.acked, w)
+	}
+}
+
+func TestFromMessagesWithAbandonedIteration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := &fakeQueue{batches: [][]interface{}{{1, 2, 3}}, cancel: cancel}
+
+	if got := FromMessages(ctx, queue.fetch, queue.ack).Take(2).Results(); len(got) != 2 {
+		t.Errorf("FromMessages().Take(2)=%v expected 2 messages", got)
+	}
+
+	if w := []interface{}{1}; !validateQuery(From(queue.acked), w) {
+		t.Errorf("FromMessages().Take(2) acknowledged %v expected %v", queue.acked, w)
+	}
+}
+
+func TestFromMessagesWithDoneContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fetched := false
+	q := FromMessages(ctx, func(context.Context) ([]interface{}, error) {
+		fetched = true
+		return []interface{}{1}, nil
+	}, func(interface{}) error { return nil })
+
+	if n := q.Count(); n != 0 || fetched {
+		t.Errorf("FromMessages() with done context Count()=%d, fetched=%v expected 0, false", n, fetched)
+	}
+}
+
+func TestFromMessages_PanicWhenFetchFails(t *testing.T) {
+	mustPanicWithError(t, "fetch failed", func() {
+		FromMessages(context.Background(), func(context.Context) ([]interface{}, error) {
+			return nil, errors.New("fetch failed")
+		}, func(interface{}) error { return nil }).Count()
+	})
+}
+
+func TestFromMessages_PanicWhenAckFails(t *testing.T) {
+	mustPanicWithError(t, "ack failed", func() {
+		FromMessages(context.Background(), func(context.Context) ([]interface{}, error) {
+			return []interface{}{1, 2}, nil
+		}, func(interface{}) error { return errors.New("ack failed") }).Take(2).Count()
+	})
+}
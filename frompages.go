@@ -0,0 +1,46 @@
+package linq
+
+// FromPages initializes a linq query with a paginated API as the source.
+// Function fetch is called with the token of the page to fetch, starting with
+// an empty token, and returns the elements of the page and the token of the
+// next one, which is empty for the last page.
+//
+// Pages are fetched lazily: the first page is fetched when the first element
+// is requested and each following page only after the elements of the
+// previous one have been iterated over, so that
+//
+//	FromPages(fetch).Take(10)
+//
+// fetches only as many pages as needed for 10 elements. If fetch returns an
+// error, the iterator panics with it; use Catch or Materialize to handle it.
+// Each iteration of the query walks the pages again from the first one.
+func FromPages(fetch func(pageToken string) (items []interface{}, next string, err error)) Query {
+	return Query{
+		desc: "FromPages",
+		Iterate: func() Iterator {
+			var page []interface{}
+			token := ""
+			fetched, done := false, false
+
+			return func() (item interface{}, ok bool) {
+				for len(page) == 0 {
+					if done || (fetched && token == "") {
+						done = true
+						return nil, false
+					}
+
+					items, next, err := fetch(token)
+					if err != nil {
+						done = true
+						panic(err)
+					}
+
+					page, token, fetched = items, next, true
+				}
+
+				item, page = page[0], page[1:]
+				return item, true
+			}
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package linq
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFromPages(t *testing.T) {
+	pages := map[string][]interface{}{
+		"":  {1, 2},
+		"2": {},
+		"3": {3},
+	}
+	tokens := map[string]string{"": "2", "2": "3", "3": ""}
+
+	var fetched []string
+	fetch := func(token string) ([]interface{}, string, error) {
+		fetched = append(fetched, token)
+		return pages[token], tokens[token], nil
+	}
+
+	q := FromPages(fetch)
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("FromPages()=%v expected %v", toSlice(q), w)
+	}
+
+	fetched = nil
+	if w := []interface{}{1, 2}; !validateQuery(q.Take(2), w) || len(fetched) != 1 {
+		t.Errorf("FromPages().Take(2)=%v fetched %v expected %v fetched [\"\"]", toSlice(q.Take(2)), fetched, w)
+	}
+}
+
+func TestFromPagesWithError(t *testing.T) {
+	fetch := func(token string) ([]interface{}, string, error) {
+		if token == "" {
+			return []interface{}{1}, "next", nil
+		}
+
+		return nil, "", errors.New("page " + strconv.Quote(token) + " failed")
+	}
+
+	mustPanicWithError(t, `page "next" failed`, func() {
+		toSlice(FromPages(fetch))
+	})
+
+	var got error
+	q := FromPages(fetch).Catch(func(err error) Query {
+		got = err
+		return Empty()
+	})
+	if w := []interface{}{1}; !validateQuery(q, w) || got == nil {
+		t.Errorf("FromPages().Catch()=%v error %v expected %v and an error", toSlice(q), got, w)
+	}
+}
@@ -0,0 +1,49 @@
+package linq
+
+import (
+	"errors"
+	"io"
+)
+
+// FromReaderChunks initializes a linq query that lazily iterates over the
+// contents of r in blocks of chunkSize bytes. The elements are of type []byte
+// and every one of them is a new slice, so they can be kept after the
+// iteration moves on. All blocks but the last one hold exactly chunkSize
+// bytes. FromReaderChunks panics if chunkSize is not positive.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading fails, the
+// iterator panics with the error returned by the reader.
+func FromReaderChunks(r io.Reader, chunkSize int) Query {
+	if chunkSize <= 0 {
+		panic(errors.New("FromReaderChunks: non-positive chunk size"))
+	}
+
+	done := false
+
+	return Query{
+		desc: "FromReaderChunks",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				if done {
+					return nil, false
+				}
+
+				chunk := make([]byte, chunkSize)
+				n, err := io.ReadFull(r, chunk)
+				switch err {
+				case nil:
+					return chunk, true
+				case io.EOF:
+					done = true
+					return nil, false
+				case io.ErrUnexpectedEOF:
+					done = true
+					return chunk[:n:n], true
+				}
+
+				panic(err)
+			}
+		}),
+	}
+}
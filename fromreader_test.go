@@ -0,0 +1,62 @@
+package linq
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFromReaderChunks(t *testing.T) {
+	tests := []struct {
+		input  string
+		size   int
+		output []interface{}
+	}{
+		{"abcdefg", 3, []interface{}{"abc", "def", "g"}},
+		{"abcdef", 3, []interface{}{"abc", "def"}},
+		{"ab", 5, []interface{}{"ab"}},
+		{"", 5, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		r := iotest.OneByteReader(strings.NewReader(test.input))
+		q := FromReaderChunks(r, test.size).Select(func(b interface{}) interface{} {
+			return string(b.([]byte))
+		})
+
+		if !validateQuery(q, test.output) {
+			t.Errorf("FromReaderChunks(%q, %d)=%v expected %v", test.input, test.size, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestFromReaderChunksHashing(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	h := sha256.New()
+
+	FromReaderChunks(bytes.NewReader(data), 64).ForEach(func(chunk interface{}) {
+		h.Write(chunk.([]byte))
+	})
+
+	if got, want := h.Sum(nil), sha256.Sum256(data); !bytes.Equal(got, want[:]) {
+		t.Errorf("FromReaderChunks() hashed to %x expected %x", got, want)
+	}
+}
+
+func TestFromReaderChunks_PanicWhenReaderFails(t *testing.T) {
+	r := io.MultiReader(strings.NewReader("abc"), iotest.ErrReader(errors.New("read failed")))
+
+	mustPanicWithError(t, "read failed", func() {
+		FromReaderChunks(r, 2).Count()
+	})
+}
+
+func TestFromReaderChunks_PanicWhenChunkSizeIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "FromReaderChunks: non-positive chunk size", func() {
+		FromReaderChunks(strings.NewReader("abc"), 0)
+	})
+}
@@ -0,0 +1,60 @@
+package linq
+
+// RecordBatch is an interface that has to be implemented by a batch of rows of
+// a columnar format, such as an Apache Arrow record or a Parquet row group, to
+// be read with FromRecordBatches. Its method matches the NumRows method of
+// Arrow records.
+type RecordBatch interface {
+	NumRows() int64
+}
+
+// RecordBatchIterator is an interface that has to be implemented by a reader
+// of record batches to be read with FromRecordBatches. Like bufio.Scanner,
+// Next advances to the next batch and reports whether there is one, Batch
+// returns the current batch and Err returns the error that stopped Next, if
+// any.
+type RecordBatchIterator interface {
+	Next() bool
+	Batch() RecordBatch
+	Err() error
+}
+
+// FromRecordBatches initializes a linq query that lazily iterates over the
+// rows of the record batches returned by iter, so that columnar formats can be
+// consumed row by row by linq operators. Function rowBuilder is executed for
+// each row with the batch and the index of the row within it to build the
+// element of the query, for example by reading the values of the row from the
+// columns of the batch.
+//
+// A batch is only accessed until iter advances to the next one, so iter can
+// reuse or release it. Like a query created from a channel, the query can be
+// iterated only once. If iter stops with an error, the iterator panics with
+// it.
+func FromRecordBatches(iter RecordBatchIterator,
+	rowBuilder func(batch RecordBatch, row int) interface{}) Query {
+	var batch RecordBatch
+	var rows, row int
+
+	return Query{
+		desc: "FromRecordBatches",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				for row >= rows {
+					if !iter.Next() {
+						if err := iter.Err(); err != nil {
+							panic(err)
+						}
+
+						return nil, false
+					}
+
+					batch, rows, row = iter.Batch(), int(iter.Batch().NumRows()), 0
+				}
+
+				item = rowBuilder(batch, row)
+				row++
+				return item, true
+			}
+		}),
+	}
+}
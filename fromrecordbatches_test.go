@@ -0,0 +1,70 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+// columns is a record batch holding a column of names and a column of ages.
+type columns struct {
+	names []string
+	ages  []int
+}
+
+func (c *columns) NumRows() int64 {
+	return int64(len(c.names))
+}
+
+type batchReader struct {
+	batches []*columns
+	current *columns
+	err     error
+}
+
+func (r *batchReader) Next() bool {
+	if len(r.batches) == 0 {
+		return false
+	}
+
+	r.current, r.batches = r.batches[0], r.batches[1:]
+	return true
+}
+
+func (r *batchReader) Batch() RecordBatch {
+	return r.current
+}
+
+func (r *batchReader) Err() error {
+	return r.err
+}
+
+func TestFromRecordBatches(t *testing.T) {
+	reader := &batchReader{batches: []*columns{
+		{[]string{"a", "b"}, []int{1, 2}},
+		{},
+		{[]string{"c"}, []int{3}},
+	}}
+
+	q := FromRecordBatches(reader, func(batch RecordBatch, row int) interface{} {
+		c := batch.(*columns)
+		return KeyValue{c.names[row], c.ages[row]}
+	})
+
+	want := []interface{}{KeyValue{"a", 1}, KeyValue{"b", 2}, KeyValue{"c", 3}}
+	if !validateQuery(q, want) {
+		t.Errorf("FromRecordBatches()=%v expected %v", toSlice(q), want)
+	}
+}
+
+func TestFromRecordBatches_PanicWhenReaderFails(t *testing.T) {
+	reader := &batchReader{
+		batches: []*columns{{[]string{"a"}, []int{1}}},
+		err:     errors.New("corrupt file"),
+	}
+
+	mustPanicWithError(t, "corrupt file", func() {
+		FromRecordBatches(reader, func(batch RecordBatch, row int) interface{} {
+			return row
+		}).Results()
+	})
+}
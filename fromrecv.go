@@ -0,0 +1,64 @@
+package linq
+
+import "io"
+
+// FromRecvFunc initializes a linq query with passed receive function as the
+// source, adapting APIs shaped like the Recv method of a server-streaming gRPC
+// client, so that streamed responses can be filtered and transformed with linq
+// operators.
+//
+// Function recv is called each time the query needs a new element. When it
+// returns io.EOF, the query ends and recv is not called again; any other error
+// makes the iterator panic with it. Since the stream is consumed while the
+// query is iterated, like a query created from a channel, the query can be
+// iterated only once.
+func FromRecvFunc(recv func() (interface{}, error)) Query {
+	done := false
+
+	return Query{
+		desc: "FromRecvFunc",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				if done {
+					return nil, false
+				}
+
+				item, err := recv()
+				if err == io.EOF {
+					done = true
+					return nil, false
+				}
+
+				if err != nil {
+					panic(err)
+				}
+
+				return item, true
+			}
+		}),
+	}
+}
+
+// FromRecvFuncT is the typed version of FromRecvFunc.
+//
+//   - recvFn is of type "func() (TSource, error)", such as the Recv method
+//     value of a streaming gRPC client
+//
+// NOTE: FromRecvFunc has better performance than FromRecvFuncT.
+func FromRecvFuncT(recvFn interface{}) Query {
+	recvGenericFunc, err := newGenericFunc(
+		"FromRecvFuncT", "recvFn", recvFn,
+		simpleParamValidator(newElemTypeSlice(), newElemTypeSlice(new(genericType), new(error))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	recvFunc := func() (interface{}, error) {
+		out := recvGenericFunc.Cache.FnValue.Call(nil)
+		err, _ := out[1].Interface().(error)
+		return out[0].Interface(), err
+	}
+
+	return FromRecvFunc(recvFunc).describe("FromRecvFuncT")
+}
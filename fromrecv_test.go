@@ -0,0 +1,69 @@
+package linq
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+// fakeStream mimics a server-streaming gRPC client.
+type fakeStream struct {
+	items []string
+	err   error
+	calls int
+}
+
+func (s *fakeStream) Recv() (string, error) {
+	s.calls++
+	if len(s.items) == 0 {
+		if s.err != nil {
+			return "", s.err
+		}
+
+		return "", io.EOF
+	}
+
+	item := s.items[0]
+	s.items = s.items[1:]
+	return item, nil
+}
+
+func TestFromRecvFunc(t *testing.T) {
+	s := &fakeStream{items: []string{"a", "b", "c"}}
+	q := FromRecvFunc(func() (interface{}, error) { return s.Recv() })
+
+	if w := []interface{}{"a", "b", "c"}; !validateQuery(q, w) {
+		t.Errorf("FromRecvFunc()=%v expected %v", toSlice(q), w)
+	}
+
+	mustPanicWithError(t, ErrSourceExhausted.Error(), func() {
+		q.Results()
+	})
+
+	if s.calls != 4 {
+		t.Errorf("FromRecvFunc() called recv %d times after io.EOF expected 4", s.calls)
+	}
+}
+
+func TestFromRecvFunc_PanicWhenRecvFails(t *testing.T) {
+	s := &fakeStream{items: []string{"a"}, err: errors.New("stream reset")}
+
+	mustPanicWithError(t, "stream reset", func() {
+		FromRecvFunc(func() (interface{}, error) { return s.Recv() }).Results()
+	})
+}
+
+func TestFromRecvFuncT(t *testing.T) {
+	s := &fakeStream{items: []string{"a", "bb"}}
+	q := FromRecvFuncT(s.Recv).Select(func(i interface{}) interface{} { return len(i.(string)) })
+
+	if w := []interface{}{1, 2}; !validateQuery(q, w) {
+		t.Errorf("FromRecvFuncT()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestFromRecvFuncT_PanicWhenRecvFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "FromRecvFuncT: parameter [recvFn] has a invalid function signature. Expected: 'func()T,error', actual: 'func()string'", func() {
+		FromRecvFuncT(func() string { return "" })
+	})
+}
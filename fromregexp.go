@@ -0,0 +1,40 @@
+package linq
+
+import "regexp"
+
+// FromRegexpMatches initializes a linq query with the successive
+// non-overlapping matches of re in s. Elements of the query are of type
+// []string, holding the text of the match followed by the text of its
+// submatches, as returned by FindStringSubmatch. Submatches that did not
+// participate in the match are empty strings.
+//
+// The positions of the matches are located with FindAllStringSubmatchIndex
+// when the query is iterated, and the strings of each match are extracted only
+// when the element is requested.
+func FromRegexpMatches(re *regexp.Regexp, s string) Query {
+	return Query{
+		desc: "FromRegexpMatches",
+		Iterate: func() Iterator {
+			matches := re.FindAllStringSubmatchIndex(s, -1)
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(matches)
+				if ok {
+					loc := matches[index]
+					groups := make([]string, len(loc)/2)
+					for i := range groups {
+						if loc[2*i] >= 0 {
+							groups[i] = s[loc[2*i]:loc[2*i+1]]
+						}
+					}
+
+					item = groups
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package linq
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestFromRegexpMatches(t *testing.T) {
+	tests := []struct {
+		re     string
+		input  string
+		output []interface{}
+	}{
+		{`(\w+)=(\d+)`, "a=1, b=22, c=x", []interface{}{[]string{"a=1", "a", "1"}, []string{"b=22", "b", "22"}}},
+		{`(a)|(b)`, "ab", []interface{}{[]string{"a", "a", ""}, []string{"b", "", "b"}}},
+		{`\d`, "abc", nil},
+	}
+
+	for _, test := range tests {
+		if r := FromRegexpMatches(regexp.MustCompile(test.re), test.input).Results(); !reflect.DeepEqual(r, test.output) {
+			t.Errorf("FromRegexpMatches(%v, %q)=%v expected %v", test.re, test.input, r, test.output)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package linq
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FromSQLRows initializes a linq query that lazily iterates over the rows of a
+// result set. The elements of the query are of type map[string]interface{},
+// keyed by column name, holding the values returned by the driver.
+//
+// The rows are consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. The rows are closed
+// when the last one has been read. If reading fails, the iterator panics with
+// the error returned by database/sql.
+func FromSQLRows(rows *sql.Rows) Query {
+	return Query{
+		desc: "FromSQLRows",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				columns, ok := nextSQLRow(rows)
+				if !ok {
+					return nil, false
+				}
+
+				values := make([]interface{}, len(columns))
+				dest := make([]interface{}, len(columns))
+				for i := range values {
+					dest[i] = &values[i]
+				}
+
+				if err := rows.Scan(dest...); err != nil {
+					panic(err)
+				}
+
+				m := make(map[string]interface{}, len(columns))
+				for i, column := range columns {
+					m[column] = values[i]
+				}
+
+				return m, true
+			}
+		}),
+	}
+}
+
+// ScanStructs initializes a linq query that lazily iterates over the rows of a
+// result set and scans each of them into a new value of the struct type of
+// prototype, which is a struct or a pointer to struct. The elements of the
+// query are structs, or pointers to structs if prototype is a pointer.
+//
+// Columns are mapped to the exported fields of the struct by the name in the
+// struct tag with the specified key, e.g. "db", using the part before the first
+// comma. Fields tagged with "-" are skipped and fields without a tag name keep
+// their Go name. Names are matched ignoring case if no name matches exactly,
+// and columns without a matching field are discarded. The mapping is computed
+// once per struct type and column.
+//
+// Like FromSQLRows, the query can be iterated only once, the rows are closed
+// when the last one has been read, and the iterator panics with the error of
+// database/sql if reading or scanning fails. ScanStructs panics if prototype
+// is not a struct or a pointer to struct.
+func ScanStructs(rows *sql.Rows, prototype interface{}, tag string) Query {
+	t, pointer := structPrototype("ScanStructs", prototype)
+	var indexes [][]int
+
+	return Query{
+		desc: "ScanStructs",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				columns, ok := nextSQLRow(rows)
+				if !ok {
+					return nil, false
+				}
+
+				if indexes == nil {
+					indexes = make([][]int, len(columns))
+					for i, column := range columns {
+						indexes[i] = columnField(t, tag, column)
+					}
+				}
+
+				v := reflect.New(t)
+				dest := make([]interface{}, len(columns))
+				for i, index := range indexes {
+					if index == nil {
+						dest[i] = new(interface{})
+						continue
+					}
+
+					dest[i] = v.Elem().FieldByIndex(index).Addr().Interface()
+				}
+
+				if err := rows.Scan(dest...); err != nil {
+					panic(err)
+				}
+
+				if pointer {
+					return v.Interface(), true
+				}
+
+				return v.Elem().Interface(), true
+			}
+		}),
+	}
+}
+
+// structPrototype returns the struct type of prototype, which is a struct or a
+// pointer to struct, and whether it is a pointer. It panics with an error
+// naming method if prototype has another type.
+func structPrototype(method string, prototype interface{}) (t reflect.Type, pointer bool) {
+	t = reflect.TypeOf(prototype)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t, pointer = t.Elem(), true
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		panic(fmt.Errorf("%s: parameter [prototype] has an invalid type. Expected: 'struct or pointer to struct', actual: '%T'", method, prototype))
+	}
+
+	return t, pointer
+}
+
+// nextSQLRow advances rows to the next row and returns the names of its
+// columns. ok is false if there are no more rows, in which case rows are
+// closed. It panics if reading fails.
+func nextSQLRow(rows *sql.Rows) (columns []string, ok bool) {
+	if !rows.Next() {
+		err := rows.Err()
+		rows.Close()
+		if err != nil {
+			panic(err)
+		}
+
+		return nil, false
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		panic(err)
+	}
+
+	return columns, true
+}
+
+// columnKey identifies a column mapped to a field of a struct type.
+type columnKey struct {
+	t           reflect.Type
+	tag, column string
+}
+
+// columnFields caches the fields columns are mapped to by columnField.
+var columnFields sync.Map
+
+// columnField returns the index sequence of the field of struct type t that
+// the column with the specified name is mapped to by ScanStructs, or nil.
+func columnField(t reflect.Type, tag, column string) []int {
+	key := columnKey{t, tag, column}
+	if index, ok := columnFields.Load(key); ok {
+		return index.([]int)
+	}
+
+	var index []int
+	for _, f := range structFields(t, tag) {
+		if f.Name == column {
+			index = []int{f.Index}
+			break
+		}
+
+		if index == nil && strings.EqualFold(f.Name, column) {
+			index = []int{f.Index}
+		}
+	}
+
+	columnFields.Store(key, index)
+	return index
+}
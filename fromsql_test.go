@@ -0,0 +1,111 @@
+package linq
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+func queryRecordingDB(t *testing.T, failOn string, columns []string, rows ...[]driver.Value) (*sql.Rows, *recordingDriver) {
+	db, d := openRecordingDB(t, failOn)
+	d.columns, d.rows = columns, rows
+
+	r, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r, d
+}
+
+func TestFromSQLRows(t *testing.T) {
+	rows, d := queryRecordingDB(t, "", []string{"id", "name"},
+		[]driver.Value{int64(1), "Ann"},
+		[]driver.Value{int64(2), []byte("Bob")})
+
+	got := FromSQLRows(rows).Results()
+	want := []interface{}{
+		map[string]interface{}{"id": int64(1), "name": "Ann"},
+		map[string]interface{}{"id": int64(2), "name": []byte("Bob")},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromSQLRows()=%v expected %v", got, want)
+	}
+
+	if w := []string{"SELECT", "CLOSE"}; !reflect.DeepEqual(d.log, w) {
+		t.Errorf("FromSQLRows() driver calls %q expected %q", d.log, w)
+	}
+}
+
+func TestFromSQLRows_PanicWhenReadFails(t *testing.T) {
+	rows, _ := queryRecordingDB(t, "[2]", []string{"id"}, []driver.Value{int64(1)}, []driver.Value{int64(2)})
+
+	mustPanicWithError(t, "read failed", func() {
+		FromSQLRows(rows).Results()
+	})
+}
+
+func TestScanStructs(t *testing.T) {
+	type user struct {
+		ID       int64  `db:"user_id"`
+		Name     string `db:"name,notnull"`
+		Email    string
+		Password string `db:"-"`
+		private  string
+	}
+
+	columns := []string{"user_id", "NAME", "email", "password", "private", "extra"}
+	values := [][]driver.Value{
+		{int64(1), "Ann", "ann@example.com", "secret", "x", 1.5},
+		{int64(2), "Bob", "bob@example.com", "secret", "y", 2.5},
+	}
+
+	rows, _ := queryRecordingDB(t, "", columns, values...)
+	got := ScanStructs(rows, user{}, "db").Results()
+
+	want := []interface{}{
+		user{ID: 1, Name: "Ann", Email: "ann@example.com"},
+		user{ID: 2, Name: "Bob", Email: "bob@example.com"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanStructs(user{})=%+v expected %+v", got, want)
+	}
+
+	rows, _ = queryRecordingDB(t, "", []string{"user_id", "name"}, []driver.Value{int64(3), "Cid"})
+	var users []*user
+	ScanStructs(rows, &user{}, "db").ToSlice(&users)
+
+	if len(users) != 1 || *users[0] != (user{ID: 3, Name: "Cid"}) {
+		t.Errorf("ScanStructs(&user{})=%+v expected [&{ID:3 Name:Cid}]", users)
+	}
+}
+
+func TestScanStructs_PanicWhenScanFails(t *testing.T) {
+	type user struct {
+		ID int64
+	}
+
+	rows, _ := queryRecordingDB(t, "", []string{"id"}, []driver.Value{"not a number"})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("ScanStructs() expected a panic for a column that can't be scanned")
+		} else if _, ok := r.(error); !ok {
+			t.Errorf("ScanStructs() panicked with %v expected an error", r)
+		}
+	}()
+
+	ScanStructs(rows, user{}, "").Results()
+}
+
+func TestScanStructs_PanicWhenPrototypeIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ScanStructs: parameter [prototype] has an invalid type. Expected: 'struct or pointer to struct', actual: '<nil>'", func() {
+		ScanStructs(nil, nil, "")
+	})
+
+	mustPanicWithError(t, "ScanStructs: parameter [prototype] has an invalid type. Expected: 'struct or pointer to struct', actual: '*int'", func() {
+		ScanStructs(nil, new(int), "")
+	})
+}
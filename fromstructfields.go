@@ -0,0 +1,81 @@
+package linq
+
+import (
+	"reflect"
+	"strings"
+)
+
+// structField describes an exported field of a struct type.
+type structField struct {
+	Name  string
+	Index int
+}
+
+// structFields returns the exported fields of struct type t. If tag is not
+// empty, the name of a field is taken from the struct tag with that key, using
+// the part before the first comma like encoding/json does. Fields with the tag
+// value "-" are skipped and fields without a tag name keep their Go name.
+func structFields(t reflect.Type, tag string) []structField {
+	fields := make([]structField, 0, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		name := f.Name
+		if tag != "" {
+			value := f.Tag.Get(tag)
+			if value == "-" {
+				continue
+			}
+
+			if n := strings.Split(value, ",")[0]; n != "" {
+				name = n
+			}
+		}
+
+		fields = append(fields, structField{name, i})
+	}
+
+	return fields
+}
+
+// FromStructFields initializes a linq query with the exported fields of passed
+// struct, or pointer to struct, as the source. Elements of the query are of
+// type KeyValue, where Key is the name of a field and Value is its value.
+// Fields are iterated in declaration order.
+func FromStructFields(source interface{}) Query {
+	return FromStructFieldsTag(source, "")
+}
+
+// FromStructFieldsTag is like FromStructFields, but the names of the fields are
+// taken from the struct tag with the specified key, e.g. "json". Fields tagged
+// with "-" are skipped and fields without a tag keep their Go name.
+func FromStructFieldsTag(source interface{}, tag string) Query {
+	src := reflect.Indirect(reflect.ValueOf(source))
+	fields := structFields(src.Type(), tag)
+
+	return Query{
+		desc: "FromStructFieldsTag",
+		Iterate: func() Iterator {
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(fields)
+				if ok {
+					f := fields[index]
+					item = KeyValue{
+						Key:   f.Name,
+						Value: src.Field(f.Index).Interface(),
+					}
+
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
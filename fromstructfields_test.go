@@ -0,0 +1,31 @@
+package linq
+
+import "testing"
+
+func TestFromStructFields(t *testing.T) {
+	type user struct {
+		Name     string `db:"user_name" json:"name,omitempty"`
+		Age      int    `json:"-"`
+		Admin    bool
+		password string
+	}
+
+	u := user{"alice", 30, true, "secret"}
+
+	tests := []struct {
+		input  Query
+		output []interface{}
+	}{
+		{FromStructFields(u), []interface{}{KeyValue{"Name", "alice"}, KeyValue{"Age", 30}, KeyValue{"Admin", true}}},
+		{FromStructFields(&u), []interface{}{KeyValue{"Name", "alice"}, KeyValue{"Age", 30}, KeyValue{"Admin", true}}},
+		{FromStructFieldsTag(u, "json"), []interface{}{KeyValue{"name", "alice"}, KeyValue{"Admin", true}}},
+		{FromStructFieldsTag(u, "db"), []interface{}{KeyValue{"user_name", "alice"}, KeyValue{"Age", 30}, KeyValue{"Admin", true}}},
+		{FromStructFields(struct{}{}), []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if !validateQuery(test.input, test.output) {
+			t.Errorf("FromStructFields()=%v expected %v", toSlice(test.input), test.output)
+		}
+	}
+}
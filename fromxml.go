@@ -0,0 +1,52 @@
+package linq
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// FromXML initializes a linq query that lazily streams the XML elements with
+// the specified local name read from r. Elements are matched at any depth of
+// the document; an element nested inside a matching element is decoded as part
+// of its parent.
+//
+// Function newElem is executed for each matching element to allocate the
+// target it is decoded into with encoding/xml, and must return a pointer. The
+// pointer returned by newElem is the element of the query.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading or decoding
+// fails, the iterator panics with the error returned by encoding/xml.
+func FromXML(r io.Reader, localName string, newElem func() interface{}) Query {
+	decoder := xml.NewDecoder(r)
+
+	return Query{
+		desc: "FromXML",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				for {
+					token, err := decoder.Token()
+					if err == io.EOF {
+						return nil, false
+					}
+
+					if err != nil {
+						panic(err)
+					}
+
+					start, isStart := token.(xml.StartElement)
+					if !isStart || start.Name.Local != localName {
+						continue
+					}
+
+					item = newElem()
+					if err := decoder.DecodeElement(item, &start); err != nil {
+						panic(err)
+					}
+
+					return item, true
+				}
+			}
+		}),
+	}
+}
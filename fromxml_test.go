@@ -0,0 +1,43 @@
+package linq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFromXML(t *testing.T) {
+	type book struct {
+		ID    int    `xml:"id,attr"`
+		Title string `xml:"title"`
+	}
+
+	input := `<?xml version="1.0"?>
+<library>
+	<shelf>
+		<book id="1"><title>Go</title></book>
+		<book id="2"><title>LINQ</title></book>
+	</shelf>
+	<book id="3"><title>XML</title></book>
+</library>`
+	want := []interface{}{&book{1, "Go"}, &book{2, "LINQ"}, &book{3, "XML"}}
+
+	r := FromXML(strings.NewReader(input), "book", func() interface{} {
+		return new(book)
+	}).Results()
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("FromXML()=%v expected %v", r, want)
+	}
+
+	if c := FromXML(strings.NewReader(input), "magazine", func() interface{} {
+		return new(book)
+	}).Count(); c != 0 {
+		t.Errorf("FromXML(magazine).Count()=%v expected 0", c)
+	}
+}
+
+func TestFromXML_PanicWhenDocumentIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "XML syntax error on line 1: unexpected EOF", func() {
+		FromXML(strings.NewReader("<library><book>"), "magazine", nil).Count()
+	})
+}
@@ -0,0 +1,127 @@
+package linq
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// FromYAMLDocuments initializes a linq query that lazily iterates over the
+// documents of a YAML stream read from r, such as a bundle of Kubernetes
+// manifests, separated by "---" lines and optionally ended by "..." lines.
+//
+// Each document is decoded with unmarshal, which is the Unmarshal function of
+// the YAML library of your choice, e.g. yaml.Unmarshal of gopkg.in/yaml.v3, so
+// that this package doesn't depend on one. If prototype is nil, documents are
+// decoded into map[string]interface{}; otherwise they are decoded into a new
+// value of the type of prototype, and the elements are of that type, or
+// pointers to it if prototype is a pointer. Documents holding only blank lines
+// and comments are skipped.
+//
+// The reader is consumed while the query is iterated, so like a query created
+// from a channel, the query can be iterated only once. If reading or decoding
+// fails, the iterator panics with the error.
+func FromYAMLDocuments(r io.Reader, unmarshal func(data []byte, v interface{}) error,
+	prototype interface{}) Query {
+	t := reflect.TypeOf(map[string]interface{}{})
+	pointer := false
+	if prototype != nil {
+		t = reflect.TypeOf(prototype)
+		if pointer = t.Kind() == reflect.Ptr; pointer {
+			t = t.Elem()
+		}
+	}
+
+	docs := &yamlDocuments{reader: bufio.NewReader(r)}
+
+	return Query{
+		desc: "FromYAMLDocuments",
+		Iterate: singleUse(func() Iterator {
+			return func() (item interface{}, ok bool) {
+				for {
+					doc, ok := docs.next()
+					if !ok {
+						return nil, false
+					}
+
+					if isBlankYAML(doc) {
+						continue
+					}
+
+					v := reflect.New(t)
+					if err := unmarshal(doc, v.Interface()); err != nil {
+						panic(err)
+					}
+
+					if pointer {
+						return v.Interface(), true
+					}
+
+					return v.Elem().Interface(), true
+				}
+			}
+		}),
+	}
+}
+
+// yamlDocuments splits a YAML stream into documents.
+type yamlDocuments struct {
+	reader *bufio.Reader
+
+	// carry is the content following the last "---" marker on its line, such
+	// as a tag, which is the first line of the next document.
+	carry string
+	eof   bool
+}
+
+// next returns the lines of the stream up to the next document separator, and
+// false if the end of the stream has been reached. It panics if reading fails.
+func (d *yamlDocuments) next() ([]byte, bool) {
+	if d.eof {
+		return nil, false
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(d.carry)
+	d.carry = ""
+
+	for {
+		line, err := d.reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			panic(err)
+		}
+
+		trimmed := strings.TrimRight(line, " \t\r\n")
+		switch {
+		case trimmed == "---" || strings.HasPrefix(trimmed, "--- ") || strings.HasPrefix(trimmed, "---\t"):
+			if rest := strings.TrimSpace(trimmed[3:]); !strings.HasPrefix(rest, "#") {
+				d.carry = rest + "\n"
+			}
+
+			d.eof = err == io.EOF && strings.TrimSpace(d.carry) == ""
+			return buf.Bytes(), true
+		case trimmed == "...":
+			d.eof = err == io.EOF
+			return buf.Bytes(), true
+		}
+
+		buf.WriteString(line)
+		if err == io.EOF {
+			d.eof = true
+			return buf.Bytes(), true
+		}
+	}
+}
+
+// isBlankYAML reports whether doc holds only blank lines and comments.
+func isBlankYAML(doc []byte) bool {
+	for _, line := range strings.Split(string(doc), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package linq
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// JSON is a subset of YAML, so encoding/json stands in for a YAML library.
+
+func TestFromYAMLDocuments(t *testing.T) {
+	input := strings.Join([]string{
+		"# bundle",
+		"---",
+		`{"kind": "Service",`,
+		` "name": "web"}`,
+		"--- # second",
+		`{"kind": "Deployment", "name": "web"}`,
+		"...",
+		"---",
+		"# only a comment",
+		`--- {"kind": "ConfigMap", "name": "cfg"}`,
+	}, "\n")
+
+	q := FromYAMLDocuments(strings.NewReader(input), json.Unmarshal, nil)
+	want := []interface{}{
+		map[string]interface{}{"kind": "Service", "name": "web"},
+		map[string]interface{}{"kind": "Deployment", "name": "web"},
+		map[string]interface{}{"kind": "ConfigMap", "name": "cfg"},
+	}
+
+	if got := q.Results(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FromYAMLDocuments()=%v expected %v", got, want)
+	}
+}
+
+func TestFromYAMLDocumentsWithPrototype(t *testing.T) {
+	type manifest struct {
+		Kind string `json:"kind"`
+	}
+
+	input := "{\"kind\": \"Service\"}\n---\n{\"kind\": \"Secret\"}\n"
+
+	var values []manifest
+	FromYAMLDocuments(strings.NewReader(input), json.Unmarshal, manifest{}).ToSlice(&values)
+	if w := []manifest{{"Service"}, {"Secret"}}; !reflect.DeepEqual(values, w) {
+		t.Errorf("FromYAMLDocuments(manifest{})=%v expected %v", values, w)
+	}
+
+	var pointers []*manifest
+	FromYAMLDocuments(strings.NewReader(input), json.Unmarshal, &manifest{}).ToSlice(&pointers)
+	if len(pointers) != 2 || pointers[1].Kind != "Secret" {
+		t.Errorf("FromYAMLDocuments(&manifest{})=%v expected 2 pointers", pointers)
+	}
+}
+
+func TestFromYAMLDocuments_PanicWhenDecodingFails(t *testing.T) {
+	mustPanicWithError(t, "invalid character 'n' looking for beginning of object key string", func() {
+		FromYAMLDocuments(strings.NewReader("{}\n---\n{not json}\n"), json.Unmarshal, nil).Results()
+	})
+}
+
+func TestFromYAMLDocuments_PanicWhenReaderFails(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		FromYAMLDocuments(iotest.ErrReader(errors.New("read failed")), json.Unmarshal, nil).Results()
+	})
+}
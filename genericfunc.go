@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 // genericType represents a any reflect.Type.
@@ -28,9 +29,16 @@ type genericFunc struct {
 
 // Call calls a dynamic function.
 func (g *genericFunc) Call(params ...interface{}) interface{} {
-	paramsIn := make([]reflect.Value, len(params))
-	for i, param := range params {
-		paramsIn[i] = reflect.ValueOf(param)
+	// Typed functions take at most a few parameters, so keep them in an
+	// array on the stack instead of allocating a slice on every call.
+	var buf [3]reflect.Value
+	paramsIn := buf[:0]
+	if len(params) > len(buf) {
+		paramsIn = make([]reflect.Value, 0, len(params))
+	}
+
+	for _, param := range params {
+		paramsIn = append(paramsIn, reflect.ValueOf(param))
 	}
 	paramsOut := g.Cache.FnValue.Call(paramsIn)
 	if len(paramsOut) >= 1 {
@@ -39,17 +47,59 @@ func (g *genericFunc) Call(params ...interface{}) interface{} {
 	return nil
 }
 
+// genericFuncKey identifies a validated function signature in
+// genericFuncCache.
+type genericFuncKey struct {
+	MethodName string
+	ParamName  string
+	FnType     reflect.Type
+}
+
+// genericFuncEntry is the result of validating a function signature.
+type genericFuncEntry struct {
+	Cache *functionCache
+	Err   error
+}
+
+// genericFuncCache keeps the validated signatures of the functions passed to
+// typed methods, so that queries built repeatedly with functions of the same
+// type don't rebuild the reflection metadata. Entries are keyed by the
+// function type rather than the function value, as distinct closures share
+// the same code.
+var genericFuncCache sync.Map
+
 // newGenericFunc instantiates a new genericFunc pointer
 func newGenericFunc(methodName, paramName string, fn interface{}, validateFunc func(*functionCache) error) (*genericFunc, error) {
-	cache := &functionCache{}
-	cache.FnValue = reflect.ValueOf(fn)
+	fnValue := reflect.ValueOf(fn)
+
+	if fnValue.Kind() != reflect.Func {
+		return nil, fmt.Errorf("%s: parameter [%s] is not a function type. It is a '%s'", methodName, paramName, fnValue.Type())
+	}
+
+	key := genericFuncKey{methodName, paramName, fnValue.Type()}
+	entry, ok := genericFuncCache.Load(key)
+	if !ok {
+		cache, err := buildFunctionCache(methodName, paramName, fnValue.Type(), validateFunc)
+		entry, _ = genericFuncCache.LoadOrStore(key, &genericFuncEntry{cache, err})
+	}
 
-	if cache.FnValue.Kind() != reflect.Func {
-		return nil, fmt.Errorf("%s: parameter [%s] is not a function type. It is a '%s'", methodName, paramName, cache.FnValue.Type())
+	e := entry.(*genericFuncEntry)
+	if e.Err != nil {
+		return nil, e.Err
 	}
+
+	cache := *e.Cache
+	cache.FnValue = fnValue
+	return &genericFunc{Cache: &cache}, nil
+}
+
+// buildFunctionCache builds and validates the reflection metadata of a
+// function type.
+func buildFunctionCache(methodName, paramName string, fnType reflect.Type, validateFunc func(*functionCache) error) (*functionCache, error) {
+	cache := &functionCache{}
 	cache.MethodName = methodName
 	cache.ParamName = paramName
-	cache.FnType = cache.FnValue.Type()
+	cache.FnType = fnType
 	numTypesIn := cache.FnType.NumIn()
 	cache.TypesIn = make([]reflect.Type, numTypesIn)
 	for i := 0; i < numTypesIn; i++ {
@@ -65,7 +115,7 @@ func newGenericFunc(methodName, paramName string, fn interface{}, validateFunc f
 		return nil, err
 	}
 
-	return &genericFunc{Cache: cache}, nil
+	return cache, nil
 }
 
 // simpleParamValidator creates a function to validate genericFunc based in the
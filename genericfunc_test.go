@@ -128,3 +128,47 @@ func TestCall(t *testing.T) {
 		}()
 	}
 }
+
+func TestNewGenericFunc_CachesSignatureNotFunction(t *testing.T) {
+	validator := simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType)))
+
+	newAdder := func(n int) func(int) int {
+		return func(i int) int { return i + n }
+	}
+
+	add1, err := newGenericFunc("TestCacheAdder", "fn", newAdder(1), validator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	add2, err := newGenericFunc("TestCacheAdder", "fn", newAdder(2), validator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if add1.Cache.FnType != add2.Cache.FnType || &add1.Cache.TypesIn[0] != &add2.Cache.TypesIn[0] {
+		t.Errorf("newGenericFunc() expected to reuse the cached signature")
+	}
+
+	if r1, r2 := add1.Call(10), add2.Call(10); r1 != 11 || r2 != 12 {
+		t.Errorf("Call(10)=%v,%v expected 11,12", r1, r2)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := newGenericFunc("TestCacheAdder", "fn", func(i, j int) int { return i }, validator)
+		if err == nil || err.Error() != "TestCacheAdder: parameter [fn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'" {
+			t.Errorf("newGenericFunc() returned %v on attempt #%d", err, i)
+		}
+	}
+}
+
+func TestCall_WithManyParams(t *testing.T) {
+	sum, err := newGenericFunc("TestCallWithManyParams", "fn", func(a, b, c, d int) int { return a + b + c + d }, simpleParamValidator(nil, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := sum.Call(1, 2, 3, 4); r != 10 {
+		t.Errorf("Call(1, 2, 3, 4)=%v expected 10", r)
+	}
+}
@@ -1,20 +1,43 @@
 package linq
 
+import "sort"
+
 // Group is a type that is used to store the result of GroupBy method.
 type Group struct {
 	Key   interface{}
 	Group []interface{}
 }
 
+// Count returns the number of elements in the group.
+func (g Group) Count() int {
+	return len(g.Group)
+}
+
+// ToQuery returns a query that iterates over the elements of the group.
+func (g Group) ToQuery() Query {
+	return From(g.Group).describe("Group")
+}
+
+// KeyString returns the key of the group, which has to be a string.
+func (g Group) KeyString() string {
+	return g.Key.(string)
+}
+
+// KeyInt returns the key of the group, which has to be an int.
+func (g Group) KeyInt() int {
+	return g.Key.(int)
+}
+
 // GroupBy method groups the elements of a collection according to a specified
 // key selector function and projects the elements for each group by using a
 // specified function.
 func (q Query) GroupBy(keySelector func(interface{}) interface{},
 	elementSelector func(interface{}) interface{}) Query {
 	return Query{
-		func() Iterator {
+		desc: q.chain("GroupBy"),
+		Iterate: func() Iterator {
 			next := q.Iterate()
-			set := make(map[interface{}][]interface{})
+			set := make(map[interface{}][]interface{}, q.capacity())
 
 			for item, ok := next(); ok; item, ok = next() {
 				key := keySelector(item)
@@ -79,3 +102,197 @@ func (q Query) GroupByT(keySelectorFn interface{},
 
 	return q.GroupBy(keySelectorFunc, elementSelectorFunc)
 }
+
+// GroupBySorted groups the elements of a collection according to a specified
+// key selector function, like GroupBy, but returns the groups in ascending
+// order of keys, as determined by keyLess, so reports built from them are
+// deterministic. The elements of each group are kept in their original order.
+//
+// If keyLess is nil, keys are compared like in OrderBy, so they have to be of
+// a basic type or implement Comparable interface. Groups with keys that are
+// neither less nor greater than each other are returned in the order their
+// keys first appear in the collection.
+func (q Query) GroupBySorted(keySelector func(interface{}) interface{},
+	keyLess func(interface{}, interface{}) bool) Query {
+	return Query{
+		desc: q.chain("GroupBySorted"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			set := make(map[interface{}][]interface{}, q.capacity())
+			var keys []interface{}
+
+			for item, ok := next(); ok; item, ok = next() {
+				key := keySelector(item)
+				if _, has := set[key]; !has {
+					keys = append(keys, key)
+				}
+
+				set[key] = append(set[key], item)
+			}
+
+			less := keyLess
+			if less == nil && len(keys) > 0 {
+				compare := q.comparer(keys[0])
+				less = func(a, b interface{}) bool { return compare(a, b) < 0 }
+			}
+
+			sort.SliceStable(keys, func(i, j int) bool {
+				return less(keys[i], keys[j])
+			})
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(keys)
+				if ok {
+					key := keys[index]
+					item = Group{key, set[key]}
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// GroupBySortedT is the typed version of GroupBySorted.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - keyLessFn is of type "func(TKey, TKey) bool", or nil
+//
+// NOTE: GroupBySorted has better performance than GroupBySortedT.
+func (q Query) GroupBySortedT(keySelectorFn interface{},
+	keyLessFn interface{}) Query {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"GroupBySortedT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	if keyLessFn == nil {
+		return q.GroupBySorted(keySelectorFunc, nil)
+	}
+
+	keyLessGenericFunc, err := newGenericFunc(
+		"GroupBySortedT", "keyLessFn", keyLessFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keyLessFunc := func(a, b interface{}) bool {
+		return keyLessGenericFunc.Call(a, b).(bool)
+	}
+
+	return q.GroupBySorted(keySelectorFunc, keyLessFunc)
+}
+
+// GroupByFold groups the elements of a collection according to a specified
+// key selector function and folds the elements of each group into a single
+// value, returning a KeyValue with the key and the folded value of each group
+// in the order the keys first appear in the collection.
+//
+// The value of each group starts with the result of seedFactory, which is
+// called once per group, and folder is called with the value of the group and
+// each of its elements to compute the new value. Unlike GroupBy followed by
+// Aggregate, no slices of grouped elements are built. For example, to total
+// the amounts of orders by customer:
+//
+//	From(orders).GroupByFold(
+//		func(o interface{}) interface{} { return o.(Order).Customer },
+//		func() interface{} { return 0.0 },
+//		func(total, o interface{}) interface{} {
+//			return total.(float64) + o.(Order).Amount
+//		})
+func (q Query) GroupByFold(keySelector func(interface{}) interface{},
+	seedFactory func() interface{},
+	folder func(interface{}, interface{}) interface{}) Query {
+	return Query{
+		desc: q.chain("GroupByFold"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			positions := make(map[interface{}]int)
+			var results []KeyValue
+
+			for item, ok := next(); ok; item, ok = next() {
+				key := keySelector(item)
+				position, has := positions[key]
+				if !has {
+					position = len(results)
+					positions[key] = position
+					results = append(results, KeyValue{key, seedFactory()})
+				}
+
+				results[position].Value = folder(results[position].Value, item)
+			}
+
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(results)
+				if ok {
+					item = results[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// GroupByFoldT is the typed version of GroupByFold.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//   - seedFactoryFn is of type "func() TAccumulate"
+//   - folderFn is of type "func(TAccumulate, TSource) TAccumulate"
+//
+// NOTE: GroupByFold has better performance than GroupByFoldT.
+func (q Query) GroupByFoldT(keySelectorFn interface{},
+	seedFactoryFn interface{}, folderFn interface{}) Query {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"GroupByFoldT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	seedFactoryGenericFunc, err := newGenericFunc(
+		"GroupByFoldT", "seedFactoryFn", seedFactoryFn,
+		simpleParamValidator(newElemTypeSlice(), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	seedFactoryFunc := func() interface{} {
+		return seedFactoryGenericFunc.Call()
+	}
+
+	folderGenericFunc, err := newGenericFunc(
+		"GroupByFoldT", "folderFn", folderFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	folderFunc := func(acc interface{}, item interface{}) interface{} {
+		return folderGenericFunc.Call(acc, item)
+	}
+
+	return q.GroupByFold(keySelectorFunc, seedFactoryFunc, folderFunc)
+}
@@ -57,3 +57,122 @@ func TestGroupByT_PanicWhenElementSelectorFnIsInvalid(t *testing.T) {
 		).ToSlice(&r)
 	})
 }
+
+func TestGroupBySorted(t *testing.T) {
+	input := []string{"pear", "fig", "apple", "kiwi", "plum", "banana"}
+	want := []interface{}{
+		Group{3, []interface{}{"fig"}},
+		Group{4, []interface{}{"pear", "kiwi", "plum"}},
+		Group{5, []interface{}{"apple"}},
+		Group{6, []interface{}{"banana"}},
+	}
+
+	q := From(input).GroupBySorted(func(i interface{}) interface{} {
+		return len(i.(string))
+	}, nil)
+
+	if r := toSlice(q); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).GroupBySorted()=%v expected %v", input, r, want)
+	}
+
+	desc := func(a, b interface{}) bool { return a.(byte) > b.(byte) }
+	want = []interface{}{
+		Group{byte('p'), []interface{}{"pear", "plum"}},
+		Group{byte('k'), []interface{}{"kiwi"}},
+		Group{byte('f'), []interface{}{"fig"}},
+		Group{byte('b'), []interface{}{"banana"}},
+		Group{byte('a'), []interface{}{"apple"}},
+	}
+
+	q = From(input).GroupBySortedT(func(s string) byte { return s[0] }, desc)
+	if r := toSlice(q); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).GroupBySortedT()=%v expected %v", input, r, want)
+	}
+
+	if q := Empty().GroupBySorted(nil, nil); !validateQuery(q, []interface{}{}) {
+		t.Errorf("Empty().GroupBySorted()=%v expected []", toSlice(q))
+	}
+}
+
+func TestGroupBySortedT_PanicWhenKeyLessFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "GroupBySortedT: parameter [keyLessFn] has a invalid function signature. Expected: 'func(T,T)bool', actual: 'func(int,int)int'", func() {
+		From([]int{1, 2}).GroupBySortedT(func(i int) int { return i }, func(a, b int) int { return a - b })
+	})
+}
+
+func TestGroupMethods(t *testing.T) {
+	g := Group{Key: "odd", Group: []interface{}{1, 3, 5}}
+
+	if g.Count() != 3 || g.KeyString() != "odd" {
+		t.Errorf("Group.Count()=%d, KeyString()=%s expected 3, odd", g.Count(), g.KeyString())
+	}
+
+	if q := g.ToQuery(); !validateQuery(q, []interface{}{1, 3, 5}) || q.Count() != 3 {
+		t.Errorf("Group.ToQuery()=%v expected [1 3 5]", toSlice(q))
+	}
+
+	sums := Range(1, 6).GroupBySorted(func(i interface{}) interface{} {
+		return i.(int) % 3
+	}, nil).Select(func(i interface{}) interface{} {
+		g := i.(Group)
+		return int64(g.KeyInt()*100) + g.ToQuery().SumInts()
+	})
+
+	if w := []interface{}{int64(9), int64(105), int64(207)}; !validateQuery(sums, w) {
+		t.Errorf("Group.KeyInt() and ToQuery() sums=%v expected %v", toSlice(sums), w)
+	}
+}
+
+func TestGroupByFold(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	want := []interface{}{
+		KeyValue{1, 1 + 3 + 5 + 7 + 9},
+		KeyValue{0, 2 + 4 + 6 + 8},
+	}
+
+	q := From(input).GroupByFold(
+		func(i interface{}) interface{} { return i.(int) % 2 },
+		func() interface{} { return 0 },
+		func(acc, i interface{}) interface{} { return acc.(int) + i.(int) })
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).GroupByFold()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From([]int{}).GroupByFold(
+		func(i interface{}) interface{} { return i },
+		func() interface{} { return 0 },
+		func(acc, i interface{}) interface{} { return acc }); !validateQuery(q, []interface{}{}) {
+		t.Errorf("From([]).GroupByFold()=%v expected []", toSlice(q))
+	}
+}
+
+func TestGroupByFoldT(t *testing.T) {
+	input := []string{"a", "bb", "cc", "d"}
+	want := []interface{}{KeyValue{1, "ad"}, KeyValue{2, "bbcc"}}
+
+	q := From(input).GroupByFoldT(
+		func(s string) int { return len(s) },
+		func() string { return "" },
+		func(acc, s string) string { return acc + s })
+	if !validateQuery(q, want) {
+		t.Errorf("From(%v).GroupByFoldT()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestGroupByFoldT_PanicWhenSeedFactoryFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "GroupByFoldT: parameter [seedFactoryFn] has a invalid function signature. Expected: 'func()T', actual: 'func(int)int'", func() {
+		From([]int{1}).GroupByFoldT(
+			func(i int) int { return i },
+			func(i int) int { return i },
+			func(acc, i int) int { return acc + i })
+	})
+}
+
+func TestGroupByFoldT_PanicWhenFolderFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "GroupByFoldT: parameter [folderFn] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int)int'", func() {
+		From([]int{1}).GroupByFoldT(
+			func(i int) int { return i },
+			func() int { return 0 },
+			func(i int) int { return i })
+	})
+}
@@ -0,0 +1,245 @@
+package linq
+
+// GroupAggregates is the type of the elements of the query returned by
+// GroupedQuery.Query. It holds the key of a group and the values of the
+// aggregates computed over its elements, by the names they were added with.
+type GroupAggregates struct {
+	Key        interface{}
+	Aggregates map[string]interface{}
+}
+
+// groupAggregator computes one aggregate of the elements of a group.
+type groupAggregator struct {
+	name string
+
+	// add returns the new state of the aggregate after the element item,
+	// given the state after the previous elements, which is nil for the
+	// first element.
+	add func(state, item interface{}) interface{}
+
+	// result returns the value of the aggregate from its final state.
+	result func(state interface{}) interface{}
+}
+
+// GroupedQuery is the type returned from GroupedBy. It builds a query that
+// groups the elements of a collection by key and computes several aggregates
+// over each group in a single pass, without storing the elements of the
+// groups.
+//
+// Example:
+//
+//	From(orders).GroupedBy(func(o interface{}) interface{} {
+//		return o.(Order).Region
+//	}).Count("orders").Sum("total", func(o interface{}) interface{} {
+//		return o.(Order).Amount
+//	}).Query()
+type GroupedQuery struct {
+	source      Query
+	keySelector func(interface{}) interface{}
+	aggregators []groupAggregator
+}
+
+// GroupedBy starts building a query that groups the elements of a collection
+// according to the key returned by keySelector and computes aggregates over
+// each group. Add aggregates with the methods of GroupedQuery, such as Sum and
+// Count, and get the query with Query.
+func (q Query) GroupedBy(keySelector func(interface{}) interface{}) GroupedQuery {
+	return GroupedQuery{source: q, keySelector: keySelector}
+}
+
+// with returns a copy of g with the aggregator a added.
+func (g GroupedQuery) with(a groupAggregator) GroupedQuery {
+	g.aggregators = append(g.aggregators[:len(g.aggregators):len(g.aggregators)], a)
+	return g
+}
+
+// Count adds an aggregate with the specified name holding the number of
+// elements of each group as an int.
+func (g GroupedQuery) Count(name string) GroupedQuery {
+	return g.with(groupAggregator{
+		name: name,
+		add: func(state, item interface{}) interface{} {
+			n, _ := state.(int)
+			return n + 1
+		},
+		result: func(state interface{}) interface{} { return state },
+	})
+}
+
+// Sum adds an aggregate with the specified name holding the sum of the values
+// returned by selector for the elements of each group as a float64. Values can
+// be numbers of any type, see SumNumeric. Iterating over the query panics with
+// ErrNotNumeric if a value is not a number.
+func (g GroupedQuery) Sum(name string, selector func(interface{}) interface{}) GroupedQuery {
+	return g.with(groupAggregator{
+		name: name,
+		add: func(state, item interface{}) interface{} {
+			sum, _ := state.(float64)
+			return sum + mustFloat64(selector(item))
+		},
+		result: func(state interface{}) interface{} { return state },
+	})
+}
+
+// Average adds an aggregate with the specified name holding the average of
+// the values returned by selector for the elements of each group as a
+// float64. Values can be numbers of any type, see SumNumeric. Iterating over
+// the query panics with ErrNotNumeric if a value is not a number.
+func (g GroupedQuery) Average(name string, selector func(interface{}) interface{}) GroupedQuery {
+	type mean struct {
+		value float64
+		n     int
+	}
+
+	return g.with(groupAggregator{
+		name: name,
+		add: func(state, item interface{}) interface{} {
+			m, _ := state.(mean)
+			m.n++
+			m.value += (mustFloat64(selector(item)) - m.value) / float64(m.n)
+			return m
+		},
+		result: func(state interface{}) interface{} { return state.(mean).value },
+	})
+}
+
+// Min adds an aggregate with the specified name holding the minimum of the
+// values returned by selector for the elements of each group. Values have to
+// be of a basic type or implement Comparable.
+func (g GroupedQuery) Min(name string, selector func(interface{}) interface{}) GroupedQuery {
+	return g.extreme(name, selector, -1)
+}
+
+// Max adds an aggregate with the specified name holding the maximum of the
+// values returned by selector for the elements of each group. Values have to
+// be of a basic type or implement Comparable.
+func (g GroupedQuery) Max(name string, selector func(interface{}) interface{}) GroupedQuery {
+	return g.extreme(name, selector, 1)
+}
+
+// extreme adds an aggregate holding the maximum of the values returned by
+// selector if sign is positive, or their minimum if sign is negative.
+func (g GroupedQuery) extreme(name string, selector func(interface{}) interface{}, sign int) GroupedQuery {
+	type extreme struct {
+		value   interface{}
+		compare comparer
+	}
+
+	source := g.source
+	return g.with(groupAggregator{
+		name: name,
+		add: func(state, item interface{}) interface{} {
+			value := selector(item)
+			if state == nil {
+				return extreme{value, source.comparer(value)}
+			}
+
+			e := state.(extreme)
+			if e.compare(value, e.value)*sign > 0 {
+				e.value = value
+			}
+
+			return e
+		},
+		result: func(state interface{}) interface{} { return state.(extreme).value },
+	})
+}
+
+// Aggregate adds an aggregate with the specified name computed by folding the
+// elements of each group with accumulator, starting from seed, like the
+// AggregateWithSeed method of Query.
+func (g GroupedQuery) Aggregate(name string, seed interface{},
+	accumulator func(interface{}, interface{}) interface{}) GroupedQuery {
+	type fold struct {
+		value interface{}
+	}
+
+	return g.with(groupAggregator{
+		name: name,
+		add: func(state, item interface{}) interface{} {
+			f, ok := state.(fold)
+			if !ok {
+				f.value = seed
+			}
+
+			f.value = accumulator(f.value, item)
+			return f
+		},
+		result: func(state interface{}) interface{} { return state.(fold).value },
+	})
+}
+
+// Query returns the query of the groups. Its elements are of type
+// GroupAggregates and are ordered by the first occurrence of their key in the
+// collection. The collection is grouped when the first group is requested, so
+// errors of the collection and of the aggregates can be recovered with Catch.
+func (g GroupedQuery) Query() Query {
+	q, keySelector, aggregators := g.source, g.keySelector, g.aggregators
+
+	return Query{
+		desc: q.chain("GroupedBy"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var keys []interface{}
+			var states [][]interface{}
+			grouped := false
+			i := 0
+
+			return func() (item interface{}, ok bool) {
+				if !grouped {
+					keys, states = groupAggregate(next, keySelector, aggregators, q.capacity())
+					grouped = true
+				}
+
+				ok = i < len(keys)
+				if ok {
+					values := make(map[string]interface{}, len(aggregators))
+					for j, a := range aggregators {
+						values[a.name] = a.result(states[i][j])
+					}
+
+					item = GroupAggregates{Key: keys[i], Aggregates: values}
+					i++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// groupAggregate groups the elements returned by next by key and returns the
+// keys in order of their first occurrence, with the states of the aggregators
+// for each of them.
+func groupAggregate(next Iterator, keySelector func(interface{}) interface{},
+	aggregators []groupAggregator, capacity int) (keys []interface{}, states [][]interface{}) {
+	index := make(map[interface{}]int, capacity)
+
+	for item, ok := next(); ok; item, ok = next() {
+		key := keySelector(item)
+		i, found := index[key]
+		if !found {
+			i = len(keys)
+			index[key] = i
+			keys = append(keys, key)
+			states = append(states, make([]interface{}, len(aggregators)))
+		}
+
+		for j, a := range aggregators {
+			states[i][j] = a.add(states[i][j], item)
+		}
+	}
+
+	return
+}
+
+// mustFloat64 converts a number of any type to float64 like toFloat64, and
+// panics with ErrNotNumeric if item is not a number.
+func mustFloat64(item interface{}) float64 {
+	f, ok := toFloat64(item)
+	if !ok {
+		panic(ErrNotNumeric)
+	}
+
+	return f
+}
@@ -0,0 +1,87 @@
+package linq
+
+import "testing"
+
+type groupedQueryTestOrder struct {
+	Region string
+	Amount int
+}
+
+func TestGroupedBy(t *testing.T) {
+	orders := []groupedQueryTestOrder{
+		{"EU", 10}, {"US", 5}, {"EU", 30}, {"APAC", 7}, {"US", 15}, {"EU", 20},
+	}
+	amount := func(o interface{}) interface{} { return o.(groupedQueryTestOrder).Amount }
+
+	q := From(orders).GroupedBy(func(o interface{}) interface{} {
+		return o.(groupedQueryTestOrder).Region
+	}).Count("count").Sum("sum", amount).Average("avg", amount).
+		Min("min", amount).Max("max", amount).
+		Aggregate("amounts", "", func(acc, o interface{}) interface{} {
+			return acc.(string) + "+"
+		}).Query()
+
+	want := []interface{}{
+		GroupAggregates{"EU", map[string]interface{}{"count": 3, "sum": 60.0, "avg": 20.0, "min": 10, "max": 30, "amounts": "+++"}},
+		GroupAggregates{"US", map[string]interface{}{"count": 2, "sum": 20.0, "avg": 10.0, "min": 5, "max": 15, "amounts": "++"}},
+		GroupAggregates{"APAC", map[string]interface{}{"count": 1, "sum": 7.0, "avg": 7.0, "min": 7, "max": 7, "amounts": "+"}},
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := toSlice(q); !validateQuery(From(got).Select(func(g interface{}) interface{} {
+			return g.(GroupAggregates).Key
+		}), []interface{}{"EU", "US", "APAC"}) || !sameGroupAggregates(got, want) {
+			t.Errorf("GroupedBy()=%v expected %v", got, want)
+		}
+	}
+}
+
+func TestGroupedByIsImmutable(t *testing.T) {
+	g := Range(1, 4).GroupedBy(func(i interface{}) interface{} { return i.(int) % 2 }).Count("count")
+	withSum := g.Sum("sum", func(i interface{}) interface{} { return i })
+	withMax := g.Max("max", func(i interface{}) interface{} { return i })
+
+	got := withMax.Query().First().(GroupAggregates)
+	if _, ok := got.Aggregates["sum"]; ok || got.Aggregates["max"] != 3 {
+		t.Errorf("GroupedBy().Count().Max()=%v expected no sum and max 3", got)
+	}
+
+	if got := withSum.Query().First().(GroupAggregates); got.Aggregates["sum"] != 4.0 {
+		t.Errorf("GroupedBy().Count().Sum()=%v expected sum 4", got)
+	}
+}
+
+func TestGroupedByWithNonNumericValue(t *testing.T) {
+	var got error
+	From([]string{"a"}).GroupedBy(func(i interface{}) interface{} { return i }).
+		Sum("sum", func(i interface{}) interface{} { return i }).Query().
+		Catch(func(err error) Query {
+			got = err
+			return Empty()
+		}).ToSlice(new([]interface{}))
+
+	if got != ErrNotNumeric {
+		t.Errorf("GroupedBy().Sum() of strings error=%v expected %v", got, ErrNotNumeric)
+	}
+}
+
+func sameGroupAggregates(got, want []interface{}) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for i := range got {
+		g, w := got[i].(GroupAggregates), want[i].(GroupAggregates)
+		if g.Key != w.Key || len(g.Aggregates) != len(w.Aggregates) {
+			return false
+		}
+
+		for name, value := range w.Aggregates {
+			if g.Aggregates[name] != value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -18,35 +18,40 @@ import "reflect"
 //
 // GroupJoin preserves the order of the elements of outer, and for each element
 // of outer, the order of the matching elements from inner.
+//
+// The hash table is built from inner, unless outer is a random access
+// collection known to be smaller than inner. Use GroupJoinLookup to join
+// against an inner collection that has already been grouped with ToLookup.
 func (q Query) GroupJoin(inner Query,
 	outerKeySelector func(interface{}) interface{},
 	innerKeySelector func(interface{}) interface{},
 	resultSelector func(outer interface{}, inners []interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("GroupJoin"),
 		Iterate: func() Iterator {
-			outernext := q.Iterate()
-			innernext := inner.Iterate()
+			if buildOuterSide(q, inner) {
+				matches := matchOuter(q, inner, outerKeySelector, innerKeySelector)
+				index := 0
 
-			innerLookup := make(map[interface{}][]interface{})
-			for innerItem, ok := innernext(); ok; innerItem, ok = innernext() {
-				innerKey := innerKeySelector(innerItem)
-				innerLookup[innerKey] = append(innerLookup[innerKey], innerItem)
-			}
+				return func() (item interface{}, ok bool) {
+					if index >= len(matches) {
+						return
+					}
 
-			return func() (item interface{}, ok bool) {
-				if item, ok = outernext(); !ok {
-					return
-				}
+					group := matches[index]
+					if group == nil {
+						group = []interface{}{}
+					}
 
-				if group, has := innerLookup[outerKeySelector(item)]; !has {
-					item = resultSelector(item, []interface{}{})
-				} else {
-					item = resultSelector(item, group)
+					item = resultSelector(q.index(index), group)
+					index++
+					return item, true
 				}
-
-				return
 			}
+
+			innerLookup := buildLookup(inner.Iterate(), innerKeySelector)
+			return groupJoinIterator(q.Iterate(), innerLookup, outerKeySelector, resultSelector)
 		},
 	}
 }
@@ -24,6 +24,28 @@ func TestGroupJoin(t *testing.T) {
 	}
 }
 
+func TestGroupJoinWithUnknownOuterLength(t *testing.T) {
+	outer := From([]int{0, 1, 2}).Where(func(interface{}) bool { return true })
+	inner := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	want := []interface{}{
+		KeyValue{0, 4},
+		KeyValue{1, 5},
+		KeyValue{2, 0},
+	}
+
+	q := outer.GroupJoin(
+		From(inner),
+		func(i interface{}) interface{} { return i },
+		func(i interface{}) interface{} { return i.(int) % 2 },
+		func(outer interface{}, inners []interface{}) interface{} {
+			return KeyValue{outer, len(inners)}
+		})
+
+	if !validateQuery(q, want) {
+		t.Errorf("Where().GroupJoin()=%v expected %v", toSlice(q), want)
+	}
+}
+
 func TestGroupJoinT_PanicWhenOuterKeySelectorFnIsInvalid(t *testing.T) {
 	mustPanicWithError(t, "GroupJoinT: parameter [outerKeySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
 		From([]int{0, 1, 2}).GroupJoinT(
@@ -0,0 +1,50 @@
+package linq
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+)
+
+// HashSequence folds all elements of a collection into a single hash, in one
+// iteration, for change detection and cache keys over query results. The
+// hash depends on the order of the elements: collections with the same
+// elements in a different order have different hashes.
+//
+// Every element is converted to bytes with function encode, and the bytes are
+// written to h, preceded by their length so that the boundaries between
+// elements affect the hash. h is reset first, and its sum is returned. If h is
+// nil, the 64-bit FNV-1a hash is used, and if encode is nil, elements are
+// encoded with their type and Go syntax representation, as formatted by %T
+// and %#v.
+//
+// The default encoding is only stable within one process: pointers are
+// formatted as addresses, which differ between processes, and before Go 1.12
+// the keys of maps are formatted in random order, so elements containing maps
+// may hash differently even within a process. Pass an encode function, such
+// as one marshaling elements to JSON, to use the hash as a persistent cache key
+// or to compare hashes computed by different processes.
+func (q Query) HashSequence(h hash.Hash64, encode func(interface{}) []byte) uint64 {
+	if h == nil {
+		h = fnv.New64a()
+	}
+
+	if encode == nil {
+		encode = func(item interface{}) []byte {
+			return []byte(fmt.Sprintf("%T %#v", item, item))
+		}
+	}
+
+	h.Reset()
+
+	var length [binary.MaxVarintLen64]byte
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		b := encode(item)
+		h.Write(length[:binary.PutUvarint(length[:], uint64(len(b)))])
+		h.Write(b)
+	}
+
+	return h.Sum64()
+}
@@ -0,0 +1,31 @@
+package linq
+
+import (
+	"hash/crc64"
+	"testing"
+)
+
+func TestHashSequence(t *testing.T) {
+	encode := func(i interface{}) []byte { return []byte(i.(string)) }
+
+	h := From([]string{"ab", "c"}).HashSequence(nil, encode)
+	if h2 := From([]string{"ab", "c"}).HashSequence(nil, encode); h2 != h {
+		t.Errorf("HashSequence()=%x for equal collections expected %x", h2, h)
+	}
+
+	for _, input := range [][]string{{"c", "ab"}, {"a", "bc"}, {"abc"}, {"ab", "c", ""}} {
+		if h2 := From(input).HashSequence(nil, encode); h2 == h {
+			t.Errorf("From(%v).HashSequence()=%x expected a hash different from [ab c]", input, h2)
+		}
+	}
+
+	crc := crc64.New(crc64.MakeTable(crc64.ISO))
+	crc.Write([]byte("previous data"))
+	if r, r2 := Range(1, 3).HashSequence(crc, nil), Range(1, 3).HashSequence(crc, nil); r != r2 {
+		t.Errorf("HashSequence(crc64)=%x and %x for equal collections expected equal hashes", r, r2)
+	}
+
+	if r, r2 := Range(1, 3).HashSequence(nil, nil), From([]int64{1, 2, 3}).HashSequence(nil, nil); r == r2 {
+		t.Errorf("HashSequence() of int and int64 elements=%x expected different hashes", r)
+	}
+}
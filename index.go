@@ -38,3 +38,97 @@ func (q Query) IndexOfT(predicateFn interface{}) int {
 
 	return q.IndexOf(predicateFunc)
 }
+
+// IndexOfSequence searches for the contiguous subsequence of a collection that
+// is equal to the elements of needle and returns the zero-based index of its
+// first occurrence within the collection. This method returns -1 if the
+// subsequence is not found, and 0 if needle is empty.
+//
+// The elements of needle are buffered, and the collection is iterated only
+// until the first occurrence is found, without going back, using the
+// Knuth-Morris-Pratt algorithm. Elements are compared like in SequenceEqual,
+// including the FloatTolerance set with WithFloatTolerance.
+func (q Query) IndexOfSequence(needle Query) int {
+	pattern := needle.Results()
+	if len(pattern) == 0 {
+		return 0
+	}
+
+	if eps := q.floatTolerance(); eps > 0 {
+		return q.indexOfSequenceWithin(pattern, eps)
+	}
+
+	// fallback[i] is the length of the longest proper prefix of
+	// pattern[:i+1] that is also its suffix.
+	fallback := make([]int, len(pattern))
+	for i, k := 1, 0; i < len(pattern); i++ {
+		for k > 0 && !equalItems(pattern[i], pattern[k]) {
+			k = fallback[k-1]
+		}
+
+		if equalItems(pattern[i], pattern[k]) {
+			k++
+		}
+
+		fallback[i] = k
+	}
+
+	index := 0
+	matched := 0
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		for matched > 0 && !equalItems(item, pattern[matched]) {
+			matched = fallback[matched-1]
+		}
+
+		if equalItems(item, pattern[matched]) {
+			matched++
+		}
+
+		if matched == len(pattern) {
+			return index - len(pattern) + 1
+		}
+
+		index++
+	}
+
+	return -1
+}
+
+// indexOfSequenceWithin is IndexOfSequence with floats that differ by at most
+// eps considered equal. Since this equality is not transitive, the prefixes of
+// the pattern can't be matched against each other like in Knuth-Morris-Pratt,
+// so the last len(pattern) elements are kept in a ring and compared to the
+// pattern after each element.
+func (q Query) indexOfSequenceWithin(pattern []interface{}, eps float64) int {
+	equal := func(a, b interface{}) bool {
+		return floatsWithin(a, b, eps) || equalItems(a, b)
+	}
+
+	window := make([]interface{}, len(pattern))
+	index := 0
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		window[index%len(window)] = item
+		index++
+
+		if index < len(pattern) {
+			continue
+		}
+
+		start := index - len(pattern)
+		matched := true
+		for i, p := range pattern {
+			if !equal(window[(start+i)%len(window)], p) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return start
+		}
+	}
+
+	return -1
+}
@@ -51,3 +51,41 @@ func TestIndexOfT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).IndexOfT(func(item int) int { return item + 2 })
 	})
 }
+
+func TestIndexOfSequence(t *testing.T) {
+	tests := []struct {
+		input  Query
+		needle Query
+		want   int
+	}{
+		{From([]int{1, 2, 3, 4, 5}), From([]int{3, 4}), 2},
+		{From([]int{1, 1, 1, 2, 1}), From([]int{1, 1, 2}), 1},
+		{FromString("abababc"), FromString("ababc"), 2},
+		{FromString("aabaabaaab"), FromString("aaab"), 6},
+		{From([]int{1, 2, 3}), From([]int{2, 4}), -1},
+		{From([]int{1, 2}), From([]int{1, 2, 3}), -1},
+		{From([]int{1, 2}), Empty(), 0},
+		{Empty(), Range(1, 1), -1},
+	}
+
+	for _, test := range tests {
+		if index := test.input.IndexOfSequence(test.needle); index != test.want {
+			t.Errorf("%v.IndexOfSequence(%v)=%d expected %d", test.input, test.needle, index, test.want)
+		}
+	}
+
+	ch := make(chan interface{}, 10)
+	for _, i := range []int{5, 6, 7, 8} {
+		ch <- i
+	}
+	close(ch)
+
+	q := FromChannel(ch)
+	if index := q.IndexOfSequence(Range(6, 2)); index != 1 {
+		t.Errorf("FromChannel().IndexOfSequence(Range(6, 2))=%d expected 1", index)
+	}
+
+	if rest := toSlice(q); len(rest) != 1 || rest[0] != 8 {
+		t.Errorf("FromChannel() after IndexOfSequence()=%v expected [8]", rest)
+	}
+}
@@ -0,0 +1,117 @@
+package linq
+
+// Inspect returns the elements of a collection unchanged and calls action for
+// each of them as they pass through the query. It can be used to log elements
+// or collect metrics at some point of a pipeline without disturbing it.
+//
+// action is called only for the elements that are actually iterated over. The
+// returned query doesn't report its number of elements without iterating, so
+// methods such as Count call action for every element, even for a query
+// created from a slice.
+func (q Query) Inspect(action func(interface{})) Query {
+	return Query{
+		desc: q.chain("Inspect"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok {
+					action(item)
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// InspectT is the typed version of Inspect.
+//
+//   - actionFn is of type "func(TSource)"
+//
+// NOTE: Inspect has better performance than InspectT.
+func (q Query) InspectT(actionFn interface{}) Query {
+	actionGenericFunc, err := newGenericFunc(
+		"InspectT", "actionFn", actionFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), nil),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	actionFunc := func(item interface{}) {
+		actionGenericFunc.Call(item)
+	}
+
+	return q.Inspect(actionFunc)
+}
+
+// InspectIndexed returns the elements of a collection unchanged and calls
+// action for each of them as they pass through the query. The first argument
+// to action represents the zero-based index of the element in the collection.
+// The second argument represents the element.
+func (q Query) InspectIndexed(action func(int, interface{})) Query {
+	return Query{
+		desc: q.chain("InspectIndexed"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok {
+					action(index, item)
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// InspectIndexedT is the typed version of InspectIndexed.
+//
+//   - actionFn is of type "func(int,TSource)"
+//
+// NOTE: InspectIndexed has better performance than InspectIndexedT.
+func (q Query) InspectIndexedT(actionFn interface{}) Query {
+	actionGenericFunc, err := newGenericFunc(
+		"InspectIndexedT", "actionFn", actionFn,
+		simpleParamValidator(newElemTypeSlice(new(int), new(genericType)), nil),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	actionFunc := func(index int, item interface{}) {
+		actionGenericFunc.Call(index, item)
+	}
+
+	return q.InspectIndexed(actionFunc)
+}
+
+// Do is an alias of Inspect for those used to the name of this operator in
+// reactive extensions libraries.
+func (q Query) Do(action func(interface{})) Query {
+	return q.Inspect(action).describe(q.chain("Do"))
+}
+
+// DoIndexed is an alias of InspectIndexed for those used to the name of this
+// operator in reactive extensions libraries.
+func (q Query) DoIndexed(action func(int, interface{})) Query {
+	return q.InspectIndexed(action).describe(q.chain("DoIndexed"))
+}
+
+// DoKV returns the elements of a collection of KeyValue pairs unchanged and
+// calls action with the key and the value of each of them as they pass through
+// the query, such as the elements of a query created from a map.
+//
+// Iterating over the query panics if an element is not a KeyValue.
+func (q Query) DoKV(action func(key, value interface{})) Query {
+	return q.Inspect(func(item interface{}) {
+		kv := item.(KeyValue)
+		action(kv.Key, kv.Value)
+	}).describe(q.chain("DoKV"))
+}
@@ -0,0 +1,121 @@
+package linq
+
+import "testing"
+
+func TestInspect(t *testing.T) {
+	var seen []interface{}
+	q := From([]int{1, 2, 3}).Inspect(func(item interface{}) {
+		seen = append(seen, item)
+	})
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("From([]int{1, 2, 3}).Inspect()=%v expected %v", toSlice(q), w)
+	}
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(From(seen), w) {
+		t.Errorf("Inspect() action called with %v expected %v", seen, w)
+	}
+
+	seen = nil
+	From([]int{1, 2, 3}).Inspect(func(item interface{}) {
+		seen = append(seen, item)
+	}).Take(2).ToSlice(new([]int))
+
+	if w := []interface{}{1, 2}; !validateQuery(From(seen), w) {
+		t.Errorf("Inspect().Take(2) action called with %v expected %v", seen, w)
+	}
+
+	calls := 0
+	if n := From([]int{1, 2, 3}).Inspect(func(interface{}) { calls++ }).Count(); n != 3 || calls != 3 {
+		t.Errorf("Inspect().Count()=%d with %d calls expected 3 with 3 calls", n, calls)
+	}
+}
+
+func TestInspectT(t *testing.T) {
+	sum := 0
+	q := From([]int{1, 2, 3}).InspectT(func(item int) { sum += item })
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) || sum != 6 {
+		t.Errorf("From([]int{1, 2, 3}).InspectT()=%v, sum=%d expected %v, 6", toSlice(q), sum, w)
+	}
+}
+
+func TestInspectT_PanicWhenActionFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "InspectT: parameter [actionFn] has a invalid function signature. Expected: 'func(T)', actual: 'func(int,int)'", func() {
+		From([]int{1, 2, 3}).InspectT(func(item, idx int) {})
+	})
+}
+
+func TestInspectIndexed(t *testing.T) {
+	var indexes []interface{}
+	q := FromString("abc").InspectIndexed(func(i int, item interface{}) {
+		indexes = append(indexes, i)
+	})
+
+	if w := []interface{}{'a', 'b', 'c'}; !validateQuery(q, w) {
+		t.Errorf("FromString(abc).InspectIndexed()=%v expected %v", toSlice(q), w)
+	}
+
+	if w := []interface{}{0, 1, 2}; !validateQuery(From(indexes), w) {
+		t.Errorf("InspectIndexed() action called with indexes %v expected %v", indexes, w)
+	}
+}
+
+func TestInspectIndexedT(t *testing.T) {
+	var got []string
+	From([]string{"a", "b"}).InspectIndexedT(func(i int, item string) {
+		got = append(got, item)
+	}).ToSlice(new([]string))
+
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("InspectIndexedT() action called with %v expected [a b]", got)
+	}
+}
+
+func TestInspectIndexedT_PanicWhenActionFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "InspectIndexedT: parameter [actionFn] has a invalid function signature. Expected: 'func(int,T)', actual: 'func(int)'", func() {
+		From([]int{1, 2, 3}).InspectIndexedT(func(item int) {})
+	})
+}
+
+func TestDo(t *testing.T) {
+	count := 0
+	q := Range(1, 3).Do(func(interface{}) { count++ })
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) || count != 3 {
+		t.Errorf("Range(1, 3).Do()=%v, count=%d expected %v, 3", toSlice(q), count, w)
+	}
+
+	if got, want := q.String(), "Range(1, 3).Do"; got != want {
+		t.Errorf("Do().String()=%q expected %q", got, want)
+	}
+}
+
+func TestDoIndexed(t *testing.T) {
+	var indexes []interface{}
+	q := FromString("ab").DoIndexed(func(i int, item interface{}) {
+		indexes = append(indexes, i)
+	})
+
+	if w := []interface{}{'a', 'b'}; !validateQuery(q, w) {
+		t.Errorf("FromString(ab).DoIndexed()=%v expected %v", toSlice(q), w)
+	}
+
+	if w := []interface{}{0, 1}; !validateQuery(From(indexes), w) {
+		t.Errorf("DoIndexed() action called with indexes %v expected %v", indexes, w)
+	}
+}
+
+func TestDoKV(t *testing.T) {
+	sum := 0
+	var keys []string
+	q := FromMapSorted(map[string]int{"a": 1, "b": 2}).DoKV(func(key, value interface{}) {
+		keys = append(keys, key.(string))
+		sum += value.(int)
+	})
+
+	w := []interface{}{KeyValue{"a", 1}, KeyValue{"b", 2}}
+	if !validateQuery(q, w) || sum != 3 || len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("DoKV()=%v, keys=%v, sum=%d expected %v, [a b], 3", toSlice(q), keys, sum, w)
+	}
+}
@@ -0,0 +1,106 @@
+package linq
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Hook is an interface that has to be implemented to observe the stages of a
+// query marked by Instrument, for example to export their throughput and
+// latency as metrics.
+//
+// The methods of a Hook are called from the goroutine iterating over the
+// query, so a Hook shared by queries iterated concurrently has to be safe for
+// concurrent use.
+type Hook interface {
+	// OnStart is called when the iteration over the stage starts.
+	OnStart(stage string)
+
+	// OnNext is called after the stage produces an element, with the number
+	// of elements produced so far and the time it took to produce the
+	// element.
+	OnNext(stage string, count int, elapsed time.Duration)
+
+	// OnEnd is called when the stage has no more elements, with the number of
+	// elements produced and the total time spent producing them. It is not
+	// called if the iteration is abandoned before the end of the stage, for
+	// example by Take or First.
+	OnEnd(stage string, count int, elapsed time.Duration)
+}
+
+// hookHolder wraps the global hook, since atomic.Value can't store nil.
+type hookHolder struct {
+	hook Hook
+}
+
+var globalHook atomic.Value
+
+// SetGlobalHook sets the hook used by the stages marked with Instrument
+// without a hook of their own. Passing nil removes the global hook.
+func SetGlobalHook(hook Hook) {
+	globalHook.Store(hookHolder{hook: hook})
+}
+
+// getGlobalHook returns the hook set with SetGlobalHook, or nil.
+func getGlobalHook() Hook {
+	holder, _ := globalHook.Load().(hookHolder)
+	return holder.hook
+}
+
+// Instrument returns the elements of a collection unchanged and reports the
+// iteration over them to hook as the stage with the specified name. If stage
+// is empty, the description of the query returned by String is used. If hook
+// is nil, the hook set with SetGlobalHook at the time the query is iterated is
+// used, and if there is none, the query is iterated without being observed.
+//
+// The time reported for an element is the time it took q to produce it,
+// including the time spent in the stages before it, so per-stage latency can
+// be obtained by instrumenting consecutive stages. The returned query doesn't
+// report its number of elements without iterating, so that methods such as
+// Count are observed too.
+func (q Query) Instrument(stage string, hook Hook) Query {
+	if stage == "" {
+		stage = q.String()
+	}
+
+	return Query{
+		desc: q.chain("Instrument"),
+		Iterate: func() Iterator {
+			h := hook
+			if h == nil {
+				h = getGlobalHook()
+			}
+
+			if h == nil {
+				return q.Iterate()
+			}
+
+			h.OnStart(stage)
+			next := q.Iterate()
+			count := 0
+			var total time.Duration
+			done := false
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				start := time.Now()
+				item, ok = next()
+				elapsed := time.Since(start)
+				total += elapsed
+
+				if !ok {
+					done = true
+					h.OnEnd(stage, count, total)
+					return
+				}
+
+				count++
+				h.OnNext(stage, count, elapsed)
+				return
+			}
+		},
+	}
+}
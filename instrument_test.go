@@ -0,0 +1,78 @@
+package linq
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+type recordingHook struct {
+	events []string
+}
+
+func (h *recordingHook) OnStart(stage string) {
+	h.events = append(h.events, "start "+stage)
+}
+
+func (h *recordingHook) OnNext(stage string, count int, elapsed time.Duration) {
+	h.events = append(h.events, fmt.Sprintf("next %s %d", stage, count))
+}
+
+func (h *recordingHook) OnEnd(stage string, count int, elapsed time.Duration) {
+	h.events = append(h.events, fmt.Sprintf("end %s %d", stage, count))
+}
+
+func TestInstrument(t *testing.T) {
+	hook := &recordingHook{}
+	q := Range(1, 4).Where(func(i interface{}) bool {
+		return i.(int)%2 == 0
+	}).Instrument("", hook)
+
+	if w := []interface{}{2, 4}; !validateQuery(q, w) {
+		t.Errorf("Instrument()=%v expected %v", toSlice(q), w)
+	}
+
+	want := []interface{}{
+		"start Range(1, 4).Where",
+		"next Range(1, 4).Where 1",
+		"next Range(1, 4).Where 2",
+		"end Range(1, 4).Where 2",
+	}
+	if !validateQuery(From(hook.events), want) {
+		t.Errorf("Instrument() events=%v expected %v", hook.events, want)
+	}
+
+	hook.events = nil
+	From([]int{1, 2, 3}).Instrument("source", hook).First()
+
+	want = []interface{}{"start source", "next source 1"}
+	if !validateQuery(From(hook.events), want) {
+		t.Errorf("Instrument().First() events=%v expected %v", hook.events, want)
+	}
+
+	hook.events = nil
+	From([]int{1, 2}).Instrument("source", hook).Count()
+
+	want = []interface{}{"start source", "next source 1", "next source 2", "end source 2"}
+	if !validateQuery(From(hook.events), want) {
+		t.Errorf("Instrument().Count() events=%v expected %v", hook.events, want)
+	}
+}
+
+func TestInstrumentWithGlobalHook(t *testing.T) {
+	q := Range(1, 2).Instrument("global", nil)
+	if w := []interface{}{1, 2}; !validateQuery(q, w) {
+		t.Errorf("Instrument() without hook=%v expected %v", toSlice(q), w)
+	}
+
+	hook := &recordingHook{}
+	SetGlobalHook(hook)
+	defer SetGlobalHook(nil)
+
+	q.ToSlice(new([]int))
+
+	want := []interface{}{"start global", "next global 1", "next global 2", "end global 2"}
+	if !validateQuery(From(hook.events), want) {
+		t.Errorf("Instrument() with global hook events=%v expected %v", hook.events, want)
+	}
+}
@@ -0,0 +1,34 @@
+// Package values holds helpers shared by go-linq and its subpackages.
+package values
+
+import (
+	"reflect"
+	"strings"
+)
+
+// In returns the values of the "in" operator of WhereField: the elements of a
+// slice or array, the trimmed parts of a comma-separated string, or value
+// itself otherwise.
+func In(value interface{}) []interface{} {
+	if s, ok := value.(string); ok {
+		parts := strings.Split(s, ",")
+		values := make([]interface{}, len(parts))
+		for i, part := range parts {
+			values[i] = strings.TrimSpace(part)
+		}
+
+		return values
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return []interface{}{value}
+	}
+
+	values := make([]interface{}, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+
+	return values
+}
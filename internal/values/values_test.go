@@ -0,0 +1,26 @@
+package values
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIn(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  []interface{}
+	}{
+		{"DE, FR ,IT", []interface{}{"DE", "FR", "IT"}},
+		{[]int{1, 2}, []interface{}{1, 2}},
+		{[2]string{"a", "b"}, []interface{}{"a", "b"}},
+		{[]int{}, []interface{}{}},
+		{42, []interface{}{42}},
+		{nil, []interface{}{nil}},
+	}
+
+	for _, test := range tests {
+		if got := In(test.value); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("In(%v)=%v expected %v", test.value, got, test.want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package linq
 // other elements.
 func (q Query) Intersect(q2 Query) Query {
 	return Query{
+		desc: q.chain("Intersect"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			next2 := q2.Iterate()
@@ -29,6 +30,39 @@ func (q Query) Intersect(q2 Query) Query {
 	}
 }
 
+// IntersectAll produces the bag intersection of the source collection and the
+// provided input collection. An element that appears m times in the source
+// collection and n times in q2 appears min(m, n) times in the result, in the
+// order of the source collection.
+//
+// Unlike Intersect, which returns each common element only once, IntersectAll
+// preserves duplicates, following the INTERSECT ALL operator of SQL.
+func (q Query) IntersectAll(q2 Query) Query {
+	return Query{
+		desc: q.chain("IntersectAll"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			next2 := q2.Iterate()
+
+			counts := make(map[interface{}]int)
+			for item, ok := next2(); ok; item, ok = next2() {
+				counts[item]++
+			}
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if counts[item] > 0 {
+						counts[item]--
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
 // IntersectBy produces the set intersection of the source collection and the
 // provided input collection. The intersection of two sets A and B is defined as
 // the set that contains all the elements of A that also appear in B, but no
@@ -39,6 +73,7 @@ func (q Query) IntersectBy(q2 Query,
 	selector func(interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("IntersectBy"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			next2 := q2.Iterate()
@@ -12,6 +12,16 @@ func TestIntersect(t *testing.T) {
 	}
 }
 
+func TestIntersectAll(t *testing.T) {
+	input1 := []int{1, 2, 2, 2, 3, 1}
+	input2 := []int{2, 1, 2, 4, 2, 2}
+	want := []interface{}{1, 2, 2, 2}
+
+	if q := From(input1).IntersectAll(From(input2)); !validateQuery(q, want) {
+		t.Errorf("From(%v).IntersectAll(%v)=%v expected %v", input1, input2, toSlice(q), want)
+	}
+}
+
 func TestIntersectBy(t *testing.T) {
 	input1 := []int{5, 7, 8}
 	input2 := []int{1, 4, 7, 9, 12, 3}
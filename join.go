@@ -10,45 +10,40 @@ package linq
 //
 // Join preserves the order of the elements of outer collection, and for each of
 // these elements, the order of the matching elements of inner.
+//
+// The hash table is built from inner, unless outer is a random access
+// collection known to be smaller than inner. Use JoinLookup to join against
+// an inner collection that has already been grouped with ToLookup.
 func (q Query) Join(inner Query,
 	outerKeySelector func(interface{}) interface{},
 	innerKeySelector func(interface{}) interface{},
 	resultSelector func(outer interface{}, inner interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("Join"),
 		Iterate: func() Iterator {
-			outernext := q.Iterate()
-			innernext := inner.Iterate()
-
-			innerLookup := make(map[interface{}][]interface{})
-			for innerItem, ok := innernext(); ok; innerItem, ok = innernext() {
-				innerKey := innerKeySelector(innerItem)
-				innerLookup[innerKey] = append(innerLookup[innerKey], innerItem)
-			}
-
-			var outerItem interface{}
-			var innerGroup []interface{}
-			innerLen, innerIndex := 0, 0
-
-			return func() (item interface{}, ok bool) {
-				if innerIndex >= innerLen {
-					has := false
-					for !has {
-						outerItem, ok = outernext()
-						if !ok {
-							return
-						}
+			if buildOuterSide(q, inner) {
+				matches := matchOuter(q, inner, outerKeySelector, innerKeySelector)
+				outerIndex, innerIndex := 0, 0
 
-						innerGroup, has = innerLookup[outerKeySelector(outerItem)]
-						innerLen = len(innerGroup)
+				return func() (item interface{}, ok bool) {
+					for outerIndex < len(matches) && innerIndex >= len(matches[outerIndex]) {
+						outerIndex++
 						innerIndex = 0
 					}
-				}
 
-				item = resultSelector(outerItem, innerGroup[innerIndex])
-				innerIndex++
-				return item, true
+					if outerIndex >= len(matches) {
+						return
+					}
+
+					item = resultSelector(q.index(outerIndex), matches[outerIndex][innerIndex])
+					innerIndex++
+					return item, true
+				}
 			}
+
+			innerLookup := buildLookup(inner.Iterate(), innerKeySelector)
+			return joinIterator(q.Iterate(), innerLookup, outerKeySelector, resultSelector)
 		},
 	}
 }
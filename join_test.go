@@ -26,6 +26,33 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinWithLargerOuter(t *testing.T) {
+	outer := []int{1, 2, 1, 4, 7, 6, 7, 2}
+	inner := []int{0, 1, 2, 2}
+	want := []interface{}{
+		KeyValue{1, 1},
+		KeyValue{2, 2},
+		KeyValue{2, 2},
+		KeyValue{1, 1},
+		KeyValue{2, 2},
+		KeyValue{2, 2},
+	}
+
+	for _, o := range []Query{From(outer), From(outer).Where(func(interface{}) bool { return true })} {
+		q := o.Join(
+			From(inner),
+			func(i interface{}) interface{} { return i },
+			func(i interface{}) interface{} { return i },
+			func(outer interface{}, inner interface{}) interface{} {
+				return KeyValue{outer, inner}
+			})
+
+		if !validateQuery(q, want) {
+			t.Errorf("From().Join()=%v expected %v", toSlice(q), want)
+		}
+	}
+}
+
 func TestJoinT_PanicWhenOuterKeySelectorFnIsInvalid(t *testing.T) {
 	mustPanicWithError(t, "JoinT: parameter [outerKeySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
 		From([]int{0, 1, 2}).JoinT(
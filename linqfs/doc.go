@@ -0,0 +1,4 @@
+// Package linqfs creates go-linq queries from file systems. It requires Go
+// 1.17 for the io/fs package, so that go-linq itself keeps building with older
+// toolchains.
+package linqfs
@@ -0,0 +1,70 @@
+//go:build go1.17
+// +build go1.17
+
+package linqfs
+
+import (
+	"io/fs"
+	"path"
+
+	"github.com/ahmetb/go-linq/v3"
+)
+
+// Entry is a type that is used to iterate over a file system (if query is
+// created with FromFS). It holds the path of a file or directory relative to
+// the root of the file system, together with its fs.DirEntry.
+type Entry struct {
+	Path string
+	fs.DirEntry
+}
+
+// FromFS initializes a linq query that lazily walks the file tree rooted at
+// root in fsys. Elements of the query are of type Entry and include root
+// itself.
+//
+// Like fs.WalkDir, the walk is depth-first and visits the entries of a
+// directory in lexical order, but directories are read only when the query
+// reaches them, so the walk can be stopped early with Take or First. If
+// reading a directory fails, the iterator panics with the error returned by
+// fsys.
+func FromFS(fsys fs.FS, root string) linq.Query {
+	return linq.Query{
+		Iterate: func() linq.Iterator {
+			var stack []Entry
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				if !started {
+					started = true
+
+					info, err := fs.Stat(fsys, root)
+					if err != nil {
+						panic(err)
+					}
+
+					stack = append(stack, Entry{root, fs.FileInfoToDirEntry(info)})
+				}
+
+				if len(stack) == 0 {
+					return nil, false
+				}
+
+				entry := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+
+				if entry.IsDir() {
+					children, err := fs.ReadDir(fsys, entry.Path)
+					if err != nil {
+						panic(err)
+					}
+
+					for i := len(children) - 1; i >= 0; i-- {
+						stack = append(stack, Entry{path.Join(entry.Path, children[i].Name()), children[i]})
+					}
+				}
+
+				return entry, true
+			}
+		},
+	}
+}
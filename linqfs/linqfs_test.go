@@ -0,0 +1,49 @@
+//go:build go1.17
+// +build go1.17
+
+package linqfs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"b.txt":         {Data: []byte("b")},
+		"a/z.go":        {Data: []byte("z")},
+		"a/y/x.txt":     {Data: []byte("x")},
+		"c/d/e/f.txt":   {Data: []byte("f")},
+		"c/d/e/g.go":    {Data: []byte("g")},
+		"c/d/empty.txt": {},
+	}
+
+	var got []string
+	FromFS(fsys, ".").ForEach(func(i interface{}) {
+		got = append(got, i.(Entry).Path)
+	})
+
+	want := []string{".", "a", "a/y", "a/y/x.txt", "a/z.go", "b.txt", "c", "c/d", "c/d/e", "c/d/e/f.txt", "c/d/e/g.go", "c/d/empty.txt"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromFS(.)=%v expected %v", got, want)
+	}
+
+	first := FromFS(fsys, "c").Where(func(i interface{}) bool {
+		return !i.(Entry).IsDir()
+	}).First()
+	if first == nil || first.(Entry).Path != "c/d/e/f.txt" {
+		t.Errorf("FromFS(c).Where(file).First()=%v expected c/d/e/f.txt", first)
+	}
+}
+
+func TestFromFS_PanicWhenRootDoesNotExist(t *testing.T) {
+	defer func() {
+		if r := recover(); fmt.Sprint(r) != "open missing: file does not exist" {
+			t.Errorf("FromFS(missing).Count() panicked with %v expected the error of fsys", r)
+		}
+	}()
+
+	FromFS(fstest.MapFS{}, "missing").Count()
+}
@@ -0,0 +1,236 @@
+// Package linqsql translates query specifications built from the field filters
+// of go-linq into SQL clauses, so that the same specification can be applied
+// to a collection in memory with go-linq or pushed down to a database.
+//
+// Example:
+//
+//	spec := linqsql.Spec{
+//		Where:   []linqsql.Condition{{"age", ">=", 18}, {"country", "in", "DE,FR"}},
+//		OrderBy: []linqsql.Order{{Field: "created_at", Desc: true}},
+//		Limit:   10,
+//	}
+//
+//	users := spec.Apply(linq.From(cached))
+//
+//	clause, args, err := spec.SQL()
+//	rows, err := db.Query("SELECT * FROM users "+clause, args...)
+package linqsql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ahmetb/go-linq/v3"
+	"github.com/ahmetb/go-linq/v3/internal/values"
+)
+
+// Condition filters records by comparing the value of a field to Value with
+// the operator Op, like the WhereField method of linq.Query.
+type Condition struct {
+	Field string
+	Op    string
+	Value interface{}
+}
+
+// Order sorts records by the value of a field, in descending order if Desc is
+// true.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// Spec is the specification of a query over records: the records satisfying
+// all conditions of Where, sorted by the fields of OrderBy, skipping the first
+// Offset records and returning at most Limit records if Limit is positive.
+//
+// Field names are the names of database columns. In memory, they are resolved
+// by WhereField and OrderByExpr, which accept the names of map keys, json tags
+// and struct fields in any letter case with or without underscores.
+type Spec struct {
+	Where   []Condition
+	OrderBy []Order
+	Offset  int
+	Limit   int
+}
+
+// Plan returns the linq.Plan that applies the specification in memory, with a
+// WhereField step for each condition, an OrderByExpr step if the records are
+// sorted, and Skip and Take steps for Offset and Limit if they are positive.
+func (s Spec) Plan() linq.Plan {
+	var p linq.Plan
+	for _, c := range s.Where {
+		p = p.WhereField(c.Field, c.Op, c.Value)
+	}
+
+	if len(s.OrderBy) > 0 {
+		fields := make([]string, len(s.OrderBy))
+		for i, o := range s.OrderBy {
+			fields[i] = o.Field
+			if o.Desc {
+				fields[i] = "-" + o.Field
+			}
+		}
+
+		p = p.OrderByExpr(strings.Join(fields, ", "))
+	}
+
+	if s.Offset > 0 {
+		p = p.Skip(s.Offset)
+	}
+
+	if s.Limit > 0 {
+		p = p.Take(s.Limit)
+	}
+
+	return p
+}
+
+// Apply returns the query of the records of q matching the specification, as
+// built by linq.FromPlan from the plan returned by Plan. It panics with the
+// error of FromPlan if the operator of a condition is not supported by
+// WhereField.
+func (s Spec) Apply(q linq.Query) linq.Query {
+	q, err := linq.FromPlan(s.Plan(), q)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// SQL translates the specification into the WHERE, ORDER BY, LIMIT and OFFSET
+// clauses of a SELECT statement, in this order, and returns them with the
+// arguments of their "?" placeholders. The clauses are separated by spaces and
+// an empty specification yields an empty string. Since SQLite and MySQL don't
+// accept OFFSET without LIMIT, a specification with an Offset but no Limit
+// yields LIMIT 9223372036854775807, the largest limit they accept.
+//
+// The clauses use "?" placeholders, so they are meant for MySQL, SQLite and
+// the other databases whose drivers accept them. PostgreSQL drivers expect
+// numbered placeholders such as $1 instead, and are not supported.
+//
+// Operators are translated as follows:
+//
+//   - "==" and "!=" into = and <>, or into IS NULL and IS NOT NULL for a nil
+//     value;
+//   - "<", "<=", ">" and ">=" into themselves;
+//   - "contains" into LIKE with the value as a substring and ! as the escape
+//     character, which matches the in-memory behavior for string fields only;
+//   - "in" into IN with a placeholder for each element of the value, which is
+//     a slice, an array or a comma-separated string.
+//
+// SQL returns an error if a field name is not a plain, optionally qualified,
+// SQL identifier or an operator is not supported, so that specifications
+// built from user input can't inject SQL.
+func (s Spec) SQL() (clause string, args []interface{}, err error) {
+	var clauses []string
+
+	if len(s.Where) > 0 {
+		conditions := make([]string, len(s.Where))
+		for i, c := range s.Where {
+			if conditions[i], args, err = c.sql(args); err != nil {
+				return "", nil, err
+			}
+		}
+
+		clauses = append(clauses, "WHERE "+strings.Join(conditions, " AND "))
+	}
+
+	if len(s.OrderBy) > 0 {
+		orders := make([]string, len(s.OrderBy))
+		for i, o := range s.OrderBy {
+			if err := checkIdentifier(o.Field); err != nil {
+				return "", nil, err
+			}
+
+			orders[i] = o.Field
+			if o.Desc {
+				orders[i] += " DESC"
+			}
+		}
+
+		clauses = append(clauses, "ORDER BY "+strings.Join(orders, ", "))
+	}
+
+	if s.Limit > 0 {
+		clauses = append(clauses, "LIMIT "+strconv.Itoa(s.Limit))
+	} else if s.Offset > 0 {
+		clauses = append(clauses, "LIMIT "+strconv.FormatInt(math.MaxInt64, 10))
+	}
+
+	if s.Offset > 0 {
+		clauses = append(clauses, "OFFSET "+strconv.Itoa(s.Offset))
+	}
+
+	return strings.Join(clauses, " "), args, nil
+}
+
+// sql translates the condition into a SQL expression and appends its
+// arguments to args.
+func (c Condition) sql(args []interface{}) (string, []interface{}, error) {
+	if err := checkIdentifier(c.Field); err != nil {
+		return "", nil, err
+	}
+
+	switch c.Op {
+	case "==", "!=":
+		if c.Value == nil {
+			if c.Op == "==" {
+				return c.Field + " IS NULL", args, nil
+			}
+
+			return c.Field + " IS NOT NULL", args, nil
+		}
+
+		op := "="
+		if c.Op == "!=" {
+			op = "<>"
+		}
+
+		return c.Field + " " + op + " ?", append(args, c.Value), nil
+	case "<", "<=", ">", ">=":
+		return c.Field + " " + c.Op + " ?", append(args, c.Value), nil
+	case "contains":
+		pattern := "%" + likeEscaper.Replace(fmt.Sprint(c.Value)) + "%"
+		return c.Field + " LIKE ? ESCAPE '!'", append(args, pattern), nil
+	case "in":
+		values := values.In(c.Value)
+		if len(values) == 0 {
+			return "1 = 0", args, nil
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+		return c.Field + " IN (" + placeholders + ")", append(args, values...), nil
+	}
+
+	return "", nil, fmt.Errorf("linqsql: unsupported operator %q", c.Op)
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern with !, which, unlike a
+// backslash, needs no escaping in the string literal of the ESCAPE clause in
+// any SQL mode of MySQL.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// checkIdentifier returns an error if name is not a plain SQL identifier,
+// optionally qualified by a table name.
+func checkIdentifier(name string) error {
+	for _, part := range strings.Split(name, ".") {
+		valid := part != ""
+		for i, c := range part {
+			switch {
+			case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			case c >= '0' && c <= '9' && i > 0:
+			default:
+				valid = false
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("linqsql: invalid field name %q", name)
+		}
+	}
+
+	return nil
+}
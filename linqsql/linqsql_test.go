@@ -0,0 +1,139 @@
+package linqsql
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ahmetb/go-linq/v3"
+)
+
+type user struct {
+	Name      string
+	Age       int
+	Country   string
+	CreatedAt time.Time
+}
+
+func TestApply(t *testing.T) {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := []user{
+		{"Ann", 30, "DE", day},
+		{"Bob", 17, "DE", day.AddDate(0, 0, 1)},
+		{"Cid", 45, "FR", day.AddDate(0, 0, 2)},
+		{"Dee", 19, "IT", day.AddDate(0, 0, 3)},
+		{"Eve", 52, "FR", day.AddDate(0, 0, 4)},
+	}
+
+	spec := Spec{
+		Where: []Condition{
+			{"age", ">=", 18},
+			{"country", "in", "DE,FR"},
+		},
+		OrderBy: []Order{{Field: "created_at", Desc: true}},
+		Offset:  1,
+		Limit:   2,
+	}
+
+	var got []string
+	spec.Apply(linq.From(users)).SelectField("Name").ToSlice(&got)
+
+	if want := []string{"Cid", "Ann"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply()=%v expected %v", got, want)
+	}
+
+	if n := (Spec{}).Apply(linq.From(users)).Count(); n != len(users) {
+		t.Errorf("Spec{}.Apply().Count()=%d expected %d", n, len(users))
+	}
+}
+
+func TestPlan(t *testing.T) {
+	spec := Spec{
+		Where:   []Condition{{"age", ">=", 18}},
+		OrderBy: []Order{{Field: "name"}, {Field: "created_at", Desc: true}},
+		Offset:  1,
+		Limit:   2,
+	}
+
+	want := linq.Plan{}.WhereField("age", ">=", 18).OrderByExpr("name, -created_at").Skip(1).Take(2)
+	if got := spec.Plan(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan()=%v expected %v", got, want)
+	}
+
+	if got := (Spec{}).Plan(); len(got.Steps) != 0 {
+		t.Errorf("Spec{}.Plan()=%v expected no steps", got)
+	}
+}
+
+func TestApply_PanicWhenOperatorIsInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Apply() with an invalid operator expected to panic")
+		}
+	}()
+
+	Spec{Where: []Condition{{"age", "~", 18}}}.Apply(linq.From([]user{}))
+}
+
+func TestSQL(t *testing.T) {
+	tests := []struct {
+		spec   Spec
+		clause string
+		args   []interface{}
+	}{
+		{Spec{}, "", nil},
+		{
+			Spec{
+				Where: []Condition{
+					{"age", ">=", 18},
+					{"u.country", "in", []string{"DE", "FR"}},
+					{"name", "contains", "50%_a!"},
+					{"deleted_at", "==", nil},
+					{"status", "!=", "banned"},
+				},
+				OrderBy: []Order{{Field: "created_at", Desc: true}, {Field: "name"}},
+				Limit:   10,
+				Offset:  20,
+			},
+			"WHERE age >= ? AND u.country IN (?, ?) AND name LIKE ? ESCAPE '!' AND deleted_at IS NULL AND status <> ? " +
+				"ORDER BY created_at DESC, name LIMIT 10 OFFSET 20",
+			[]interface{}{18, "DE", "FR", "%50!%!_a!!%", "banned"},
+		},
+		{
+			Spec{Where: []Condition{{"id", "in", []int{}}, {"parent_id", "!=", nil}}},
+			"WHERE 1 = 0 AND parent_id IS NOT NULL",
+			nil,
+		},
+		{Spec{Offset: 5}, "LIMIT 9223372036854775807 OFFSET 5", nil},
+		{
+			Spec{Where: []Condition{{"country", "in", "DE, FR"}}},
+			"WHERE country IN (?, ?)",
+			[]interface{}{"DE", "FR"},
+		},
+	}
+
+	for _, test := range tests {
+		clause, args, err := test.spec.SQL()
+		if err != nil || clause != test.clause || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("SQL()=%q, %v, %v expected %q, %v, nil", clause, args, err, test.clause, test.args)
+		}
+	}
+}
+
+func TestSQL_ReturnsErrorWhenSpecIsInvalid(t *testing.T) {
+	tests := []struct {
+		spec Spec
+		err  string
+	}{
+		{Spec{Where: []Condition{{"age; DROP TABLE users", "==", 1}}}, `linqsql: invalid field name "age; DROP TABLE users"`},
+		{Spec{Where: []Condition{{"age", "=~", 1}}}, `linqsql: unsupported operator "=~"`},
+		{Spec{OrderBy: []Order{{Field: "1name"}}}, `linqsql: invalid field name "1name"`},
+		{Spec{OrderBy: []Order{{Field: "users."}}}, `linqsql: invalid field name "users."`},
+	}
+
+	for _, test := range tests {
+		if _, _, err := test.spec.SQL(); err == nil || err.Error() != test.err {
+			t.Errorf("SQL() error=%v expected %s", err, test.err)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package linq
+
+// Lookup is a collection of keys each mapped to one or more elements. It is
+// returned by ToLookup method and can be passed to JoinLookup and
+// GroupJoinLookup to join against an already materialized collection without
+// rebuilding its hash table on every iteration.
+type Lookup struct {
+	keys   []interface{}
+	groups map[interface{}][]interface{}
+}
+
+// Get returns the elements that have the specified key, in the order they
+// appeared in the source collection.
+func (l Lookup) Get(key interface{}) []interface{} {
+	return l.groups[key]
+}
+
+// Item returns a query that iterates over the elements that have the
+// specified key, in the order they appeared in the source collection. The
+// query is empty if the lookup doesn't contain the key.
+func (l Lookup) Item(key interface{}) Query {
+	return From(l.groups[key]).describe("Lookup.Item")
+}
+
+// Contains reports whether the lookup contains the specified key.
+func (l Lookup) Contains(key interface{}) bool {
+	_, has := l.groups[key]
+	return has
+}
+
+// Keys returns the distinct keys of the lookup, in the order they first
+// appeared in the source collection.
+func (l Lookup) Keys() []interface{} {
+	return append([]interface{}(nil), l.keys...)
+}
+
+// Len returns the number of distinct keys in the lookup.
+func (l Lookup) Len() int {
+	return len(l.keys)
+}
+
+// Query returns a query that iterates over the groups of the lookup in the
+// order their keys first appeared in the source collection. Elements of the
+// query are of type Group.
+func (l Lookup) Query() Query {
+	return fromIndex(len(l.keys), func(i int) interface{} {
+		key := l.keys[i]
+		return Group{Key: key, Group: l.groups[key]}
+	}).describe("Lookup")
+}
+
+// ToLookup iterates over a collection and groups its elements by the keys
+// extracted by keySelector function into a Lookup.
+func (q Query) ToLookup(keySelector func(interface{}) interface{}) Lookup {
+	next := q.Iterate()
+	l := Lookup{groups: make(map[interface{}][]interface{})}
+
+	for item, ok := next(); ok; item, ok = next() {
+		key := keySelector(item)
+		group, has := l.groups[key]
+		if !has {
+			l.keys = append(l.keys, key)
+		}
+
+		l.groups[key] = append(group, item)
+	}
+
+	return l
+}
+
+// ToLookupT is the typed version of ToLookup.
+//
+//   - keySelectorFn is of type "func(TSource) TKey"
+//
+// NOTE: ToLookup has better performance than ToLookupT.
+func (q Query) ToLookupT(keySelectorFn interface{}) Lookup {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"ToLookupT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	return q.ToLookup(keySelectorFunc)
+}
+
+// JoinLookup is like Join, but matches the elements of the collection against
+// the elements of a prebuilt Lookup instead of hashing an inner collection on
+// every iteration.
+func (q Query) JoinLookup(inner Lookup,
+	outerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inner interface{}) interface{}) Query {
+
+	return Query{
+		desc: q.chain("JoinLookup"),
+		Iterate: func() Iterator {
+			return joinIterator(q.Iterate(), inner.groups, outerKeySelector, resultSelector)
+		},
+	}
+}
+
+// GroupJoinLookup is like GroupJoin, but matches the elements of the
+// collection against the elements of a prebuilt Lookup instead of hashing an
+// inner collection on every iteration.
+func (q Query) GroupJoinLookup(inner Lookup,
+	outerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inners []interface{}) interface{}) Query {
+
+	return Query{
+		desc: q.chain("GroupJoinLookup"),
+		Iterate: func() Iterator {
+			return groupJoinIterator(q.Iterate(), inner.groups, outerKeySelector, resultSelector)
+		},
+	}
+}
+
+// buildLookup groups the elements returned by next by their keys.
+func buildLookup(next Iterator, keySelector func(interface{}) interface{}) map[interface{}][]interface{} {
+	groups := make(map[interface{}][]interface{})
+	for item, ok := next(); ok; item, ok = next() {
+		key := keySelector(item)
+		groups[key] = append(groups[key], item)
+	}
+
+	return groups
+}
+
+// buildOuterSide reports whether a join of outer with inner should build its
+// hash table from outer, which is the case when outer is random access and
+// known to be smaller than inner.
+func buildOuterSide(outer, inner Query) bool {
+	return outer.index != nil && outer.length != nil && inner.length != nil &&
+		outer.length() < inner.length()
+}
+
+// matchOuter hashes the elements of a random access outer collection and
+// returns, for each of them, the matching elements of inner in their original
+// order.
+func matchOuter(outer, inner Query,
+	outerKeySelector func(interface{}) interface{},
+	innerKeySelector func(interface{}) interface{}) [][]interface{} {
+
+	n := outer.length()
+	outerIndices := make(map[interface{}][]int, n)
+	for i := 0; i < n; i++ {
+		key := outerKeySelector(outer.index(i))
+		outerIndices[key] = append(outerIndices[key], i)
+	}
+
+	matches := make([][]interface{}, n)
+	innernext := inner.Iterate()
+	for innerItem, ok := innernext(); ok; innerItem, ok = innernext() {
+		for _, i := range outerIndices[innerKeySelector(innerItem)] {
+			matches[i] = append(matches[i], innerItem)
+		}
+	}
+
+	return matches
+}
+
+func joinIterator(outernext Iterator, innerLookup map[interface{}][]interface{},
+	outerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inner interface{}) interface{}) Iterator {
+
+	var outerItem interface{}
+	var innerGroup []interface{}
+	innerLen, innerIndex := 0, 0
+
+	return func() (item interface{}, ok bool) {
+		if innerIndex >= innerLen {
+			has := false
+			for !has {
+				outerItem, ok = outernext()
+				if !ok {
+					return
+				}
+
+				innerGroup, has = innerLookup[outerKeySelector(outerItem)]
+				innerLen = len(innerGroup)
+				innerIndex = 0
+			}
+		}
+
+		item = resultSelector(outerItem, innerGroup[innerIndex])
+		innerIndex++
+		return item, true
+	}
+}
+
+func groupJoinIterator(outernext Iterator, innerLookup map[interface{}][]interface{},
+	outerKeySelector func(interface{}) interface{},
+	resultSelector func(outer interface{}, inners []interface{}) interface{}) Iterator {
+
+	return func() (item interface{}, ok bool) {
+		if item, ok = outernext(); !ok {
+			return
+		}
+
+		if group, has := innerLookup[outerKeySelector(item)]; !has {
+			item = resultSelector(item, []interface{}{})
+		} else {
+			item = resultSelector(item, group)
+		}
+
+		return
+	}
+}
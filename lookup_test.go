@@ -0,0 +1,94 @@
+package linq
+
+import "testing"
+
+func TestToLookup(t *testing.T) {
+	l := From([]int{1, 2, 3, 4, 5}).ToLookup(func(i interface{}) interface{} {
+		return i.(int) % 2
+	})
+
+	if l.Len() != 2 {
+		t.Errorf("ToLookup().Len()=%v expected 2", l.Len())
+	}
+
+	if w := []interface{}{1, 3, 5}; !validateQuery(From(l.Get(1)), w) {
+		t.Errorf("ToLookup().Get(1)=%v expected %v", l.Get(1), w)
+	}
+
+	if g := l.Get(2); g != nil {
+		t.Errorf("ToLookup().Get(2)=%v expected nil", g)
+	}
+
+	groups := l.Query().Results()
+	if len(groups) != 2 || groups[0].(Group).Key != 1 || groups[1].(Group).Key != 0 {
+		t.Errorf("ToLookup().Query()=%v expected groups with keys 1, 0", groups)
+	}
+}
+
+func TestLookupItem(t *testing.T) {
+	l := From([]string{"a", "bb", "c", "dd"}).ToLookup(func(i interface{}) interface{} {
+		return len(i.(string))
+	})
+
+	if w := []interface{}{"bb", "dd"}; !validateQuery(l.Item(2), w) {
+		t.Errorf("ToLookup().Item(2)=%v expected %v", toSlice(l.Item(2)), w)
+	}
+
+	if q := l.Item(3); !validateQuery(q, []interface{}{}) || l.Contains(3) || !l.Contains(1) {
+		t.Errorf("ToLookup().Item(3)=%v, Contains(3)=%v expected [], false", toSlice(q), l.Contains(3))
+	}
+
+	if keys := l.Keys(); len(keys) != 2 || keys[0] != 1 || keys[1] != 2 {
+		t.Errorf("ToLookup().Keys()=%v expected [1 2]", keys)
+	}
+}
+
+func TestToLookupT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToLookupT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 2, 3}).ToLookupT(func(i, j int) int { return i })
+	})
+}
+
+func TestJoinLookup(t *testing.T) {
+	inner := From([]int{1, 2, 1, 4, 7, 6, 7, 2}).ToLookupT(func(i int) int { return i })
+	want := []interface{}{
+		KeyValue{1, 1},
+		KeyValue{1, 1},
+		KeyValue{2, 2},
+		KeyValue{2, 2},
+		KeyValue{4, 4},
+	}
+
+	q := From([]int{0, 1, 2, 3, 4, 5, 8}).JoinLookup(
+		inner,
+		func(i interface{}) interface{} { return i },
+		func(outer interface{}, inner interface{}) interface{} {
+			return KeyValue{outer, inner}
+		})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From().JoinLookup()=%v expected %v", toSlice(q), want)
+	}
+}
+
+func TestGroupJoinLookup(t *testing.T) {
+	inner := From([]int{1, 2, 3, 4, 5, 6, 7, 8, 9}).ToLookup(func(i interface{}) interface{} {
+		return i.(int) % 2
+	})
+	want := []interface{}{
+		KeyValue{0, 4},
+		KeyValue{1, 5},
+		KeyValue{2, 0},
+	}
+
+	q := From([]int{0, 1, 2}).GroupJoinLookup(
+		inner,
+		func(i interface{}) interface{} { return i },
+		func(outer interface{}, inners []interface{}) interface{} {
+			return KeyValue{outer, len(inners)}
+		})
+
+	if !validateQuery(q, want) {
+		t.Errorf("From().GroupJoinLookup()=%v expected %v", toSlice(q), want)
+	}
+}
@@ -0,0 +1,117 @@
+package linq
+
+import "runtime"
+
+// NotificationKind is the kind of a Notification.
+type NotificationKind int
+
+const (
+	// OnNext is the kind of a notification that carries an element.
+	OnNext NotificationKind = iota
+	// OnError is the kind of a notification that carries the error that
+	// ended a collection.
+	OnError
+	// OnCompleted is the kind of a notification that marks the end of a
+	// collection.
+	OnCompleted
+)
+
+// Notification is a type that is used to store the result of Materialize
+// method. It represents either an element of a collection, or the way the
+// collection ended.
+type Notification struct {
+	Kind  NotificationKind
+	Value interface{}
+	Err   error
+}
+
+// Materialize turns the elements of a collection and the way it ends into
+// Notification values, so they can flow through ordinary operators.
+//
+// Each element is emitted as an OnNext notification. If the collection ends
+// normally, an OnCompleted notification follows. If iterating over it panics
+// with an error, as the sources reading from an io.Reader do, an OnError
+// notification carrying the error is emitted instead and the collection ends.
+// Runtime panics and panics with values that are not errors are not
+// recovered.
+func (q Query) Materialize() Query {
+	return Query{
+		desc: q.chain("Materialize"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			done := false
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				item, ok, err := tryNext(next)
+				switch {
+				case err != nil:
+					done = true
+					return Notification{Kind: OnError, Err: err}, true
+				case !ok:
+					done = true
+					return Notification{Kind: OnCompleted}, true
+				}
+
+				return Notification{Kind: OnNext, Value: item}, true
+			}
+		},
+	}
+}
+
+// Dematerialize reverses Materialize: it turns a collection of Notification
+// values back into the elements they carry. The collection ends at the first
+// OnCompleted notification, and iterating over it panics with the error of the
+// first OnError notification.
+func (q Query) Dematerialize() Query {
+	return Query{
+		desc: q.chain("Dematerialize"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			done := false
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				if item, ok = next(); !ok {
+					return
+				}
+
+				n := item.(Notification)
+				switch n.Kind {
+				case OnNext:
+					return n.Value, true
+				case OnError:
+					done = true
+					panic(n.Err)
+				}
+
+				done = true
+				return nil, false
+			}
+		},
+	}
+}
+
+// tryNext calls next and recovers a panic with an error, returning the error.
+// Runtime panics are not recovered.
+func tryNext(next Iterator) (item interface{}, ok bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, isErr := r.(error)
+			if _, isRuntime := r.(runtime.Error); !isErr || isRuntime {
+				panic(r)
+			}
+
+			item, ok, err = nil, false, e
+		}
+	}()
+
+	item, ok = next()
+	return
+}
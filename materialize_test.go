@@ -0,0 +1,75 @@
+package linq
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMaterialize(t *testing.T) {
+	w := []interface{}{
+		Notification{Kind: OnNext, Value: 1},
+		Notification{Kind: OnNext, Value: 2},
+		Notification{Kind: OnCompleted},
+	}
+
+	if q := From([]int{1, 2}).Materialize(); !validateQuery(q, w) {
+		t.Errorf("From([]int{1, 2}).Materialize()=%v expected %v", toSlice(q), w)
+	}
+
+	err := errors.New("read failed")
+	failing := From([]int{1, 2, 3}).Select(func(i interface{}) interface{} {
+		if i.(int) == 2 {
+			panic(err)
+		}
+
+		return i
+	})
+
+	w = []interface{}{
+		Notification{Kind: OnNext, Value: 1},
+		Notification{Kind: OnError, Err: err},
+	}
+
+	if q := failing.Materialize(); !validateQuery(q, w) {
+		t.Errorf("Materialize()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestMaterialize_PanicWhenPanicIsNotError(t *testing.T) {
+	mustPanicWithError(t, "boom", func() {
+		From([]int{1}).Select(func(interface{}) interface{} {
+			panic("boom")
+		}).Materialize().Results()
+	})
+
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(error).Error(), "nil map") {
+			t.Errorf("Materialize() recovered a runtime panic: %v", r)
+		}
+	}()
+
+	From([]int{1}).Select(func(i interface{}) interface{} {
+		var m map[int]int
+		m[0] = 1
+		return i
+	}).Materialize().Results()
+}
+
+func TestDematerialize(t *testing.T) {
+	input := []int{1, 2, 3}
+	w := []interface{}{1, 2, 3}
+
+	if q := From(input).Materialize().Dematerialize(); !validateQuery(q, w) {
+		t.Errorf("From(%v).Materialize().Dematerialize()=%v expected %v", input, toSlice(q), w)
+	}
+
+	q := From([]Notification{{Kind: OnNext, Value: 1}, {Kind: OnCompleted}, {Kind: OnNext, Value: 2}}).Dematerialize()
+	if w := []interface{}{1}; !validateQuery(q, w) {
+		t.Errorf("Dematerialize()=%v expected %v", toSlice(q), w)
+	}
+
+	mustPanicWithError(t, "read failed", func() {
+		From([]Notification{{Kind: OnNext, Value: 1}, {Kind: OnError, Err: errors.New("read failed")}}).Dematerialize().Results()
+	})
+}
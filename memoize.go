@@ -0,0 +1,197 @@
+package linq
+
+import (
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// MemoizedQuery is the type returned from Memoize and MemoizeSpill methods.
+// Call Close once the query is no longer needed to release the resources it
+// holds.
+type MemoizedQuery struct {
+	Query
+	cache *memoCache
+}
+
+// Close releases the elements cached by the query and removes its spill file,
+// if any. The query must not be iterated after it has been closed.
+func (mq MemoizedQuery) Close() error {
+	return mq.cache.close()
+}
+
+// Memoize returns a query that caches the elements of a collection as they are
+// produced, so the collection is iterated only once no matter how many times
+// the returned query is iterated. Iterating over the returned query from
+// multiple goroutines is safe.
+//
+// All the elements are kept in memory; use MemoizeSpill to bound the memory
+// used by large collections.
+func (q Query) Memoize() MemoizedQuery {
+	mq := q.MemoizeSpill(-1)
+	mq.desc = q.chain("Memoize")
+	return mq
+}
+
+// MemoizeSpill is like Memoize, but keeps at most maxInMemory elements in
+// memory. The elements that don't fit are gob-encoded into a temporary file
+// and decoded from it when the query is iterated again. A negative
+// maxInMemory keeps all the elements in memory.
+//
+// Elements that are written to the file are encoded as interface values, so
+// their concrete types have to be registered with gob.Register, unless they
+// are basic types. If the file can not be written or read, the iterator
+// panics with the error.
+func (q Query) MemoizeSpill(maxInMemory int) MemoizedQuery {
+	cache := &memoCache{source: q, maxInMemory: maxInMemory}
+
+	return MemoizedQuery{
+		cache: cache,
+		Query: Query{
+			desc: q.chain("MemoizeSpill(" + strconv.Itoa(maxInMemory) + ")"),
+			Iterate: func() Iterator {
+				index := 0
+				var spill *memoSpillReader
+
+				return func() (item interface{}, ok bool) {
+					item, ok, spill = cache.get(index, spill)
+					if ok {
+						index++
+					}
+
+					return
+				}
+			},
+		},
+	}
+}
+
+// memoCache holds the elements produced so far by the source of a memoized
+// query.
+type memoCache struct {
+	mu          sync.Mutex
+	source      Query
+	next        Iterator
+	done        bool
+	maxInMemory int
+	items       []interface{}
+	spilled     int
+	file        *os.File
+	encoder     *gob.Encoder
+	readers     map[*memoSpillReader]bool
+}
+
+// memoSpillReader decodes the spilled elements of a memoCache for one
+// iteration.
+type memoSpillReader struct {
+	file    *os.File
+	decoder *gob.Decoder
+}
+
+// get returns the element at the specified index, producing it from the
+// source if it has not been produced yet. The spill reader of the iteration
+// is created when the iteration reaches the spilled elements, and is tracked
+// until the iteration ends so that close can close the readers of iterations
+// that were stopped early.
+func (c *memoCache) get(index int, spill *memoSpillReader) (interface{}, bool, *memoSpillReader) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.next == nil && !c.done {
+		c.next = c.source.Iterate()
+	}
+
+	for index >= len(c.items)+c.spilled && !c.done {
+		item, ok := c.next()
+		if !ok {
+			c.done = true
+			break
+		}
+
+		c.add(item)
+	}
+
+	if index < len(c.items) {
+		return c.items[index], true, spill
+	}
+
+	if index >= len(c.items)+c.spilled {
+		if spill != nil {
+			spill.file.Close()
+			delete(c.readers, spill)
+		}
+
+		return nil, false, nil
+	}
+
+	if spill == nil {
+		f, err := os.Open(c.file.Name())
+		if err != nil {
+			panic(err)
+		}
+
+		spill = &memoSpillReader{file: f, decoder: gob.NewDecoder(f)}
+		if c.readers == nil {
+			c.readers = make(map[*memoSpillReader]bool)
+		}
+
+		c.readers[spill] = true
+	}
+
+	var item interface{}
+	if err := spill.decoder.Decode(&item); err != nil {
+		panic(err)
+	}
+
+	return item, true, spill
+}
+
+// add adds an element produced by the source to the cache.
+func (c *memoCache) add(item interface{}) {
+	if c.maxInMemory < 0 || len(c.items) < c.maxInMemory {
+		c.items = append(c.items, item)
+		return
+	}
+
+	if c.file == nil {
+		f, err := ioutil.TempFile("", "linq-memoize-")
+		if err != nil {
+			panic(err)
+		}
+
+		c.file = f
+		c.encoder = gob.NewEncoder(f)
+	}
+
+	if err := c.encoder.Encode(&item); err != nil {
+		panic(err)
+	}
+
+	c.spilled++
+}
+
+func (c *memoCache) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = nil
+	if c.file == nil {
+		return nil
+	}
+
+	for spill := range c.readers {
+		spill.file.Close()
+	}
+
+	c.readers = nil
+	name := c.file.Name()
+	err := c.file.Close()
+	if rmErr := os.Remove(name); err == nil {
+		err = rmErr
+	}
+
+	c.file = nil
+	return err
+}
@@ -0,0 +1,117 @@
+package linq
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	q := Range(1, 5).Select(func(i interface{}) interface{} {
+		calls++
+		return i
+	}).Memoize()
+	defer q.Close()
+
+	w := []interface{}{1, 2, 3, 4, 5}
+	for i := 0; i < 3; i++ {
+		if !validateQuery(q.Query, w) {
+			t.Errorf("Memoize()=%v expected %v", toSlice(q.Query), w)
+		}
+	}
+
+	if calls != 5 {
+		t.Errorf("Memoize() iterated the source %d times expected 5", calls)
+	}
+}
+
+func TestMemoizeInterleaved(t *testing.T) {
+	q := Range(1, 3).Memoize()
+	defer q.Close()
+
+	first, second := q.Iterate(), q.Iterate()
+	a, _ := first()
+	b, _ := second()
+	c, _ := second()
+	d, _ := first()
+	if a != 1 || b != 1 || c != 2 || d != 2 {
+		t.Errorf("Memoize() interleaved=%v,%v,%v,%v expected 1,1,2,2", a, b, c, d)
+	}
+}
+
+func TestMemoizeSpill(t *testing.T) {
+	calls := 0
+	q := Range(1, 10).Select(func(i interface{}) interface{} {
+		calls++
+		return i
+	}).MemoizeSpill(3)
+
+	w := []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for i := 0; i < 3; i++ {
+		if !validateQuery(q.Query, w) {
+			t.Errorf("MemoizeSpill(3)=%v expected %v", toSlice(q.Query), w)
+		}
+	}
+
+	if calls != 10 {
+		t.Errorf("MemoizeSpill(3) iterated the source %d times expected 10", calls)
+	}
+
+	if len(q.cache.items) != 3 {
+		t.Errorf("MemoizeSpill(3) kept %d elements in memory expected 3", len(q.cache.items))
+	}
+
+	name := q.cache.file.Name()
+	if err := q.Close(); err != nil {
+		t.Errorf("MemoizeSpill(3).Close()=%v expected nil", err)
+	}
+
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("MemoizeSpill(3).Close() left spill file %s", name)
+	}
+}
+
+func TestMemoizeSpillClosesStoppedIterations(t *testing.T) {
+	q := Range(1, 10).MemoizeSpill(3)
+
+	if first := q.Skip(5).First(); first != 6 {
+		t.Errorf("MemoizeSpill(3).Skip(5).First()=%v expected 6", first)
+	}
+
+	if len(q.cache.readers) != 1 {
+		t.Fatalf("MemoizeSpill(3) has %d open spill readers expected 1", len(q.cache.readers))
+	}
+
+	var spill *memoSpillReader
+	for spill = range q.cache.readers {
+	}
+
+	if err := q.Close(); err != nil {
+		t.Errorf("MemoizeSpill(3).Close()=%v expected nil", err)
+	}
+
+	if err := spill.file.Close(); err == nil {
+		t.Errorf("MemoizeSpill(3).Close() left the spill file of a stopped iteration open")
+	}
+
+	if len(q.cache.readers) != 0 {
+		t.Errorf("MemoizeSpill(3).Close() kept %d spill readers", len(q.cache.readers))
+	}
+}
+
+func TestMemoizeSpillInterleaved(t *testing.T) {
+	q := Range(1, 4).MemoizeSpill(1)
+	defer q.Close()
+
+	first := q.Iterate()
+	first()
+	first()
+
+	if w := []interface{}{1, 2, 3, 4}; !validateQuery(q.Query, w) {
+		t.Errorf("MemoizeSpill(1)=%v expected %v", toSlice(q.Query), w)
+	}
+
+	if item, _ := first(); item != 3 {
+		t.Errorf("MemoizeSpill(1) resumed at %v expected 3", item)
+	}
+}
@@ -0,0 +1,256 @@
+package linq
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// MovingSum returns a query with the sum of each element of a collection of
+// numeric values and the window-1 elements preceding it, so that the result
+// has one element per source element. The first window-1 sums cover only the
+// elements seen so far.
+//
+// Values can be of any integer, unsigned integer or float type. Following the
+// conventions of SumInts, SumUInts and SumFloats, the sums are of type int64,
+// uint64 or float64 respectively. Float sums are kept with compensated
+// summation like in SumFloatsStable, so a value of a much larger magnitude
+// doesn't spoil the sums of the windows that follow it. MovingSum panics if
+// window is not positive.
+func (q Query) MovingSum(window int) Query {
+	if window <= 0 {
+		panic(errors.New("MovingSum: non-positive window"))
+	}
+
+	return Query{
+		desc:   q.chain("MovingSum(" + strconv.Itoa(window) + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			values := make([]interface{}, 0, window)
+			var sum interface{}
+			var floats *floatWindow
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				v := movingValue(item)
+				if f, isFloat := v.(float64); isFloat {
+					if floats == nil {
+						floats = newFloatWindow(window)
+					}
+
+					return floats.push(f), true
+				}
+
+				if sum == nil {
+					sum = v
+				} else {
+					sum = movingAdd(sum, v, 1)
+				}
+
+				if len(values) < window {
+					values = append(values, v)
+				} else {
+					sum = movingAdd(sum, values[index], -1)
+					values[index] = v
+					index = (index + 1) % window
+				}
+
+				return sum, true
+			}
+		},
+	}
+}
+
+// MovingAverage returns a query with the arithmetic mean of each element of a
+// collection of numeric values and the window-1 elements preceding it, as a
+// float64, so that the result has one element per source element. The first
+// window-1 averages cover only the elements seen so far.
+//
+// Values can be of any integer, unsigned integer or float type. Like in
+// MovingSum, the sums are kept with compensated summation. MovingAverage
+// panics if window is not positive.
+func (q Query) MovingAverage(window int) Query {
+	if window <= 0 {
+		panic(errors.New("MovingAverage: non-positive window"))
+	}
+
+	return Query{
+		desc:   q.chain("MovingAverage(" + strconv.Itoa(window) + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			window := newFloatWindow(window)
+			var convert floatConverter
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				if convert == nil {
+					convert = getNumericConverter(item)
+				}
+
+				sum := window.push(convert(item))
+				return sum / float64(len(window.values)), true
+			}
+		},
+	}
+}
+
+// MovingMax returns a query with the maximum of each element of a collection
+// and the window-1 elements preceding it, so that the result has one element
+// per source element. The first window-1 maximums cover only the elements
+// seen so far.
+//
+// The maximums are tracked with a monotonic queue, so each source element is
+// compared a constant number of times on average regardless of the size of
+// the window. Elements are compared with the Comparer of the query options, if
+// set. MovingMax panics if window is not positive.
+func (q Query) MovingMax(window int) Query {
+	if window <= 0 {
+		panic(errors.New("MovingMax: non-positive window"))
+	}
+
+	return q.moving("MovingMax", window, 1)
+}
+
+// MovingMin returns a query with the minimum of each element of a collection
+// and the window-1 elements preceding it, so that the result has one element
+// per source element. The first window-1 minimums cover only the elements
+// seen so far.
+//
+// The minimums are tracked with a monotonic queue, so each source element is
+// compared a constant number of times on average regardless of the size of
+// the window. Elements are compared with the Comparer of the query options, if
+// set. MovingMin panics if window is not positive.
+func (q Query) MovingMin(window int) Query {
+	if window <= 0 {
+		panic(errors.New("MovingMin: non-positive window"))
+	}
+
+	return q.moving("MovingMin", window, -1)
+}
+
+// moving returns the extreme element of each window of a query: the maximum
+// if sign is positive and the minimum if it's negative. The queue holds the
+// positions of the elements that can still become the extreme of a window,
+// in order of position and of decreasing extremeness.
+func (q Query) moving(name string, window int, sign int) Query {
+	type entry struct {
+		position int
+		item     interface{}
+	}
+
+	return Query{
+		desc:   q.chain(name + "(" + strconv.Itoa(window) + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var compare comparer
+			var queue []entry
+			position := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				if compare == nil {
+					compare = q.comparer(item)
+				}
+
+				for len(queue) > 0 && sign*compare(item, queue[len(queue)-1].item) >= 0 {
+					queue = queue[:len(queue)-1]
+				}
+
+				queue = append(queue, entry{position, item})
+				if queue[0].position <= position-window {
+					queue = queue[1:]
+				}
+
+				position++
+				return queue[0].item, true
+			}
+		},
+	}
+}
+
+// movingValue converts a numeric value to the type of the sums of MovingSum.
+func movingValue(item interface{}) interface{} {
+	switch item.(type) {
+	case int, int8, int16, int32, int64:
+		return getIntConverter(item)(item)
+	case uint, uint8, uint16, uint32, uint64:
+		return getUIntConverter(item)(item)
+	default:
+		return getFloatConverter(item)(item)
+	}
+}
+
+// movingAdd adds v, converted by movingValue, to the integer sum if sign is
+// positive and subtracts it if sign is negative.
+func movingAdd(sum, v interface{}, sign int) interface{} {
+	switch s := sum.(type) {
+	case int64:
+		if sign < 0 {
+			return s - v.(int64)
+		}
+
+		return s + v.(int64)
+	default:
+		if sign < 0 {
+			return s.(uint64) - v.(uint64)
+		}
+
+		return s.(uint64) + v.(uint64)
+	}
+}
+
+// floatWindow is the compensated sum of the last floats of a moving window.
+type floatWindow struct {
+	values []float64
+	index  int
+	sum    neumaierSum
+}
+
+// newFloatWindow returns an empty window of the specified size.
+func newFloatWindow(size int) *floatWindow {
+	return &floatWindow{values: make([]float64, 0, size)}
+}
+
+// push adds v to the window, removing the oldest value if the window is full,
+// and returns the sum of the window. If the removed value is not finite, the
+// sum is recomputed from the values of the window, since subtracting it would
+// leave a NaN.
+func (w *floatWindow) push(v float64) float64 {
+	if len(w.values) < cap(w.values) {
+		w.values = append(w.values, v)
+		w.sum.add(v)
+		return w.sum.value()
+	}
+
+	old := w.values[w.index]
+	w.values[w.index] = v
+	w.index = (w.index + 1) % len(w.values)
+
+	if math.IsInf(old, 0) || math.IsNaN(old) {
+		w.sum = neumaierSum{}
+		for _, f := range w.values {
+			w.sum.add(f)
+		}
+	} else {
+		w.sum.add(v)
+		w.sum.add(-old)
+	}
+
+	return w.sum.value()
+}
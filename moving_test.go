@@ -0,0 +1,103 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMovingSum(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		window int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5}, 3, []interface{}{int64(1), int64(3), int64(6), int64(9), int64(12)}},
+		{[]uint{1, 2, 3}, 2, []interface{}{uint64(1), uint64(3), uint64(5)}},
+		{[]float32{0.5, 1.5, 2}, 1, []interface{}{float64(0.5), float64(1.5), float64(2)}},
+		{[]float64{1e16, 1, 1, 1, 1}, 2, []interface{}{1e16, 1e16 + 1, 2., 2., 2.}},
+		{[]float64{1, math.Inf(1), 1, 1}, 2, []interface{}{1., math.Inf(1), math.Inf(1), 2.}},
+		{[]int{}, 2, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).MovingSum(test.window); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).MovingSum(%v)=%v expected %v", test.input, test.window, toSlice(q), test.output)
+		}
+	}
+
+	mustPanicWithError(t, "MovingSum: non-positive window", func() {
+		From([]int{1}).MovingSum(0)
+	})
+}
+
+func TestMovingAverage(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		window int
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []interface{}{1., 1.5, 2.5, 3.5, 4.5}},
+		{[]float64{2, 4, 6, 8}, 3, []interface{}{2., 3., 4., 6.}},
+		{[]float64{1e16, 1, 1, 3}, 2, []interface{}{1e16, 5e15, 1., 2.}},
+		{[]uint8{}, 2, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).MovingAverage(test.window); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).MovingAverage(%v)=%v expected %v", test.input, test.window, toSlice(q), test.output)
+		}
+	}
+
+	mustPanicWithError(t, "MovingAverage: non-positive window", func() {
+		From([]int{1}).MovingAverage(-1)
+	})
+}
+
+func TestMovingMax(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		window int
+		output []interface{}
+	}{
+		{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []interface{}{1, 3, 3, 3, 5, 5, 6, 7}},
+		{[]int{5, 4, 3, 2, 1}, 2, []interface{}{5, 5, 4, 3, 2}},
+		{[]string{"b", "a", "c"}, 1, []interface{}{"b", "a", "c"}},
+		{[]int{}, 3, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).MovingMax(test.window); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).MovingMax(%v)=%v expected %v", test.input, test.window, toSlice(q), test.output)
+		}
+	}
+
+	mustPanicWithError(t, "MovingMax: non-positive window", func() {
+		From([]int{1}).MovingMax(0)
+	})
+}
+
+func TestMovingMin(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		window int
+		output []interface{}
+	}{
+		{[]int{1, 3, -1, -3, 5, 3, 6, 7}, 3, []interface{}{1, 1, -1, -3, -3, -3, 3, 3}},
+		{[]int{1, 2, 3, 4}, 2, []interface{}{1, 1, 2, 3}},
+		{[]int{}, 3, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).MovingMin(test.window); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).MovingMin(%v)=%v expected %v", test.input, test.window, toSlice(q), test.output)
+		}
+	}
+
+	mustPanicWithError(t, "MovingMin: non-positive window", func() {
+		From([]int{1}).MovingMin(0)
+	})
+
+	if got, want := From([]int{1, 2}).MovingMin(2).String(), "From(slice[2]).MovingMin(2)"; got != want {
+		t.Errorf("MovingMin().String()=%v expected %v", got, want)
+	}
+}
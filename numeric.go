@@ -0,0 +1,85 @@
+package linq
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+)
+
+// AverageNumeric computes the average of a collection of numbers of mixed
+// types. See SumNumeric for the supported element types. Method returns NaN if
+// collection contains no elements, and ErrNotNumeric as soon as an element is
+// not a number.
+func (q Query) AverageNumeric() (float64, error) {
+	next := q.Iterate()
+	var mean float64
+	n := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		f, ok := toFloat64(item)
+		if !ok {
+			return 0, ErrNotNumeric
+		}
+
+		n++
+		mean += (f - mean) / float64(n)
+	}
+
+	if n == 0 {
+		return math.NaN(), nil
+	}
+
+	return mean, nil
+}
+
+// SumNumeric computes the sum of a collection of numbers of mixed types.
+//
+// Unlike SumInts, SumUInts and SumFloats, which pick a conversion based on the
+// type of the first element, SumNumeric converts each element on its own, so
+// collections that mix integer, unsigned integer and float elements, such as
+// values decoded from JSON, can be summed. Elements of named numeric types and
+// json.Number are supported too. Method returns ErrNotNumeric as soon as an
+// element is not a number.
+func (q Query) SumNumeric() (float64, error) {
+	next := q.Iterate()
+	var sum float64
+
+	for item, ok := next(); ok; item, ok = next() {
+		f, ok := toFloat64(item)
+		if !ok {
+			return 0, ErrNotNumeric
+		}
+
+		sum += f
+	}
+
+	return sum, nil
+}
+
+// toFloat64 converts a number of any type to float64, and reports whether
+// item is a number.
+func toFloat64(item interface{}) (float64, bool) {
+	switch n := item.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+
+	v := reflect.ValueOf(item)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+
+	return 0, false
+}
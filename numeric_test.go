@@ -0,0 +1,51 @@
+package linq
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSumNumeric(t *testing.T) {
+	tests := []struct {
+		input []interface{}
+		want  float64
+		err   error
+	}{
+		{[]interface{}{1, uint8(2), 3.5, float32(0.5), int64(-1)}, 6, nil},
+		{[]interface{}{json.Number("1.5"), 2.5, time.Duration(1)}, 5, nil},
+		{[]interface{}{}, 0, nil},
+		{[]interface{}{1, "2"}, 0, ErrNotNumeric},
+		{[]interface{}{json.Number("x")}, 0, ErrNotNumeric},
+		{[]interface{}{1, nil}, 0, ErrNotNumeric},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).SumNumeric(); r != test.want || err != test.err {
+			t.Errorf("From(%v).SumNumeric()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+}
+
+func TestAverageNumeric(t *testing.T) {
+	tests := []struct {
+		input []interface{}
+		want  float64
+		err   error
+	}{
+		{[]interface{}{1, uint(2), 3.0}, 2, nil},
+		{[]interface{}{json.Number("4"), 2}, 3, nil},
+		{[]interface{}{1, true}, 0, ErrNotNumeric},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).AverageNumeric(); r != test.want || err != test.err {
+			t.Errorf("From(%v).AverageNumeric()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+
+	if r, err := From([]interface{}{}).AverageNumeric(); !math.IsNaN(r) || err != nil {
+		t.Errorf("From([]interface{}{}).AverageNumeric()=%v,%v expected NaN,nil", r, err)
+	}
+}
@@ -0,0 +1,133 @@
+package linq
+
+import (
+	"context"
+	"runtime"
+)
+
+// Options holds settings that the operators a query is passed to consult,
+// instead of having a variant of each operator for every combination of
+// settings. The zero value of a field means the default behavior.
+type Options struct {
+	// Comparer, if set, compares elements, or the keys of elements, instead
+	// of the default comparison of basic types and Comparable in Min, Max,
+	// MinMax, MinParallel, MaxParallel, OrderBy, OrderByDescending and the
+	// ThenBy methods following them. It returns a negative number, zero or a
+	// positive number if a is less than, equal to or greater than b.
+	Comparer func(a, b interface{}) int
+
+	// Capacity, if positive, is a hint of the approximate number of elements
+	// of the query, as set by WithCapacityHint.
+	Capacity int
+
+	// Parallelism, if positive, is the number of workers used by the
+	// parallel methods, such as AggregateParallel, when they are passed a
+	// number of workers that is not positive.
+	Parallelism int
+
+	// FloatTolerance, if positive, makes Contains, SequenceEqual, Distinct
+	// and IndexOfSequence consider float elements equal if they differ by at
+	// most FloatTolerance, as set by WithFloatTolerance. Since such an
+	// equality is not transitive, OrderBy, Min, Max and the other methods
+	// ordering elements keep comparing floats exactly.
+	FloatTolerance float64
+
+	// Unordered, if set, lets FanOut return the results of the elements in
+	// the order they are ready instead of the order of the collection.
+	Unordered bool
+
+	// Context, if set, ends the iteration over the query early when it is
+	// done. Use Subscribe to be notified of the cancellation.
+	Context context.Context
+}
+
+// WithOptions returns the query with the specified options. Like
+// WithCapacityHint, options apply only to the operator the query is directly
+// passed to: in q.WithOptions(opts).Where(f).OrderBy(key), OrderBy doesn't see
+// opts, so options have to be set again on the query passed to each operator
+// that should consult them.
+//
+// Context is the exception: it ends the iteration over the query and so over
+// every query built from it, including iterations resumed with IterateFrom.
+// The query then no longer supports random access or reports its number of
+// elements without iterating, so that operators such as Skip, Select and Count
+// read its elements through the cancellable iteration.
+func (q Query) WithOptions(opts Options) Query {
+	if opts.Capacity > 0 {
+		q.capacityHint = opts.Capacity
+	}
+
+	if ctx := opts.Context; ctx != nil {
+		cancellable := func(next Iterator) Iterator {
+			return func() (item interface{}, ok bool) {
+				if ctx.Err() != nil {
+					return nil, false
+				}
+
+				return next()
+			}
+		}
+
+		iterate := q.Iterate
+		q.Iterate = func() Iterator {
+			return cancellable(iterate())
+		}
+		q.resume = q.resumeWith(cancellable)
+		q.index, q.length, q.enumerate = nil, nil, nil
+	}
+
+	q.options = &opts
+	return q
+}
+
+// Options returns the options set with WithOptions, so that operators defined
+// outside of this package can consult them too.
+func (q Query) Options() Options {
+	if q.options == nil {
+		return Options{Capacity: q.capacityHint}
+	}
+
+	opts := *q.options
+	opts.Capacity = q.capacityHint
+	return opts
+}
+
+// context returns the Context option of the query, or context.Background().
+func (q Query) context() context.Context {
+	if q.options == nil || q.options.Context == nil {
+		return context.Background()
+	}
+
+	return q.options.Context
+}
+
+// comparer returns the comparer set with WithOptions, normalized to return -1,
+// 0 or 1 like the default comparers, or the default comparer for the type of
+// sample.
+func (q Query) comparer(sample interface{}) comparer {
+	if q.options == nil || q.options.Comparer == nil {
+		return getComparer(sample)
+	}
+
+	compare := q.options.Comparer
+	return func(x, y interface{}) int {
+		switch c := compare(x, y); {
+		case c < 0:
+			return -1
+		case c > 0:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// parallelism returns the number of workers of parallel methods passed a
+// number of workers that is not positive.
+func (q Query) parallelism() int {
+	if q.options != nil && q.options.Parallelism > 0 {
+		return q.options.Parallelism
+	}
+
+	return runtime.GOMAXPROCS(0)
+}
@@ -0,0 +1,105 @@
+package linq
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWithOptionsComparer(t *testing.T) {
+	byLength := Options{Comparer: func(a, b interface{}) int {
+		return len(a.(string)) - len(b.(string))
+	}}
+	words := From([]string{"ccc", "a", "bb"}).WithOptions(byLength)
+
+	if got := words.Min(); got != "a" {
+		t.Errorf("WithOptions(Comparer).Min()=%v expected a", got)
+	}
+
+	if got := words.Max(); got != "ccc" {
+		t.Errorf("WithOptions(Comparer).Max()=%v expected ccc", got)
+	}
+
+	if min, max := words.MinMax(); min != "a" || max != "ccc" {
+		t.Errorf("WithOptions(Comparer).MinMax()=%v, %v expected a, ccc", min, max)
+	}
+
+	if got := words.MaxParallel(2); got != "ccc" {
+		t.Errorf("WithOptions(Comparer).MaxParallel()=%v expected ccc", got)
+	}
+
+	identity := func(i interface{}) interface{} { return i }
+	q := words.OrderByDescending(identity).Query
+	if w := []interface{}{"ccc", "bb", "a"}; !validateQuery(q, w) {
+		t.Errorf("WithOptions(Comparer).OrderByDescending()=%v expected %v", toSlice(q), w)
+	}
+
+	q = From([]string{"b", "A", "a", "B"}).WithOptions(Options{Comparer: func(a, b interface{}) int {
+		return strings.Compare(strings.ToLower(a.(string)), strings.ToLower(b.(string)))
+	}}).OrderBy(identity).ThenBy(func(i interface{}) interface{} { return i }).Query
+
+	if w := []interface{}{"A", "a", "b", "B"}; !validateQuery(q, w) {
+		t.Errorf("WithOptions(Comparer).OrderBy().ThenBy()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestWithOptionsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var got []interface{}
+
+	Range(1, 10).WithOptions(Options{Context: ctx}).Select(func(i interface{}) interface{} {
+		if i.(int) == 3 {
+			cancel()
+		}
+
+		return i
+	}).ForEach(func(i interface{}) {
+		got = append(got, i)
+	})
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(From(got), w) {
+		t.Errorf("WithOptions(Context)=%v expected %v", got, w)
+	}
+}
+
+func TestWithOptionsContextResumable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	q := From([]int{1, 2, 3, 4, 5}).WithOptions(Options{Context: ctx})
+
+	c, err := q.Select(func(i interface{}) interface{} { return i.(int) * 10 }).IterateFrom("1")
+	if err != nil {
+		t.Fatalf("WithOptions(Context).Select().IterateFrom()=%v expected no error", err)
+	}
+
+	if item, ok := c.Next(); !ok || item != 20 {
+		t.Errorf("WithOptions(Context).IterateFrom().Next()=%v, %v expected 20, true", item, ok)
+	}
+
+	cancel()
+	if item, ok := c.Next(); ok {
+		t.Errorf("WithOptions(Context).IterateFrom().Next()=%v after cancel expected no element", item)
+	}
+
+	if got := toSlice(q.Skip(1)); len(got) != 0 {
+		t.Errorf("WithOptions(Context).Skip(1)=%v after cancel expected no elements", got)
+	}
+
+	if n := q.Count(); n != 0 {
+		t.Errorf("WithOptions(Context).Count()=%d after cancel expected 0", n)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	q := Range(1, 10).WithOptions(Options{Capacity: 100, Parallelism: 3})
+	if opts := q.Options(); opts.Capacity != 100 || opts.Parallelism != 3 || q.capacity() != 100 || q.parallelism() != 3 {
+		t.Errorf("Options()=%+v expected Capacity 100 and Parallelism 3", opts)
+	}
+
+	if opts := Range(1, 10).WithCapacityHint(5).Options(); opts.Capacity != 5 {
+		t.Errorf("WithCapacityHint(5).Options()=%+v expected Capacity 5", opts)
+	}
+
+	if got := q.SumIntsParallel(0); got != 55 {
+		t.Errorf("WithOptions(Parallelism).SumIntsParallel(0)=%d expected 55", got)
+	}
+}
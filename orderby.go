@@ -23,6 +23,7 @@ func (q Query) OrderBy(selector func(interface{}) interface{}) OrderedQuery {
 		orders:   []order{{selector: selector}},
 		original: q,
 		Query: Query{
+			desc: q.chain("OrderBy"),
 			Iterate: func() Iterator {
 				items := q.sort([]order{{selector: selector}})
 				len := len(items)
@@ -38,7 +39,7 @@ func (q Query) OrderBy(selector func(interface{}) interface{}) OrderedQuery {
 					return
 				}
 			},
-		},
+		}.elementsOf(q),
 	}
 }
 
@@ -60,7 +61,9 @@ func (q Query) OrderByT(selectorFn interface{}) OrderedQuery {
 		return selectorGenericFunc.Call(item)
 	}
 
-	return q.OrderBy(selectorFunc)
+	oq := q.OrderBy(selectorFunc)
+	oq.Query = oq.Query.filteredBy(q, selectorGenericFunc, 0)
+	return oq
 }
 
 // OrderByDescending sorts the elements of a collection in descending order.
@@ -70,6 +73,7 @@ func (q Query) OrderByDescending(selector func(interface{}) interface{}) Ordered
 		orders:   []order{{selector: selector, desc: true}},
 		original: q,
 		Query: Query{
+			desc: q.chain("OrderByDescending"),
 			Iterate: func() Iterator {
 				items := q.sort([]order{{selector: selector, desc: true}})
 				len := len(items)
@@ -85,7 +89,7 @@ func (q Query) OrderByDescending(selector func(interface{}) interface{}) Ordered
 					return
 				}
 			},
-		},
+		}.elementsOf(q),
 	}
 }
 
@@ -105,7 +109,9 @@ func (q Query) OrderByDescendingT(selectorFn interface{}) OrderedQuery {
 		return selectorGenericFunc.Call(item)
 	}
 
-	return q.OrderByDescending(selectorFunc)
+	oq := q.OrderByDescending(selectorFunc)
+	oq.Query = oq.Query.filteredBy(q, selectorGenericFunc, 0)
+	return oq
 }
 
 // ThenBy performs a subsequent ordering of the elements in a collection in
@@ -117,6 +123,7 @@ func (oq OrderedQuery) ThenBy(
 		orders:   append(oq.orders, order{selector: selector}),
 		original: oq.original,
 		Query: Query{
+			desc: oq.chain("ThenBy"),
 			Iterate: func() Iterator {
 				items := oq.original.sort(append(oq.orders, order{selector: selector}))
 				len := len(items)
@@ -132,7 +139,7 @@ func (oq OrderedQuery) ThenBy(
 					return
 				}
 			},
-		},
+		}.elementsOf(oq.Query),
 	}
 }
 
@@ -152,7 +159,9 @@ func (oq OrderedQuery) ThenByT(selectorFn interface{}) OrderedQuery {
 		return selectorGenericFunc.Call(item)
 	}
 
-	return oq.ThenBy(selectorFunc)
+	r := oq.ThenBy(selectorFunc)
+	r.Query = r.Query.filteredBy(oq.Query, selectorGenericFunc, 0)
+	return r
 }
 
 // ThenByDescending performs a subsequent ordering of the elements in a
@@ -163,6 +172,7 @@ func (oq OrderedQuery) ThenByDescending(selector func(interface{}) interface{})
 		orders:   append(oq.orders, order{selector: selector, desc: true}),
 		original: oq.original,
 		Query: Query{
+			desc: oq.chain("ThenByDescending"),
 			Iterate: func() Iterator {
 				items := oq.original.sort(append(oq.orders, order{selector: selector, desc: true}))
 				len := len(items)
@@ -178,7 +188,7 @@ func (oq OrderedQuery) ThenByDescending(selector func(interface{}) interface{})
 					return
 				}
 			},
-		},
+		}.elementsOf(oq.Query),
 	}
 }
 
@@ -187,20 +197,25 @@ func (oq OrderedQuery) ThenByDescending(selector func(interface{}) interface{})
 // NOTE: ThenByDescending has better performance than ThenByDescendingT.
 func (oq OrderedQuery) ThenByDescendingT(selectorFn interface{}) OrderedQuery {
 	selectorFunc, ok := selectorFn.(func(interface{}) interface{})
-	if !ok {
-		selectorGenericFunc, err := newGenericFunc(
-			"ThenByDescending", "selectorFn", selectorFn,
-			simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
-		)
-		if err != nil {
-			panic(err)
-		}
-
-		selectorFunc = func(item interface{}) interface{} {
-			return selectorGenericFunc.Call(item)
-		}
+	if ok {
+		return oq.ThenByDescending(selectorFunc)
 	}
-	return oq.ThenByDescending(selectorFunc)
+
+	selectorGenericFunc, err := newGenericFunc(
+		"ThenByDescending", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc = func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	r := oq.ThenByDescending(selectorFunc)
+	r.Query = r.Query.filteredBy(oq.Query, selectorGenericFunc, 0)
+	return r
 }
 
 // Sort returns a new query by sorting elements with provided less function in
@@ -210,6 +225,7 @@ func (oq OrderedQuery) ThenByDescendingT(selectorFn interface{}) OrderedQuery {
 // much better.
 func (q Query) Sort(less func(i, j interface{}) bool) Query {
 	return Query{
+		desc: q.chain("Sort"),
 		Iterate: func() Iterator {
 			items := q.lessSort(less)
 			len := len(items)
@@ -275,7 +291,7 @@ func (q Query) sort(orders []order) (r []interface{}) {
 	}
 
 	for i, j := range orders {
-		orders[i].compare = getComparer(j.selector(r[0]))
+		orders[i].compare = q.comparer(j.selector(r[0]))
 	}
 
 	s := sorter{
@@ -0,0 +1,113 @@
+package linq
+
+// OrderedMap is a map that remembers the order in which its keys were first
+// inserted. It is returned by ToOrderedMap method. The zero value is an empty
+// map ready to use.
+type OrderedMap struct {
+	keys   []interface{}
+	values map[interface{}]interface{}
+}
+
+// Set sets the value for a key. If the key is new, it is added after all the
+// existing keys; otherwise its value is replaced and its position is kept.
+func (m *OrderedMap) Set(key, value interface{}) {
+	if m.values == nil {
+		m.values = make(map[interface{}]interface{})
+	}
+
+	if _, has := m.values[key]; !has {
+		m.keys = append(m.keys, key)
+	}
+
+	m.values[key] = value
+}
+
+// Get returns the value for a key, and whether the key is present in the map.
+func (m *OrderedMap) Get(key interface{}) (value interface{}, ok bool) {
+	value, ok = m.values[key]
+	return
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns the keys of the map in insertion order.
+func (m *OrderedMap) Keys() []interface{} {
+	return append([]interface{}(nil), m.keys...)
+}
+
+// Query returns a query that iterates over the map in insertion order.
+// Elements of the query are of type KeyValue.
+func (m *OrderedMap) Query() Query {
+	return Query{
+		desc: "OrderedMap.Query",
+		Iterate: func() Iterator {
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				ok = index < len(m.keys)
+				if ok {
+					key := m.keys[index]
+					item = KeyValue{Key: key, Value: m.values[key]}
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// ToOrderedMap iterates over a collection and returns an OrderedMap populated
+// with its elements. Functions keySelector and valueSelector are executed for
+// each element of the collection to generate key and value for the map. Keys
+// keep the order in which they first appear in the collection; when a key
+// appears again, its value is replaced.
+func (q Query) ToOrderedMap(keySelector func(interface{}) interface{},
+	valueSelector func(interface{}) interface{}) *OrderedMap {
+	m := &OrderedMap{}
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		m.Set(keySelector(item), valueSelector(item))
+	}
+
+	return m
+}
+
+// ToOrderedMapT is the typed version of ToOrderedMap.
+//
+//   - keySelectorFn is of type "func(TSource)TKey"
+//   - valueSelectorFn is of type "func(TSource)TValue"
+//
+// NOTE: ToOrderedMap has better performance than ToOrderedMapT.
+func (q Query) ToOrderedMapT(keySelectorFn interface{},
+	valueSelectorFn interface{}) *OrderedMap {
+	keySelectorGenericFunc, err := newGenericFunc(
+		"ToOrderedMapT", "keySelectorFn", keySelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	keySelectorFunc := func(item interface{}) interface{} {
+		return keySelectorGenericFunc.Call(item)
+	}
+
+	valueSelectorGenericFunc, err := newGenericFunc(
+		"ToOrderedMapT", "valueSelectorFn", valueSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	valueSelectorFunc := func(item interface{}) interface{} {
+		return valueSelectorGenericFunc.Call(item)
+	}
+
+	return q.ToOrderedMap(keySelectorFunc, valueSelectorFunc)
+}
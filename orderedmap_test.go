@@ -0,0 +1,67 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+
+	if m.Len() != 2 {
+		t.Errorf("OrderedMap.Len()=%v expected 2", m.Len())
+	}
+
+	if v, ok := m.Get("b"); !ok || v != 3 {
+		t.Errorf("OrderedMap.Get(b)=%v,%v expected 3,true", v, ok)
+	}
+
+	if v, ok := m.Get("c"); ok || v != nil {
+		t.Errorf("OrderedMap.Get(c)=%v,%v expected nil,false", v, ok)
+	}
+
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []interface{}{"b", "a"}) {
+		t.Errorf("OrderedMap.Keys()=%v expected [b a]", keys)
+	}
+}
+
+func TestToOrderedMap(t *testing.T) {
+	input := []int{5, 3, 8, 3, 1}
+	want := []interface{}{KeyValue{1, 1}, KeyValue{3, 9}, KeyValue{5, 25}, KeyValue{8, 64}}
+
+	m := From(input).OrderBy(func(i interface{}) interface{} {
+		return i
+	}).ToOrderedMap(func(i interface{}) interface{} {
+		return i
+	}, func(i interface{}) interface{} {
+		return i.(int) * i.(int)
+	})
+
+	if q := m.Query(); !validateQuery(q, want) {
+		t.Errorf("From(%v).OrderBy().ToOrderedMap()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestToOrderedMapT(t *testing.T) {
+	input := []string{"apple", "banana", "avocado"}
+	want := []interface{}{KeyValue{"a", "avocado"}, KeyValue{"b", "banana"}}
+
+	m := From(input).ToOrderedMapT(func(s string) string {
+		return s[:1]
+	}, func(s string) string {
+		return s
+	})
+
+	if q := m.Query(); !validateQuery(q, want) {
+		t.Errorf("From(%v).ToOrderedMapT()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestToOrderedMapT_PanicWhenKeySelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToOrderedMapT: parameter [keySelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1}).ToOrderedMapT(func(i, j int) int { return i }, func(i int) int { return i })
+	})
+}
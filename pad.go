@@ -0,0 +1,103 @@
+package linq
+
+import "strconv"
+
+// PadEnd appends fill values to the end of a collection until it contains at
+// least length elements. A collection that already contains length elements
+// or more is returned unchanged.
+func (q Query) PadEnd(length int, fill interface{}) Query {
+	return Query{
+		desc:   q.chain("PadEnd(" + strconv.Itoa(length) + ")"),
+		length: paddedLength(q, length),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			count := 0
+			ended := false
+
+			return func() (item interface{}, ok bool) {
+				if !ended {
+					if item, ok = next(); ok {
+						count++
+						return
+					}
+
+					ended = true
+				}
+
+				if count < length {
+					count++
+					return fill, true
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// PadStart inserts fill values at the beginning of a collection until it
+// contains at least length elements. A collection that already contains
+// length elements or more is returned unchanged.
+//
+// Unless the length of the collection is known without iterating over it, up
+// to length elements are buffered to find out how many fill values are
+// needed.
+func (q Query) PadStart(length int, fill interface{}) Query {
+	return Query{
+		desc:   q.chain("PadStart(" + strconv.Itoa(length) + ")"),
+		length: paddedLength(q, length),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var buffer []interface{}
+			padding := -1
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if padding < 0 {
+					if q.length != nil {
+						padding = knownLength(length - q.length())
+					} else {
+						for len(buffer) < length {
+							if item, ok = next(); !ok {
+								break
+							}
+
+							buffer = append(buffer, item)
+						}
+
+						padding = knownLength(length - len(buffer))
+					}
+				}
+
+				if padding > 0 {
+					padding--
+					return fill, true
+				}
+
+				if index < len(buffer) {
+					index++
+					return buffer[index-1], true
+				}
+
+				return next()
+			}
+		},
+	}
+}
+
+// paddedLength returns the length function of q padded to length elements,
+// or nil if the length of q is unknown.
+func paddedLength(q Query, length int) func() int {
+	if q.length == nil {
+		return nil
+	}
+
+	return func() int {
+		n := q.length()
+		if n < length {
+			return length
+		}
+
+		return n
+	}
+}
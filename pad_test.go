@@ -0,0 +1,53 @@
+package linq
+
+import "testing"
+
+func TestPadEnd(t *testing.T) {
+	tests := []struct {
+		input  Query
+		length int
+		want   []interface{}
+	}{
+		{Range(1, 2), 4, []interface{}{1, 2, 0, 0}},
+		{Range(1, 3), 2, []interface{}{1, 2, 3}},
+		{Empty(), 2, []interface{}{0, 0}},
+		{Range(1, 2), -1, []interface{}{1, 2}},
+	}
+
+	for _, test := range tests {
+		if q := test.input.PadEnd(test.length, 0); !validateQuery(q, test.want) {
+			t.Errorf("%v.PadEnd(%d)=%v expected %v", test.input, test.length, toSlice(q), test.want)
+		}
+	}
+
+	if c := Range(1, 2).PadEnd(5, 0).Count(); c != 5 {
+		t.Errorf("Range(1, 2).PadEnd(5).Count()=%d expected 5", c)
+	}
+}
+
+func TestPadStart(t *testing.T) {
+	where := func(interface{}) bool { return true }
+
+	tests := []struct {
+		input  Query
+		length int
+		want   []interface{}
+	}{
+		{Range(1, 2), 4, []interface{}{0, 0, 1, 2}},
+		{Range(1, 2).Where(where), 4, []interface{}{0, 0, 1, 2}},
+		{Range(1, 3), 2, []interface{}{1, 2, 3}},
+		{Range(1, 3).Where(where), 2, []interface{}{1, 2, 3}},
+		{Empty(), 2, []interface{}{0, 0}},
+		{Range(1, 2).Where(where), 0, []interface{}{1, 2}},
+	}
+
+	for _, test := range tests {
+		if q := test.input.PadStart(test.length, 0); !validateQuery(q, test.want) {
+			t.Errorf("%v.PadStart(%d)=%v expected %v", test.input, test.length, toSlice(q), test.want)
+		}
+	}
+
+	if c := Range(1, 2).PadStart(5, 0).Count(); c != 5 {
+		t.Errorf("Range(1, 2).PadStart(5).Count()=%d expected 5", c)
+	}
+}
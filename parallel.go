@@ -0,0 +1,210 @@
+package linq
+
+import "sync"
+
+// AggregateParallel applies an accumulator function over a sequence using
+// several goroutines.
+//
+// The collection is split into at most workers contiguous partitions, and each
+// partition is folded by its own goroutine, starting from seed. The partial
+// results are then merged in order with combiner. For the result to match a
+// sequential fold, combiner has to be associative and seed has to be its
+// identity element; folder and combiner must be safe for concurrent use and
+// must not modify seed. If workers is not positive, the Parallelism option set
+// with WithOptions or runtime.GOMAXPROCS(0) goroutines are used.
+//
+// Only collections that support random access, such as queries created from a
+// slice, array or string, are partitioned. Each goroutine reads the elements of
+// its partition by index, which runs the functions of the operators the
+// collection was built with, such as the selector of SelectPure, for those
+// elements: these functions run concurrently on the goroutines too, and must
+// also be safe for concurrent use. Other collections are folded sequentially
+// in the calling goroutine.
+func (q Query) AggregateParallel(seed interface{},
+	folder func(interface{}, interface{}) interface{},
+	combiner func(interface{}, interface{}) interface{},
+	workers int) interface{} {
+
+	if q.index == nil {
+		return q.AggregateWithSeed(seed, folder)
+	}
+
+	partials := q.partition(workers, func(lo, hi int) interface{} {
+		return q.subRange(lo, hi).AggregateWithSeed(seed, folder)
+	})
+
+	result := seed
+	for _, partial := range partials {
+		result = combiner(result, partial)
+	}
+
+	return result
+}
+
+// CountWithParallel is the parallel version of CountWith. The predicate must
+// be safe for concurrent use. See AggregateParallel for how the collection is
+// partitioned and which functions run concurrently.
+func (q Query) CountWithParallel(predicate func(interface{}) bool, workers int) int {
+	return q.AggregateParallel(0,
+		func(acc, item interface{}) interface{} {
+			if predicate(item) {
+				return acc.(int) + 1
+			}
+
+			return acc
+		},
+		func(a, b interface{}) interface{} { return a.(int) + b.(int) },
+		workers).(int)
+}
+
+// MaxParallel is the parallel version of Max. See AggregateParallel for how
+// the collection is partitioned and which functions run concurrently.
+func (q Query) MaxParallel(workers int) interface{} {
+	if q.index == nil {
+		return q.Max()
+	}
+
+	return q.extremeParallel(workers, 1)
+}
+
+// MinParallel is the parallel version of Min. See AggregateParallel for how the
+// collection is partitioned and which functions run concurrently.
+func (q Query) MinParallel(workers int) interface{} {
+	if q.index == nil {
+		return q.Min()
+	}
+
+	return q.extremeParallel(workers, -1)
+}
+
+// SumFloatsParallel is the parallel version of SumFloats. See
+// AggregateParallel for how the collection is partitioned and which functions
+// run concurrently.
+func (q Query) SumFloatsParallel(workers int) float64 {
+	if q.index == nil {
+		return q.SumFloats()
+	}
+
+	var sum float64
+	for _, partial := range q.partition(workers, func(lo, hi int) interface{} {
+		return q.subRange(lo, hi).SumFloats()
+	}) {
+		sum += partial.(float64)
+	}
+
+	return sum
+}
+
+// SumIntsParallel is the parallel version of SumInts. See AggregateParallel
+// for how the collection is partitioned and which functions run concurrently.
+func (q Query) SumIntsParallel(workers int) int64 {
+	if q.index == nil {
+		return q.SumInts()
+	}
+
+	var sum int64
+	for _, partial := range q.partition(workers, func(lo, hi int) interface{} {
+		return q.subRange(lo, hi).SumInts()
+	}) {
+		sum += partial.(int64)
+	}
+
+	return sum
+}
+
+// SumUIntsParallel is the parallel version of SumUInts. See AggregateParallel
+// for how the collection is partitioned and which functions run concurrently.
+func (q Query) SumUIntsParallel(workers int) uint64 {
+	if q.index == nil {
+		return q.SumUInts()
+	}
+
+	var sum uint64
+	for _, partial := range q.partition(workers, func(lo, hi int) interface{} {
+		return q.subRange(lo, hi).SumUInts()
+	}) {
+		sum += partial.(uint64)
+	}
+
+	return sum
+}
+
+// extremeParallel returns the maximum element of a random access collection
+// if sign is positive, or its minimum element if sign is negative. It returns
+// nil if the collection is empty.
+func (q Query) extremeParallel(workers int, sign int) interface{} {
+	if q.length() == 0 {
+		return nil
+	}
+
+	compare := q.comparer(q.index(0))
+	extreme := func(items Query) interface{} {
+		next := items.Iterate()
+		r, _ := next()
+
+		for item, ok := next(); ok; item, ok = next() {
+			if compare(item, r)*sign > 0 {
+				r = item
+			}
+		}
+
+		return r
+	}
+
+	partials := q.partition(workers, func(lo, hi int) interface{} {
+		return extreme(q.subRange(lo, hi))
+	})
+
+	return extreme(From(partials))
+}
+
+// partition splits a random access collection into at most workers
+// non-empty contiguous ranges, calls f for each of them in its own goroutine,
+// and returns the results in order. If f panics, partition panics with the
+// same value in the calling goroutine once all the goroutines have ended, so
+// that the panic can be recovered by the caller.
+func (q Query) partition(workers int, f func(lo, hi int) interface{}) []interface{} {
+	n := q.length()
+	if workers <= 0 {
+		workers = q.parallelism()
+	}
+
+	if workers > n {
+		workers = n
+	}
+
+	results := make([]interface{}, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	var once sync.Once
+	var reason interface{}
+	panicked := false
+
+	for w := 0; w < workers; w++ {
+		lo, hi := n*w/workers, n*(w+1)/workers
+		go func(w, lo, hi int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() { panicked, reason = true, r })
+				}
+			}()
+
+			results[w] = f(lo, hi)
+		}(w, lo, hi)
+	}
+
+	wg.Wait()
+	if panicked {
+		panic(reason)
+	}
+
+	return results
+}
+
+// subRange returns the elements of a random access collection from index lo
+// up to, but not including, index hi.
+func (q Query) subRange(lo, hi int) Query {
+	return fromIndex(hi-lo, func(i int) interface{} { return q.index(lo + i) })
+}
@@ -0,0 +1,111 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAggregateParallel(t *testing.T) {
+	sum := func(a, b interface{}) interface{} { return a.(int) + b.(int) }
+	tests := []struct {
+		input   Query
+		workers int
+		output  interface{}
+	}{
+		{Range(1, 100), 4, 5050},
+		{From(toSlice(Range(1, 100))), 4, 5050},
+		{From(toSlice(Range(1, 100))), 0, 5050},
+		{From(toSlice(Range(1, 3))), 8, 6},
+		{From([]int{}), 4, 0},
+	}
+
+	for _, test := range tests {
+		if r := test.input.AggregateParallel(0, sum, sum, test.workers); r != test.output {
+			t.Errorf("AggregateParallel(%v)=%v expected %v", test.workers, r, test.output)
+		}
+	}
+
+	concat := func(a, b interface{}) interface{} { return a.(string) + b.(string) }
+	if r := FromString("abcdefg").AggregateParallel("", func(acc, r interface{}) interface{} {
+		return acc.(string) + string(r.(rune))
+	}, concat, 3); r != "abcdefg" {
+		t.Errorf("AggregateParallel()=%v expected abcdefg", r)
+	}
+}
+
+func TestCountWithParallel(t *testing.T) {
+	even := func(i interface{}) bool { return i.(int)%2 == 0 }
+	input := toSlice(Range(1, 1001))
+
+	if r := From(input).CountWithParallel(even, 4); r != 500 {
+		t.Errorf("CountWithParallel()=%v expected 500", r)
+	}
+
+	if r := Range(1, 1001).CountWithParallel(even, 4); r != 500 {
+		t.Errorf("Range().CountWithParallel()=%v expected 500", r)
+	}
+}
+
+func TestMinMaxParallel(t *testing.T) {
+	tests := []struct {
+		input Query
+		min   interface{}
+		max   interface{}
+	}{
+		{From([]int{5, 3, 9, 1, 7, 2, 8}), 1, 9},
+		{From([]int{4}), 4, 4},
+		{FromString("golang"), 'a', 'o'},
+		{From([]int{}), nil, nil},
+		{Range(1, 10), 1, 10},
+	}
+
+	for _, test := range tests {
+		if r := test.input.MinParallel(3); r != test.min {
+			t.Errorf("MinParallel()=%v expected %v", r, test.min)
+		}
+
+		if r := test.input.MaxParallel(3); r != test.max {
+			t.Errorf("MaxParallel()=%v expected %v", r, test.max)
+		}
+	}
+}
+
+func TestSumParallel(t *testing.T) {
+	ints := From(toSlice(Range(1, 1000)))
+	if r := ints.SumIntsParallel(4); r != 500500 {
+		t.Errorf("SumIntsParallel()=%v expected 500500", r)
+	}
+
+	if r := From([]uint{1, 2, 3, 4, 5}).SumUIntsParallel(2); r != 15 {
+		t.Errorf("SumUIntsParallel()=%v expected 15", r)
+	}
+
+	if r := From([]float64{0.5, 1.5, 2, 4}).SumFloatsParallel(3); r != 8 {
+		t.Errorf("SumFloatsParallel()=%v expected 8", r)
+	}
+
+	if r := Range(1, 10).SumIntsParallel(4); r != 55 {
+		t.Errorf("Range().SumIntsParallel()=%v expected 55", r)
+	}
+
+	if r := From([]int{}).SumIntsParallel(4); r != 0 {
+		t.Errorf("SumIntsParallel()=%v expected 0", r)
+	}
+}
+
+func TestAggregateParallelWithPanic(t *testing.T) {
+	input := make([]int, 100)
+
+	mustPanicWithError(t, "fold failed", func() {
+		From(input).AggregateParallel(0, func(acc, item interface{}) interface{} {
+			panic(errors.New("fold failed"))
+		}, func(a, b interface{}) interface{} { return a }, 4)
+	})
+
+	q := From(input).Select(func(interface{}) interface{} {
+		panic(errors.New("select failed"))
+	})
+	mustPanicWithError(t, "select failed", func() {
+		q.SumIntsParallel(4)
+	})
+}
@@ -0,0 +1,169 @@
+package linq
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Permutations returns every permutation of the elements of a collection, as a
+// []interface{}. The elements are buffered when the query is iterated, and the
+// permutations are produced lazily with Heap's algorithm, each one from the
+// previous one by a single swap, so a collection of n elements produces n!
+// permutations without holding more than one of them at a time. The first
+// permutation has the elements in their original order.
+//
+// Elements are told apart by position, so a collection with duplicate elements
+// produces duplicate permutations. An empty collection produces one empty
+// permutation.
+func (q Query) Permutations() Query {
+	return q.permutations(q.chain("Permutations"), -1)
+}
+
+// PermutationsOf returns every permutation of k elements of a collection, as a
+// []interface{}. Like Permutations, the permutations are produced lazily: the
+// k-element subsets of the collection are taken in the order of the elements,
+// and the permutations of each subset are produced with Heap's algorithm.
+//
+// If k is greater than the number of elements, the result is empty, and if k
+// is zero, the result is one empty permutation. PermutationsOf panics if k is
+// negative.
+func (q Query) PermutationsOf(k int) Query {
+	if k < 0 {
+		panic(errors.New("PermutationsOf: negative length"))
+	}
+
+	return q.permutations(q.chain("PermutationsOf("+strconv.Itoa(k)+")"), k)
+}
+
+// permutations returns the permutations of k elements of q, or of all of its
+// elements if k is negative.
+func (q Query) permutations(desc string, k int) Query {
+	return Query{
+		desc: desc,
+		Iterate: func() Iterator {
+			var items []interface{}
+			var subset combinations
+			var perm heapPermutation
+			started, done := false, false
+			size := k
+
+			advance := func() bool {
+				if !started {
+					started = true
+					items = q.Results()
+					if size < 0 {
+						size = len(items)
+					}
+
+					if size > len(items) {
+						return false
+					}
+
+					subset = newCombinations(len(items), size)
+				} else if perm.next() {
+					return true
+				} else if !subset.next() {
+					return false
+				}
+
+				perm.reset(subset.pick(items))
+				return true
+			}
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				if !advance() {
+					done = true
+					return nil, false
+				}
+
+				permutation := make([]interface{}, len(perm.items))
+				copy(permutation, perm.items)
+				return permutation, true
+			}
+		},
+	}
+}
+
+// combinations enumerates the k-element subsets of n positions as ascending
+// indexes, in lexicographic order.
+type combinations struct {
+	n       int
+	indexes []int
+}
+
+func newCombinations(n, k int) combinations {
+	indexes := make([]int, k)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	return combinations{n: n, indexes: indexes}
+}
+
+// next advances to the next subset and reports whether there is one.
+func (c *combinations) next() bool {
+	k := len(c.indexes)
+	for i := k - 1; i >= 0; i-- {
+		if c.indexes[i] != i+c.n-k {
+			c.indexes[i]++
+			for j := i + 1; j < k; j++ {
+				c.indexes[j] = c.indexes[j-1] + 1
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// pick returns the elements of items at the indexes of the current subset.
+func (c *combinations) pick(items []interface{}) []interface{} {
+	subset := make([]interface{}, len(c.indexes))
+	for i, index := range c.indexes {
+		subset[i] = items[index]
+	}
+
+	return subset
+}
+
+// heapPermutation permutes items in place with the iterative form of Heap's
+// algorithm.
+type heapPermutation struct {
+	items []interface{}
+	c     []int
+	i     int
+}
+
+// reset starts the permutations of items, the first of which is items itself.
+func (p *heapPermutation) reset(items []interface{}) {
+	p.items = items
+	p.c = make([]int, len(items))
+	p.i = 0
+}
+
+// next advances to the next permutation and reports whether there is one.
+func (p *heapPermutation) next() bool {
+	for p.i < len(p.items) {
+		if p.c[p.i] < p.i {
+			if p.i%2 == 0 {
+				p.items[0], p.items[p.i] = p.items[p.i], p.items[0]
+			} else {
+				p.items[p.c[p.i]], p.items[p.i] = p.items[p.i], p.items[p.c[p.i]]
+			}
+
+			p.c[p.i]++
+			p.i = 0
+			return true
+		}
+
+		p.c[p.i] = 0
+		p.i++
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPermutations(t *testing.T) {
+	want := []interface{}{
+		[]interface{}{1, 2, 3}, []interface{}{2, 1, 3}, []interface{}{3, 1, 2},
+		[]interface{}{1, 3, 2}, []interface{}{2, 3, 1}, []interface{}{3, 2, 1},
+	}
+
+	if q := Range(1, 3).Permutations(); !reflect.DeepEqual(toSlice(q), want) {
+		t.Errorf("Range(1, 3).Permutations()=%v expected %v", toSlice(q), want)
+	}
+
+	if q := Empty().Permutations(); !reflect.DeepEqual(toSlice(q), []interface{}{[]interface{}{}}) {
+		t.Errorf("Empty().Permutations()=%v expected [[]]", toSlice(q))
+	}
+
+	q := Range(1, 6).Permutations()
+	if c, d := q.Count(), q.DistinctBy(func(p interface{}) interface{} {
+		return From(p).Aggregate(func(r, i interface{}) interface{} { return r.(int)*10 + i.(int) })
+	}).Count(); c != 720 || d != 720 {
+		t.Errorf("Range(1, 6).Permutations() count=%d, distinct=%d expected 720, 720", c, d)
+	}
+}
+
+func TestPermutationsOf(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []interface{}
+	}{
+		{0, []interface{}{[]interface{}{}}},
+		{1, []interface{}{[]interface{}{1}, []interface{}{2}, []interface{}{3}}},
+		{2, []interface{}{
+			[]interface{}{1, 2}, []interface{}{2, 1}, []interface{}{1, 3},
+			[]interface{}{3, 1}, []interface{}{2, 3}, []interface{}{3, 2},
+		}},
+		{4, nil},
+	}
+
+	for _, test := range tests {
+		if q := Range(1, 3).PermutationsOf(test.k); !reflect.DeepEqual(toSlice(q), test.want) {
+			t.Errorf("Range(1, 3).PermutationsOf(%d)=%v expected %v", test.k, toSlice(q), test.want)
+		}
+	}
+
+	if c := Range(1, 5).PermutationsOf(3).Count(); c != 60 {
+		t.Errorf("Range(1, 5).PermutationsOf(3).Count()=%d expected 60", c)
+	}
+}
+
+func TestPermutationsOf_PanicWhenLengthIsNegative(t *testing.T) {
+	mustPanicWithError(t, "PermutationsOf: negative length", func() {
+		Range(1, 3).PermutationsOf(-1)
+	})
+}
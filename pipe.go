@@ -0,0 +1,73 @@
+package linq
+
+// Pipe applies the specified operators to a query in order, so that operators
+// defined outside of this package can be chained fluently:
+//
+//	From(orders).Where(isOpen).Pipe(dedupeByCustomer, takeLatest(10)).ToSlice(&result)
+//
+// Each operator is a function that takes a query and returns a new query. Use
+// NewOperator to build one from a function that transforms an Iterator.
+func (q Query) Pipe(ops ...func(Query) Query) Query {
+	for _, op := range ops {
+		q = op(q)
+	}
+
+	return q
+}
+
+// NewOperator builds an operator that can be passed to Pipe from a function
+// that transforms the iterator of a query. wrap is called every time the
+// resulting query is iterated, with a fresh iterator of the source query, so
+// the operator is as lazy as the built-in ones.
+//
+// Example:
+//
+//	double := NewOperator(func(next Iterator) Iterator {
+//		return func() (interface{}, bool) {
+//			item, ok := next()
+//			if ok {
+//				item = item.(int) * 2
+//			}
+//			return item, ok
+//		}
+//	})
+func NewOperator(wrap func(next Iterator) Iterator) func(Query) Query {
+	return func(q Query) Query {
+		return WrapIterator(q, wrap)
+	}
+}
+
+// NewQuery initializes a linq query with a function that returns a new
+// iterator over a collection every time it is called. It is the same as
+// Query{Iterate: iterate}, and is meant for packages that implement their own
+// sources and operators.
+func NewQuery(iterate func() Iterator) Query {
+	return Query{Iterate: iterate}
+}
+
+// NewRandomAccessQuery initializes a linq query with a collection of the
+// specified length whose elements are returned by index. Unlike a query created
+// with NewQuery, it supports the same fast paths as a query created from a
+// slice: methods such as Count, ElementAt, Last, Skip and Reverse don't iterate
+// over the preceding elements. index is called only with values from 0 to
+// length-1.
+func NewRandomAccessQuery(length int, index func(int) interface{}) Query {
+	if length < 0 {
+		length = 0
+	}
+
+	return fromIndex(length, index)
+}
+
+// WrapIterator returns a query whose iterator is built by wrap from a fresh
+// iterator of q every time the query is iterated. wrap can filter, transform,
+// add or reorder elements; it must not call next before the returned iterator
+// is called, so that the query stays lazy.
+func WrapIterator(q Query, wrap func(next Iterator) Iterator) Query {
+	return Query{
+		desc: q.chain("WrapIterator"),
+		Iterate: func() Iterator {
+			return wrap(q.Iterate())
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package linq
+
+import "testing"
+
+func TestPipe(t *testing.T) {
+	double := NewOperator(func(next Iterator) Iterator {
+		return func() (interface{}, bool) {
+			item, ok := next()
+			if ok {
+				item = item.(int) * 2
+			}
+
+			return item, ok
+		}
+	})
+
+	takeTwo := func(q Query) Query { return q.Take(2) }
+
+	q := Range(1, 5).Pipe(double, takeTwo, double)
+	if w := []interface{}{4, 8}; !validateQuery(q, w) {
+		t.Errorf("Range(1, 5).Pipe()=%v expected %v", toSlice(q), w)
+	}
+
+	if w := []interface{}{4, 8}; !validateQuery(q, w) {
+		t.Errorf("Range(1, 5).Pipe() second iteration=%v expected %v", toSlice(q), w)
+	}
+
+	if q := Range(1, 2).Pipe(); !validateQuery(q, []interface{}{1, 2}) {
+		t.Errorf("Range(1, 2).Pipe()=%v expected [1 2]", toSlice(q))
+	}
+}
+
+func TestNewQuery(t *testing.T) {
+	q := NewQuery(func() Iterator {
+		i := 0
+		return func() (interface{}, bool) {
+			i++
+			return i, i <= 3
+		}
+	})
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(q, w) {
+		t.Errorf("NewQuery()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestNewRandomAccessQuery(t *testing.T) {
+	calls := 0
+	q := NewRandomAccessQuery(1000, func(i int) interface{} {
+		calls++
+		return i * i
+	})
+
+	if c := q.Count(); c != 1000 {
+		t.Errorf("NewRandomAccessQuery().Count()=%v expected 1000", c)
+	}
+
+	if e := q.ElementAt(30); e != 900 {
+		t.Errorf("NewRandomAccessQuery().ElementAt(30)=%v expected 900", e)
+	}
+
+	if l := q.Last(); l != 999*999 {
+		t.Errorf("NewRandomAccessQuery().Last()=%v expected %v", l, 999*999)
+	}
+
+	if calls != 2 {
+		t.Errorf("NewRandomAccessQuery() index called %d times expected 2", calls)
+	}
+
+	if c := NewRandomAccessQuery(-1, nil).Count(); c != 0 {
+		t.Errorf("NewRandomAccessQuery(-1).Count()=%v expected 0", c)
+	}
+}
+
+func TestWrapIterator(t *testing.T) {
+	evens := WrapIterator(Range(1, 6), func(next Iterator) Iterator {
+		return func() (item interface{}, ok bool) {
+			for item, ok = next(); ok; item, ok = next() {
+				if item.(int)%2 == 0 {
+					return
+				}
+			}
+
+			return
+		}
+	})
+
+	if w := []interface{}{2, 4, 6}; !validateQuery(evens, w) {
+		t.Errorf("WrapIterator()=%v expected %v", toSlice(evens), w)
+	}
+}
@@ -0,0 +1,129 @@
+package linq
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Plan is a serializable description of a pipeline built from the field-based
+// operators, such as WhereField, WhereExpr and SelectField, so that query
+// specifications can be stored, for example as saved reports, or sent to
+// another service as JSON, and applied to a source there with FromPlan.
+//
+// A Plan is built by chaining its methods, which mirror the operators of
+// Query, starting from the zero value:
+//
+//	plan := Plan{}.WhereExpr("age >= 18").OrderByExpr("-age").Take(10)
+//	data, err := json.Marshal(plan)
+//
+// The Plan method of linqsql.Spec returns the plan of a specification that can
+// also be translated into SQL.
+type Plan struct {
+	Steps []PlanStep `json:"steps"`
+}
+
+// PlanStep is an operator of a Plan with its arguments. Op is the name of the
+// operator, and the other fields are the arguments it takes.
+type PlanStep struct {
+	Op       string      `json:"op"`
+	Field    string      `json:"field,omitempty"`
+	Fields   []string    `json:"fields,omitempty"`
+	Operator string      `json:"operator,omitempty"`
+	Value    interface{} `json:"value"`
+	Expr     string      `json:"expr,omitempty"`
+	Count    int         `json:"count,omitempty"`
+}
+
+// then returns a copy of the plan with step appended, so that plans built from
+// a common prefix don't share their steps.
+func (p Plan) then(step PlanStep) Plan {
+	steps := make([]PlanStep, len(p.Steps), len(p.Steps)+1)
+	copy(steps, p.Steps)
+	return Plan{Steps: append(steps, step)}
+}
+
+// WhereField adds a step filtering the records like Query.WhereField. Values
+// decoded from JSON are strings, float64s, bools or slices of them, which
+// WhereField compares to fields of any numeric type.
+func (p Plan) WhereField(name, op string, value interface{}) Plan {
+	return p.then(PlanStep{Op: "WhereField", Field: name, Operator: op, Value: value})
+}
+
+// WhereExpr adds a step filtering the records like Query.WhereExpr.
+func (p Plan) WhereExpr(expr string) Plan {
+	return p.then(PlanStep{Op: "WhereExpr", Expr: expr})
+}
+
+// OrderByExpr adds a step sorting the records like Query.OrderByExpr.
+func (p Plan) OrderByExpr(spec string) Plan {
+	return p.then(PlanStep{Op: "OrderByExpr", Expr: spec})
+}
+
+// SelectField adds a step projecting the records like Query.SelectField.
+func (p Plan) SelectField(name string) Plan {
+	return p.then(PlanStep{Op: "SelectField", Field: name})
+}
+
+// SelectFields adds a step projecting the records like Query.SelectFields.
+func (p Plan) SelectFields(names ...string) Plan {
+	return p.then(PlanStep{Op: "SelectFields", Fields: append([]string(nil), names...)})
+}
+
+// Distinct adds a step removing duplicate elements like Query.Distinct.
+func (p Plan) Distinct() Plan {
+	return p.then(PlanStep{Op: "Distinct"})
+}
+
+// Skip adds a step bypassing elements like Query.Skip.
+func (p Plan) Skip(count int) Plan {
+	return p.then(PlanStep{Op: "Skip", Count: count})
+}
+
+// Take adds a step keeping the first elements like Query.Take.
+func (p Plan) Take(count int) Plan {
+	return p.then(PlanStep{Op: "Take", Count: count})
+}
+
+// FromPlan initializes a linq query that applies the steps of plan, such as a
+// plan decoded from JSON, to the records of source. Unlike the operators,
+// which panic, FromPlan returns the error an operator panics with if a step
+// has invalid arguments, or an error if it has an unknown operator, so that
+// plans received from elsewhere can be rejected.
+func FromPlan(plan Plan, source Query) (Query, error) {
+	q := source
+	for i, step := range plan.Steps {
+		var err error
+		if q, err = step.apply(q); err != nil {
+			return Query{}, fmt.Errorf("linq: plan step %d (%s): %v", i, step.Op, err)
+		}
+	}
+
+	return q, nil
+}
+
+// apply returns q with the operator of the step applied to it, or the error
+// the operator panics with.
+func (s PlanStep) apply(q Query) (Query, error) {
+	return buildQuery(func() Query {
+		switch s.Op {
+		case "WhereField":
+			return q.WhereField(s.Field, s.Operator, s.Value)
+		case "WhereExpr":
+			return q.WhereExpr(s.Expr)
+		case "OrderByExpr":
+			return q.OrderByExpr(s.Expr).Query
+		case "SelectField":
+			return q.SelectField(s.Field)
+		case "SelectFields":
+			return q.SelectFields(s.Fields...)
+		case "Distinct":
+			return q.Distinct()
+		case "Skip":
+			return q.Skip(s.Count)
+		case "Take":
+			return q.Take(s.Count)
+		}
+
+		panic(errors.New("unknown operator"))
+	})
+}
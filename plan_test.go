@@ -0,0 +1,72 @@
+package linq
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromPlan(t *testing.T) {
+	type person struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	people := []person{{"alice", 30}, {"bob", 17}, {"carol", 45}, {"dave", 30}}
+
+	plan := Plan{}.
+		WhereField("age", ">=", 18).
+		WhereExpr("name != 'dave'").
+		OrderByExpr("-age").
+		SelectField("Name").
+		Take(5)
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		t.Fatalf("json.Marshal(plan) returned %v", err)
+	}
+
+	var decoded Plan
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned %v", data, err)
+	}
+
+	q, err := FromPlan(decoded, From(people))
+	if w := []interface{}{"carol", "alice"}; err != nil || !validateQuery(q, w) {
+		t.Errorf("FromPlan(%s)=%v, %v expected %v", data, toSlice(q), err, w)
+	}
+
+	q, err = FromPlan(Plan{}.SelectFields("Name").Skip(3), From(people))
+	if err != nil || q.Count() != 1 || q.First().(map[string]interface{})["Name"] != "dave" {
+		t.Errorf("FromPlan()=%v, %v expected [map[Name:dave]]", toSlice(q), err)
+	}
+}
+
+func TestFromPlanWithError(t *testing.T) {
+	tests := []struct {
+		plan Plan
+		err  string
+	}{
+		{Plan{Steps: []PlanStep{{Op: "Drop"}}}, "linq: plan step 0 (Drop): unknown operator"},
+		{Plan{}.Distinct().WhereField("age", "~", 1), "linq: plan step 1 (WhereField): WhereField: parameter [op] has an invalid value. Expected: one of '==', '!=', '<', '<=', '>', '>=', 'contains', 'in', actual: '~'"},
+		{Plan{}.OrderByExpr("name,"), "linq: plan step 0 (OrderByExpr): OrderByExpr: parameter [spec] has an invalid value. Expected: comma-separated field names, actual: 'name,'"},
+	}
+
+	for _, test := range tests {
+		if _, err := FromPlan(test.plan, From([]int{})); err == nil || err.Error() != test.err {
+			t.Errorf("FromPlan(%v)=%v expected %v", test.plan, err, test.err)
+		}
+	}
+
+	if _, err := FromPlan(Plan{}.WhereExpr("age >"), From([]int{})); err == nil {
+		t.Errorf("FromPlan() with an invalid expression expected an error")
+	}
+}
+
+func TestPlanDoesNotShareSteps(t *testing.T) {
+	base := Plan{}.Distinct()
+	a, b := base.Take(1), base.Skip(1)
+
+	if a.Steps[1].Op != "Take" || b.Steps[1].Op != "Skip" || len(base.Steps) != 1 {
+		t.Errorf("Plan steps shared between %v and %v", a, b)
+	}
+}
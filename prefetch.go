@@ -0,0 +1,69 @@
+package linq
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Prefetch returns a query that iterates over a collection in its own
+// goroutine, ahead of the consumer, keeping up to n elements in a buffer. It
+// overlaps slow producers, such as sources reading from the network or from
+// files, with the processing of the elements that have already been produced.
+// The elements are returned in their original order.
+//
+// The goroutine is started when the first element is requested, and ends when
+// the collection is exhausted. If the consumer stops iterating before the end,
+// the goroutine stays blocked on the full buffer, unless the query passed to
+// Prefetch has a Context set with WithOptions, which ends the goroutine when
+// it is done. If iterating over the collection panics, the iterator of the
+// returned query panics with the same value once the elements produced before
+// have been returned. Prefetch panics if n is not positive.
+func (q Query) Prefetch(n int) Query {
+	if n <= 0 {
+		panic(errors.New("Prefetch: non-positive buffer size"))
+	}
+
+	ctx := q.context()
+
+	return Query{
+		desc:   q.chain("Prefetch(" + strconv.Itoa(n) + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			var buffer chan interface{}
+			var h *pumpHandle
+
+			return func() (item interface{}, ok bool) {
+				if buffer == nil {
+					buffer = make(chan interface{}, n)
+					h = startPump(q, func(item interface{}) {
+						select {
+						case buffer <- item:
+						case <-ctx.Done():
+							panic(prefetchCanceled{})
+						}
+					})
+
+					p := h.p
+					go func() {
+						<-p.done
+						close(buffer)
+					}()
+				}
+
+				if item, ok = <-buffer; ok {
+					return
+				}
+
+				if _, canceled := h.p.reason.(prefetchCanceled); !canceled {
+					h.p.rethrow()
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// prefetchCanceled is the value the goroutines of Prefetch and FanOut panic
+// with to stop iterating when the context of the query is done.
+type prefetchCanceled struct{}
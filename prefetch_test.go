@@ -0,0 +1,75 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPrefetch(t *testing.T) {
+	q := Range(1, 100).Prefetch(10)
+	if w := toSlice(Range(1, 100)); !validateQuery(q, w) || q.Count() != 100 {
+		t.Errorf("Range(1, 100).Prefetch(10)=%v expected %v", toSlice(q), w)
+	}
+
+	produced := make(chan int, 10)
+	slow := Range(1, 5).Select(func(i interface{}) interface{} {
+		produced <- i.(int)
+		return i
+	})
+
+	next := slow.Prefetch(3).Iterate()
+	if first, _ := next(); first != 1 {
+		t.Fatalf("Prefetch(3) first=%v expected 1", first)
+	}
+
+	// The goroutine runs ahead of the consumer until the buffer is full.
+	deadline := time.After(time.Second)
+	for len(produced) < 4 {
+		select {
+		case <-deadline:
+			t.Fatalf("Prefetch(3) produced %d elements ahead expected 4", len(produced))
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func TestPrefetchContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ended := make(chan struct{})
+
+	next := Range(1, 1000).Select(func(i interface{}) interface{} {
+		if i == 1000 {
+			close(ended)
+		}
+		return i
+	}).WithOptions(Options{Context: ctx}).Prefetch(1).Iterate()
+	next()
+	cancel()
+
+	for _, ok := next(); ok; _, ok = next() {
+	}
+
+	select {
+	case <-ended:
+		t.Errorf("Prefetch() iterated over the collection after the context was done")
+	default:
+	}
+}
+
+func TestPrefetch_PanicWhenSourcePanics(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		Range(1, 3).Select(func(i interface{}) interface{} {
+			if i == 3 {
+				panic(errors.New("read failed"))
+			}
+			return i
+		}).Prefetch(2).Results()
+	})
+
+	mustPanicWithError(t, "Prefetch: non-positive buffer size", func() {
+		Range(1, 3).Prefetch(0)
+	})
+}
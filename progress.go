@@ -0,0 +1,55 @@
+package linq
+
+import (
+	"errors"
+	"strconv"
+)
+
+// WithProgress returns the elements of a collection unchanged and calls fn
+// with the number of elements processed so far every time that number is a
+// multiple of every, and once more with the total when the iteration ends
+// unless that total has just been reported, so that command line tools and
+// batch jobs can report the progress of a long Count, ToSlice or ForEach.
+//
+// fn is called from the goroutine iterating over the query, after the element
+// is produced and before it is returned. Each iteration of the query counts
+// from zero. The returned query doesn't report its number of elements without
+// iterating, so methods such as Count always iterate over it and report their
+// progress. WithProgress panics if every is not positive.
+func (q Query) WithProgress(every int, fn func(processed int)) Query {
+	if every <= 0 {
+		panic(errors.New("WithProgress: non-positive interval"))
+	}
+
+	return Query{
+		desc: q.chain("WithProgress(" + strconv.Itoa(every) + ")"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			processed := 0
+			done := false
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				item, ok = next()
+				if !ok {
+					done = true
+					if processed == 0 || processed%every != 0 {
+						fn(processed)
+					}
+
+					return
+				}
+
+				processed++
+				if processed%every == 0 {
+					fn(processed)
+				}
+
+				return
+			}
+		},
+	}
+}
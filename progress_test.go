@@ -0,0 +1,33 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithProgress(t *testing.T) {
+	tests := []struct {
+		count int
+		every int
+		calls []int
+	}{
+		{10, 3, []int{3, 6, 9, 10}},
+		{6, 3, []int{3, 6}},
+		{0, 5, []int{0}},
+	}
+
+	for _, test := range tests {
+		var calls []int
+		q := From(make([]int, test.count)).WithProgress(test.every, func(n int) {
+			calls = append(calls, n)
+		})
+
+		if n := q.Count(); n != test.count || !reflect.DeepEqual(calls, test.calls) {
+			t.Errorf("WithProgress(%d).Count()=%d with calls %v expected %d with calls %v", test.every, n, calls, test.count, test.calls)
+		}
+	}
+
+	mustPanicWithError(t, "WithProgress: non-positive interval", func() {
+		Range(1, 3).WithProgress(0, func(int) {})
+	})
+}
@@ -0,0 +1,141 @@
+package linq
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Traced is the type of the elements of queries returned by WithIndexes and
+// WithSourceTag. It wraps an element with the position and, optionally, the
+// identifier of the source it was read from, so that an error caused by the
+// element can report which input record it came from.
+type Traced struct {
+	Source interface{}
+	Index  int
+	Value  interface{}
+}
+
+// Error returns err wrapped in a TracedError with the provenance of the
+// element.
+func (t Traced) Error(err error) error {
+	return &TracedError{Source: t.Source, Index: t.Index, Err: err}
+}
+
+// TracedError is an error caused by an element with a known provenance, as
+// returned by Traced.Error and raised by SelectTraced and WhereTraced.
+type TracedError struct {
+	Source interface{}
+	Index  int
+	Err    error
+}
+
+// Error returns the message of the underlying error prefixed with the
+// provenance of the element.
+func (e *TracedError) Error() string {
+	if e.Source == nil {
+		return fmt.Sprintf("linq: element %d: %v", e.Index, e.Err)
+	}
+
+	return fmt.Sprintf("linq: %v, element %d: %v", e.Source, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *TracedError) Unwrap() error {
+	return e.Err
+}
+
+// WithIndexes returns a query that wraps each element of a collection in a
+// Traced with the zero-based index of the element. Use SelectTraced and
+// WhereTraced to process the values while keeping their provenance, and
+// Untrace to unwrap them.
+func (q Query) WithIndexes() Query {
+	return q.trace(nil).describe(q.chain("WithIndexes"))
+}
+
+// WithSourceTag is like WithIndexes, but also sets the Source of each Traced
+// to tag, such as the name of the file the collection is read from, to tell
+// the elements of several sources apart once they are merged.
+func (q Query) WithSourceTag(tag interface{}) Query {
+	return q.trace(tag).describe(q.chain(fmt.Sprintf("WithSourceTag(%v)", tag)))
+}
+
+// trace wraps each element of q in a Traced with the specified source.
+func (q Query) trace(source interface{}) Query {
+	var index func(int) interface{}
+	if q.index != nil {
+		index = func(i int) interface{} {
+			return Traced{Source: source, Index: i, Value: q.index(i)}
+		}
+	}
+
+	return Query{
+		length: q.length,
+		index:  index,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			i := 0
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok {
+					item = Traced{Source: source, Index: i, Value: item}
+					i++
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// Untrace returns a query with the values of the Traced elements of a
+// collection. Elements that are not of type Traced are returned unchanged.
+func (q Query) Untrace() Query {
+	return q.Select(func(item interface{}) interface{} {
+		if t, ok := item.(Traced); ok {
+			return t.Value
+		}
+
+		return item
+	}).describe(q.chain("Untrace"))
+}
+
+// SelectTraced projects the value of each Traced element of a collection,
+// keeping its provenance. If selector panics with an error, the iterator
+// panics with a TracedError wrapping it, naming the element it failed for.
+// Runtime panics and panics with values that are not errors are not wrapped.
+func (q Query) SelectTraced(selector func(interface{}) interface{}) Query {
+	return q.Select(func(item interface{}) interface{} {
+		t := item.(Traced)
+		traced(t, func() { t.Value = selector(t.Value) })
+		return t
+	}).describe(q.chain("SelectTraced"))
+}
+
+// WhereTraced filters a collection of Traced elements based on a predicate of
+// their values. If predicate panics with an error, the iterator panics with a
+// TracedError wrapping it, like SelectTraced.
+func (q Query) WhereTraced(predicate func(interface{}) bool) Query {
+	return q.Where(func(item interface{}) (match bool) {
+		t := item.(Traced)
+		traced(t, func() { match = predicate(t.Value) })
+		return
+	}).describe(q.chain("WhereTraced"))
+}
+
+// traced calls f, wrapping a panic of f with an error in a TracedError with
+// the provenance of t.
+func traced(t Traced, f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, isErr := r.(error)
+			if _, isRuntime := r.(runtime.Error); !isErr || isRuntime {
+				panic(r)
+			}
+
+			panic(t.Error(err))
+		}
+	}()
+
+	f()
+}
@@ -0,0 +1,63 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithIndexes(t *testing.T) {
+	q := From([]string{"a", "b"}).WithIndexes()
+	want := []interface{}{Traced{Index: 0, Value: "a"}, Traced{Index: 1, Value: "b"}}
+	if !validateQuery(q, want) || q.index == nil {
+		t.Errorf("WithIndexes()=%v expected %v", toSlice(q), want)
+	}
+
+	if w := []interface{}{"a", "b"}; !validateQuery(q.Untrace(), w) {
+		t.Errorf("WithIndexes().Untrace()=%v expected %v", toSlice(q.Untrace()), w)
+	}
+}
+
+func TestWithSourceTag(t *testing.T) {
+	q := From([]int{1, 2, 3}).WithSourceTag("a.csv").
+		WhereTraced(func(i interface{}) bool { return i.(int) > 1 }).
+		SelectTraced(func(i interface{}) interface{} { return i.(int) * 10 })
+
+	want := []interface{}{Traced{"a.csv", 1, 20}, Traced{"a.csv", 2, 30}}
+	if !validateQuery(q, want) {
+		t.Errorf("WithSourceTag()=%v expected %v", toSlice(q), want)
+	}
+
+	if s := From([]int{1}).WithSourceTag("a.csv").String(); s != "From(slice[1]).WithSourceTag(a.csv)" {
+		t.Errorf("WithSourceTag().String()=%v", s)
+	}
+}
+
+func TestSelectTracedWithError(t *testing.T) {
+	failed := errors.New("parse failed")
+	q := From([]string{"1", "x"}).WithSourceTag("input.txt").
+		SelectTraced(func(s interface{}) interface{} {
+			if s.(string) == "x" {
+				panic(failed)
+			}
+
+			return s
+		})
+
+	mustPanicWithError(t, "linq: input.txt, element 1: parse failed", func() {
+		toSlice(q)
+	})
+
+	var got error
+	toSlice(q.Catch(func(err error) Query {
+		got = err
+		return Empty()
+	}))
+
+	if e, ok := got.(*TracedError); !ok || e.Index != 1 || e.Unwrap() != failed {
+		t.Errorf("SelectTraced() error=%#v expected a TracedError for element 1", got)
+	}
+
+	mustPanicWithError(t, "linq: element 0: parse failed", func() {
+		From([]int{1}).WithIndexes().WhereTraced(func(interface{}) bool { panic(failed) }).First()
+	})
+}
@@ -0,0 +1,145 @@
+package linq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// RangeBound specifies whether the end of the range generated by RangeStep is
+// included in it.
+type RangeBound int
+
+const (
+	// RangeExclusive generates the values up to, but not including, the end.
+	RangeExclusive RangeBound = iota
+	// RangeInclusive generates the values up to and including the end.
+	RangeInclusive
+)
+
+// rangeEpsilon is the tolerance used by RangeStep to decide whether the end of
+// a range of floats is reached by a whole number of steps, so that rounding
+// errors don't add or drop the last value of a range such as 0 to 1 by 0.1.
+const rangeEpsilon = 1e-9
+
+// RangeStep generates a sequence of numbers from start to end, in increments
+// of step, which is negative for descending sequences. Whether end is
+// included is specified by bound. If step doesn't lead from start towards end,
+// the sequence is empty.
+//
+// If start, end and step are all integers of any type, the elements are of
+// type int. Otherwise they have to be integers or floats and the elements are
+// of type float64, computed as start + i*step so that rounding errors don't
+// accumulate. The result supports random access, like a query created from a
+// slice. RangeStep panics if step is zero or an argument is not a number.
+func RangeStep(start, end, step interface{}, bound RangeBound) Query {
+	desc := fmt.Sprintf("RangeStep(%v, %v, %v)", start, end, step)
+
+	if isIntValue(start) && isIntValue(end) && isIntValue(step) {
+		s, e, st := int(toInt64(start)), int(toInt64(end)), int(toInt64(step))
+		if st == 0 {
+			panic(errors.New("RangeStep: zero step"))
+		}
+
+		return fromIndex(intRangeCount(s, e, st, bound), func(i int) interface{} {
+			return s + i*st
+		}).describe(desc)
+	}
+
+	s, e, st := rangeFloat(start), rangeFloat(end), rangeFloat(step)
+	if st == 0 {
+		panic(errors.New("RangeStep: zero step"))
+	}
+
+	return fromIndex(floatRangeCount(s, e, st, bound), func(i int) interface{} {
+		return s + float64(i)*st
+	}).describe(desc)
+}
+
+// DateRange generates a sequence of times from from, inclusive, to to,
+// exclusive, in increments of step, such as the start times of the hourly
+// buckets of a day. The result supports random access, like a query created
+// from a slice. DateRange panics if step is not positive.
+func DateRange(from, to time.Time, step time.Duration) Query {
+	if step <= 0 {
+		panic(errors.New("DateRange: non-positive step"))
+	}
+
+	count := 0
+	if d := to.Sub(from); d > 0 {
+		count = int((d + step - 1) / step)
+	}
+
+	return fromIndex(count, func(i int) interface{} {
+		return from.Add(time.Duration(i) * step)
+	}).describe("DateRange(" + step.String() + ")")
+}
+
+// intRangeCount returns the number of elements of a range of ints.
+func intRangeCount(start, end, step int, bound RangeBound) int {
+	if step < 0 {
+		start, end, step = -start, -end, -step
+	}
+
+	if end < start || (end == start && bound == RangeExclusive) {
+		return 0
+	}
+
+	if bound == RangeInclusive {
+		return (end-start)/step + 1
+	}
+
+	return (end - start + step - 1) / step
+}
+
+// floatRangeCount returns the number of elements of a range of floats.
+func floatRangeCount(start, end, step float64, bound RangeBound) int {
+	n := (end - start) / step
+	if math.IsNaN(n) || math.IsInf(n, 0) || n < -rangeEpsilon {
+		return 0
+	}
+
+	whole := math.Abs(n-math.Round(n)) < rangeEpsilon
+	switch {
+	case whole && bound == RangeInclusive:
+		return int(math.Round(n)) + 1
+	case whole:
+		return int(math.Round(n))
+	default:
+		return int(math.Floor(n)) + 1
+	}
+}
+
+// isIntValue reports whether v is of an integer type.
+func isIntValue(v interface{}) bool {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return true
+	}
+
+	return false
+}
+
+// toInt64 converts v, which is of an integer type, to int64.
+func toInt64(v interface{}) int64 {
+	switch v.(type) {
+	case uint, uint8, uint16, uint32, uint64:
+		return int64(getUIntConverter(v)(v))
+	}
+
+	return getIntConverter(v)(v)
+}
+
+// rangeFloat converts v, which is of an integer or float type, to float64 for
+// RangeStep.
+func rangeFloat(v interface{}) float64 {
+	switch v.(type) {
+	case float32, float64:
+		return getFloatConverter(v)(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return float64(toInt64(v))
+	}
+
+	panic(fmt.Errorf("RangeStep: argument [%v] of type '%T' is not a number", v, v))
+}
@@ -0,0 +1,75 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRangeStep(t *testing.T) {
+	tests := []struct {
+		start, end, step interface{}
+		bound            RangeBound
+		output           []interface{}
+	}{
+		{0, 10, 3, RangeExclusive, []interface{}{0, 3, 6, 9}},
+		{0, 9, 3, RangeExclusive, []interface{}{0, 3, 6}},
+		{0, 9, 3, RangeInclusive, []interface{}{0, 3, 6, 9}},
+		{5, 1, -2, RangeInclusive, []interface{}{5, 3, 1}},
+		{int8(1), uint(3), int64(1), RangeExclusive, []interface{}{1, 2}},
+		{1, 1, 1, RangeExclusive, []interface{}{}},
+		{1, 1, 1, RangeInclusive, []interface{}{1}},
+		{1, 5, -1, RangeInclusive, []interface{}{}},
+		{0., 1., 0.25, RangeInclusive, []interface{}{0., 0.25, 0.5, 0.75, 1.}},
+		{0., 1., 0.25, RangeExclusive, []interface{}{0., 0.25, 0.5, 0.75}},
+		{0, 1, 0.4, RangeInclusive, []interface{}{0., 0.4, 0.8}},
+		{2., 1., 0.5, RangeExclusive, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := RangeStep(test.start, test.end, test.step, test.bound)
+		if !validateQuery(q, test.output) || q.Count() != len(test.output) {
+			t.Errorf("RangeStep(%v, %v, %v, %v)=%v expected %v", test.start, test.end, test.step, test.bound, toSlice(q), test.output)
+		}
+	}
+
+	if n := RangeStep(0., 1., 0.1, RangeInclusive).Count(); n != 11 {
+		t.Errorf("RangeStep(0, 1, 0.1, RangeInclusive).Count()=%v expected 11", n)
+	}
+
+	if s := RangeStep(1, 5, 2, RangeExclusive).String(); s != "RangeStep(1, 5, 2)" {
+		t.Errorf("RangeStep().String()=%v expected RangeStep(1, 5, 2)", s)
+	}
+
+	mustPanicWithError(t, "RangeStep: zero step", func() {
+		RangeStep(1, 5, 0, RangeExclusive)
+	})
+
+	mustPanicWithError(t, "RangeStep: argument [a] of type 'string' is not a number", func() {
+		RangeStep("a", 5, 1, RangeExclusive)
+	})
+}
+
+func TestDateRange(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		to     time.Time
+		step   time.Duration
+		output []interface{}
+	}{
+		{from.Add(3 * time.Hour), time.Hour, []interface{}{from, from.Add(time.Hour), from.Add(2 * time.Hour)}},
+		{from.Add(90 * time.Minute), time.Hour, []interface{}{from, from.Add(time.Hour)}},
+		{from, time.Hour, []interface{}{}},
+		{from.Add(-time.Hour), time.Hour, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := DateRange(from, test.to, test.step); !validateQuery(q, test.output) {
+			t.Errorf("DateRange(%v, %v, %v)=%v expected %v", from, test.to, test.step, toSlice(q), test.output)
+		}
+	}
+
+	mustPanicWithError(t, "DateRange: non-positive step", func() {
+		DateRange(from, from, 0)
+	})
+}
@@ -0,0 +1,103 @@
+package linq
+
+// ReplaceWhere replaces every element of a collection that satisfies a
+// specified condition with replacement, and keeps the other elements in
+// place.
+func (q Query) ReplaceWhere(predicate func(interface{}) bool, replacement interface{}) Query {
+	return q.mapWhere("ReplaceWhere", predicate, func(interface{}) interface{} {
+		return replacement
+	})
+}
+
+// ReplaceWhereT is the typed version of ReplaceWhere.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: ReplaceWhere has better performance than ReplaceWhereT.
+func (q Query) ReplaceWhereT(predicateFn interface{}, replacement interface{}) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"ReplaceWhereT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.ReplaceWhere(predicateFunc, replacement)
+}
+
+// MapWhere projects every element of a collection that satisfies a specified
+// condition into a new form with transform, and keeps the other elements in
+// place unchanged.
+func (q Query) MapWhere(predicate func(interface{}) bool, transform func(interface{}) interface{}) Query {
+	return q.mapWhere("MapWhere", predicate, transform)
+}
+
+// MapWhereT is the typed version of MapWhere.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//   - transformFn is of type "func(TSource)TSource"
+//
+// NOTE: MapWhere has better performance than MapWhereT.
+func (q Query) MapWhereT(predicateFn interface{}, transformFn interface{}) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"MapWhereT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	transformGenericFunc, err := newGenericFunc(
+		"MapWhereT", "transformFn", transformFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	transformFunc := func(item interface{}) interface{} {
+		return transformGenericFunc.Call(item)
+	}
+
+	return q.MapWhere(predicateFunc, transformFunc)
+}
+
+// mapWhere returns the query built by the operator op that applies transform
+// to the elements of q that satisfy predicate. The result has the same length
+// as q, but doesn't support random access, so that predicate and transform are
+// called once for each element, in order, like in Select.
+func (q Query) mapWhere(op string, predicate func(interface{}) bool, transform func(interface{}) interface{}) Query {
+	apply := func(item interface{}) interface{} {
+		if predicate(item) {
+			return transform(item)
+		}
+
+		return item
+	}
+
+	return Query{
+		desc:   q.chain(op),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if ok {
+					item = apply(item)
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package linq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReplaceWhere(t *testing.T) {
+	input := []string{"a", "", "b", ""}
+	want := []interface{}{"a", "n/a", "b", "n/a"}
+
+	if q := From(input).ReplaceWhere(func(i interface{}) bool {
+		return i.(string) == ""
+	}, "n/a"); !validateQuery(q, want) || q.Count() != 4 {
+		t.Errorf("From(%v).ReplaceWhere()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := Range(-2, 5).Where(func(interface{}) bool { return true }).ReplaceWhereT(func(i int) bool {
+		return i < 0
+	}, 0); !validateQuery(q, []interface{}{0, 0, 0, 1, 2}) {
+		t.Errorf("Range(-2, 5).ReplaceWhereT()=%v expected [0 0 0 1 2]", toSlice(q))
+	}
+}
+
+func TestMapWhere(t *testing.T) {
+	input := []string{"a", "B", "c"}
+	want := []interface{}{"A", "B", "C"}
+
+	if q := From(input).MapWhere(func(i interface{}) bool {
+		return i.(string) != strings.ToUpper(i.(string))
+	}, func(i interface{}) interface{} {
+		return strings.ToUpper(i.(string))
+	}); !validateQuery(q, want) || q.ElementAt(2) != "C" {
+		t.Errorf("From(%v).MapWhere()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From(input).MapWhereT(func(s string) bool { return s == "B" }, func(s string) string {
+		return s + s
+	}); !validateQuery(q, []interface{}{"a", "BB", "c"}) {
+		t.Errorf("From(%v).MapWhereT()=%v expected [a BB c]", input, toSlice(q))
+	}
+}
+
+func TestMapWhereT_PanicWhenTransformFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MapWhereT: parameter [transformFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1}).MapWhereT(func(i int) bool { return true }, func(i, j int) int { return i })
+	})
+}
+
+func TestMapWhereCallsPredicateInOrder(t *testing.T) {
+	var calls []interface{}
+	q := From([]int{1, 2, 3}).MapWhere(func(i interface{}) bool {
+		calls = append(calls, i)
+		return i.(int) > 1
+	}, func(i interface{}) interface{} {
+		return i.(int) * 10
+	}).Reverse()
+
+	if w := []interface{}{30, 20, 1}; !validateQuery(q, w) || !validateQuery(From(calls), []interface{}{1, 2, 3}) {
+		t.Errorf("MapWhere().Reverse()=%v with calls %v expected %v with calls [1 2 3]", toSlice(q), calls, w)
+	}
+}
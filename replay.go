@@ -0,0 +1,96 @@
+package linq
+
+import "sync"
+
+// Replay returns a query that shares one iteration over a collection between
+// all its iterators, and records the last bufferSize elements produced. An
+// iterator started after the collection has been partially iterated first
+// replays the recorded elements, then continues with the elements that follow
+// them. This is useful for channel-backed sources, which can be iterated only
+// once, when new consumers need recent history.
+//
+// Every element is produced by the collection once, and is seen by all the
+// iterators that are active at the time, as long as they don't fall more than
+// bufferSize elements behind the most advanced iterator; elements that are no
+// longer recorded are skipped. The returned query can be iterated from
+// different goroutines. A bufferSize that is not positive records nothing.
+func (q Query) Replay(bufferSize int) Query {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+
+	r := &replay{source: q, size: bufferSize}
+
+	return Query{
+		desc: q.chain("Replay"),
+		Iterate: func() Iterator {
+			pos := r.start()
+
+			return func() (item interface{}, ok bool) {
+				item, ok, pos = r.next(pos)
+				return
+			}
+		},
+	}
+}
+
+// replay holds the state shared by the iterators of a Replay query. The last
+// recorded elements are kept in a ring buffer indexed by their position modulo
+// size.
+type replay struct {
+	mu       sync.Mutex
+	source   Query
+	iter     Iterator
+	done     bool
+	size     int
+	ring     []interface{}
+	produced int
+}
+
+// start returns the position of the first element a new iterator returns.
+func (r *replay) start() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.produced - len(r.ring)
+}
+
+// next returns the element at position pos, or the oldest recorded element if
+// it is no longer recorded, together with the position of the element that
+// follows it.
+func (r *replay) next(pos int) (interface{}, bool, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if base := r.produced - len(r.ring); pos < base {
+		pos = base
+	}
+
+	if pos < r.produced {
+		return r.ring[pos%r.size], true, pos + 1
+	}
+
+	if r.done {
+		return nil, false, pos
+	}
+
+	if r.iter == nil {
+		r.iter = r.source.Iterate()
+	}
+
+	item, ok := r.iter()
+	if !ok {
+		r.done = true
+		return nil, false, pos
+	}
+
+	switch {
+	case len(r.ring) < r.size:
+		r.ring = append(r.ring, item)
+	case r.size > 0:
+		r.ring[r.produced%r.size] = item
+	}
+
+	r.produced++
+	return item, true, r.produced
+}
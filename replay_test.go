@@ -0,0 +1,43 @@
+package linq
+
+import "testing"
+
+func TestReplay(t *testing.T) {
+	ch := make(chan interface{}, 6)
+	for i := 1; i <= 6; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	q := FromChannel(ch).Replay(2)
+	first := q.Iterate()
+	for i := 0; i < 4; i++ {
+		first()
+	}
+
+	if w := []interface{}{3, 4, 5, 6}; !validateQuery(q, w) {
+		t.Errorf("Replay(2) late iterator=%v expected %v", toSlice(q), w)
+	}
+
+	if w := []interface{}{5, 6}; !validateQuery(q, w) {
+		t.Errorf("Replay(2) after completion=%v expected %v", toSlice(q), w)
+	}
+
+	if item, ok := first(); item != 5 || !ok {
+		t.Errorf("Replay(2) lagging iterator resumed at %v expected 5", item)
+	}
+}
+
+func TestReplayWithoutBuffer(t *testing.T) {
+	q := Range(1, 4).Replay(0)
+	first := q.Iterate()
+	first()
+
+	if w := []interface{}{2, 3, 4}; !validateQuery(q, w) {
+		t.Errorf("Replay(0) late iterator=%v expected %v", toSlice(q), w)
+	}
+
+	if _, ok := first(); ok {
+		t.Errorf("Replay(0) iterator didn't end after the source was exhausted")
+	}
+}
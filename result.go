@@ -1,8 +1,12 @@
 package linq
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"reflect"
+	"strings"
+	"time"
 )
 
 // All determines whether all elements of a collection satisfy a condition.
@@ -80,6 +84,22 @@ func (q Query) AnyWithT(predicateFn interface{}) bool {
 	return q.AnyWith(predicateFunc)
 }
 
+// AppendTo iterates over a collection and appends its elements to the slice
+// pointed by v. Unlike ToSlice, which overwrites the slice starting from index
+// 0, AppendTo keeps the existing elements, so it can be used to accumulate the
+// results of several queries into one slice.
+func (q Query) AppendTo(v interface{}) {
+	res := reflect.ValueOf(v)
+	slice := reflect.Indirect(res)
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		slice = reflect.Append(slice, reflect.ValueOf(item))
+	}
+
+	res.Elem().Set(slice)
+}
+
 // Average computes the average of a collection of numeric values.
 func (q Query) Average() (r float64) {
 	next := q.Iterate()
@@ -90,7 +110,7 @@ func (q Query) Average() (r float64) {
 
 	n := 1
 	switch item.(type) {
-	case int, int8, int16, int32, int64:
+	case int, int8, int16, int32, int64, time.Duration:
 		conv := getIntConverter(item)
 		sum := conv(item)
 
@@ -123,8 +143,59 @@ func (q Query) Average() (r float64) {
 	return r / float64(n)
 }
 
+// AverageStable computes the average of a collection of float values.
+//
+// Values can be of any float type: float32 or float64. Unlike Average, the sum
+// of the values is computed with Neumaier's variant of Kahan compensated
+// summation, which keeps the result accurate on long sequences of floats with
+// mixed magnitudes. Method returns NaN if collection contains no elements.
+func (q Query) AverageStable() float64 {
+	sum, n := q.sumFloatsStable()
+	if n == 0 {
+		return math.NaN()
+	}
+
+	return sum / float64(n)
+}
+
+// AverageStreaming computes the average of a collection of numeric values
+// using an incremental update of the mean instead of dividing a running sum.
+//
+// Values can be of any integer, unsigned integer or float type. Unlike Average,
+// which sums integers into an int64 or uint64 first, the running mean never
+// grows beyond the magnitude of the values, so averages of long sequences of
+// large integers don't overflow. Method returns NaN if collection contains no
+// elements.
+func (q Query) AverageStreaming() float64 {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return math.NaN()
+	}
+
+	conv := getNumericConverter(item)
+	mean, n := conv(item), 1
+
+	for item, ok = next(); ok; item, ok = next() {
+		n++
+		mean += (conv(item) - mean) / float64(n)
+	}
+
+	return mean
+}
+
 // Contains determines whether a collection contains a specified element.
+//
+// If value is a float and the query has a FloatTolerance set with
+// WithFloatTolerance, elements that differ from value by at most the
+// tolerance are considered equal to it.
 func (q Query) Contains(value interface{}) bool {
+	if eps := q.floatTolerance(); eps > 0 && isFloat(value) {
+		return q.AnyWith(func(item interface{}) bool {
+			return floatsWithin(item, value, eps)
+		})
+	}
+
 	next := q.Iterate()
 
 	for item, ok := next(); ok; item, ok = next() {
@@ -136,17 +207,125 @@ func (q Query) Contains(value interface{}) bool {
 	return false
 }
 
+// ContainsAll determines whether a collection contains all of the specified
+// elements. The elements are put into a set once and the collection is
+// iterated only until all of them have been found. ContainsAll returns true if
+// no elements are specified.
+func (q Query) ContainsAll(values ...interface{}) bool {
+	set := make(map[interface{}]bool, len(values))
+	for _, value := range values {
+		set[value] = false
+	}
+
+	missing := len(set)
+	if missing == 0 {
+		return true
+	}
+
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if found, has := set[item]; has && !found {
+			set[item] = true
+			missing--
+			if missing == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ContainsAny determines whether a collection contains any of the specified
+// elements. The elements are put into a set once and the collection is
+// iterated only until one of them has been found. ContainsAny returns false
+// if no elements are specified.
+func (q Query) ContainsAny(values ...interface{}) bool {
+	if len(values) == 0 {
+		return false
+	}
+
+	set := make(map[interface{}]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if _, has := set[item]; has {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsDeep determines whether a collection contains a specified element,
+// comparing elements with reflect.DeepEqual instead of ==. Unlike Contains, it
+// can be used with elements of non-comparable types, such as slices, maps and
+// structs with such fields.
+func (q Query) ContainsDeep(value interface{}) bool {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if reflect.DeepEqual(item, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Count returns the number of elements in a collection.
+//
+// If the length of the collection is known, such as for queries created from
+// slices, arrays, maps and strings and preserved through Select and Reverse,
+// Count returns it without iterating over the collection.
 func (q Query) Count() (r int) {
-	next := q.Iterate()
+	if q.length != nil {
+		return q.length()
+	}
 
-	for _, ok := next(); ok; _, ok = next() {
+	e := q.enumerator()
+
+	for _, ok := e.Next(); ok; _, ok = e.Next() {
 		r++
 	}
 
 	return
 }
 
+// CountAtLeast determines whether a collection contains at least n elements.
+// It stops iterating over the collection as soon as n elements are found, so
+// it can be used on expensive or infinite collections.
+func (q Query) CountAtLeast(n int) bool {
+	if n <= 0 {
+		return true
+	}
+
+	if q.length != nil {
+		return q.length() >= n
+	}
+
+	next := q.Iterate()
+	for _, ok := next(); ok; _, ok = next() {
+		if n--; n == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CountAtMost determines whether a collection contains at most n elements. It
+// stops iterating over the collection as soon as more than n elements are
+// found, so it can be used on expensive or infinite collections.
+func (q Query) CountAtMost(n int) bool {
+	return n >= 0 && !q.CountAtLeast(n+1)
+}
+
 // CountWith returns a number that represents how many elements in the specified
 // collection satisfy a condition.
 func (q Query) CountWith(predicate func(interface{}) bool) (r int) {
@@ -183,6 +362,99 @@ func (q Query) CountWithT(predicateFn interface{}) int {
 	return q.CountWith(predicateFunc)
 }
 
+// Count64 returns the number of elements in a collection as an int64, which
+// doesn't overflow on 32-bit platforms for collections of more than
+// math.MaxInt32 elements and can be added directly to metrics counters.
+//
+// Like Count, it returns the known length of the collection without iterating
+// over it.
+func (q Query) Count64() (r int64) {
+	if q.length != nil {
+		return int64(q.length())
+	}
+
+	next := q.Iterate()
+
+	for _, ok := next(); ok; _, ok = next() {
+		r++
+	}
+
+	return
+}
+
+// CountWith64 returns a number that represents how many elements in the
+// specified collection satisfy a condition as an int64.
+func (q Query) CountWith64(predicate func(interface{}) bool) (r int64) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			r++
+		}
+	}
+
+	return
+}
+
+// CountCtx returns the number of elements in a collection as an int64, and
+// stops iterating over the collection when ctx is done. In that case, it
+// returns the number of elements counted so far and ctx.Err().
+func (q Query) CountCtx(ctx context.Context) (r int64, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	if q.length != nil {
+		return int64(q.length()), nil
+	}
+
+	next := q.Iterate()
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		if _, ok := next(); !ok {
+			return
+		}
+
+		r++
+	}
+}
+
+// ElementAt returns the element at a specified zero-based index in a
+// collection, and nil if index is out of range.
+//
+// If the collection supports random access, such as a query created from a
+// slice, array or string, the element is returned without iterating over the
+// preceding elements.
+func (q Query) ElementAt(index int) interface{} {
+	if index < 0 {
+		return nil
+	}
+
+	if q.index != nil {
+		if index < q.length() {
+			return q.index(index)
+		}
+
+		return nil
+	}
+
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index == 0 {
+			return item
+		}
+
+		index--
+	}
+
+	return nil
+}
+
 // First returns the first element of a collection.
 func (q Query) First() interface{} {
 	item, _ := q.Iterate()()
@@ -225,11 +497,19 @@ func (q Query) FirstWithT(predicateFn interface{}) interface{} {
 	return q.FirstWith(predicateFunc)
 }
 
+// Frequencies returns a map with the number of occurrences of each distinct
+// element of a collection. The collection is iterated only once.
+func (q Query) Frequencies() map[interface{}]int {
+	return q.Histogram(func(item interface{}) interface{} {
+		return item
+	})
+}
+
 // ForEach performs the specified action on each element of a collection.
 func (q Query) ForEach(action func(interface{})) {
-	next := q.Iterate()
+	e := q.enumerator()
 
-	for item, ok := next(); ok; item, ok = next() {
+	for item, ok := e.Next(); ok; item, ok = e.Next() {
 		action(item)
 	}
 }
@@ -296,8 +576,110 @@ func (q Query) ForEachIndexedT(actionFn interface{}) {
 	q.ForEachIndexed(actionFunc)
 }
 
+// Histogram returns a map with the number of elements of a collection that fall
+// into each bucket. Function bucketer is executed for each element to determine
+// the bucket it belongs to. The collection is iterated only once.
+func (q Query) Histogram(bucketer func(interface{}) interface{}) map[interface{}]int {
+	next := q.Iterate()
+	r := make(map[interface{}]int)
+
+	for item, ok := next(); ok; item, ok = next() {
+		r[bucketer(item)]++
+	}
+
+	return r
+}
+
+// HistogramT is the typed version of Histogram.
+//
+//   - bucketerFn is of type "func(TSource) TBucket"
+//
+// NOTE: Histogram has better performance than HistogramT.
+func (q Query) HistogramT(bucketerFn interface{}) map[interface{}]int {
+	bucketerGenericFunc, err := newGenericFunc(
+		"HistogramT", "bucketerFn", bucketerFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	bucketerFunc := func(item interface{}) interface{} {
+		return bucketerGenericFunc.Call(item)
+	}
+
+	return q.Histogram(bucketerFunc)
+}
+
+// JoinStrings concatenates the elements of a collection, placing sep between
+// them. Elements of type string are used as is, other elements are formatted
+// with fmt.Sprint.
+func (q Query) JoinStrings(sep string) string {
+	return q.JoinStringsBy(sep, func(item interface{}) string {
+		if s, ok := item.(string); ok {
+			return s
+		}
+
+		return fmt.Sprint(item)
+	})
+}
+
+// JoinStringsBy concatenates the elements of a collection, placing sep between
+// them. Function stringify is executed for each element to get its text.
+func (q Query) JoinStringsBy(sep string, stringify func(interface{}) string) string {
+	var b strings.Builder
+	next := q.Iterate()
+
+	item, ok := next()
+	if !ok {
+		return ""
+	}
+
+	b.WriteString(stringify(item))
+
+	for item, ok = next(); ok; item, ok = next() {
+		b.WriteString(sep)
+		b.WriteString(stringify(item))
+	}
+
+	return b.String()
+}
+
+// JoinStringsByT is the typed version of JoinStringsBy.
+//
+//   - stringifyFn is of type "func(TSource) string"
+//
+// NOTE: JoinStringsBy has better performance than JoinStringsByT.
+func (q Query) JoinStringsByT(sep string, stringifyFn interface{}) string {
+	stringifyGenericFunc, err := newGenericFunc(
+		"JoinStringsByT", "stringifyFn", stringifyFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(string))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	stringifyFunc := func(item interface{}) string {
+		return stringifyGenericFunc.Call(item).(string)
+	}
+
+	return q.JoinStringsBy(sep, stringifyFunc)
+}
+
 // Last returns the last element of a collection.
+//
+// If the collection supports random access, such as a query created from a
+// slice, array or string, the last element is returned without iterating over
+// the collection.
 func (q Query) Last() (r interface{}) {
+	if q.index != nil {
+		if n := q.length(); n > 0 {
+			return q.index(n - 1)
+		}
+
+		return nil
+	}
+
 	next := q.Iterate()
 
 	for item, ok := next(); ok; item, ok = next() {
@@ -351,7 +733,7 @@ func (q Query) Max() (r interface{}) {
 		return nil
 	}
 
-	compare := getComparer(item)
+	compare := q.comparer(item)
 	r = item
 
 	for item, ok := next(); ok; item, ok = next() {
@@ -371,7 +753,7 @@ func (q Query) Min() (r interface{}) {
 		return nil
 	}
 
-	compare := getComparer(item)
+	compare := q.comparer(item)
 	r = item
 
 	for item, ok := next(); ok; item, ok = next() {
@@ -383,77 +765,200 @@ func (q Query) Min() (r interface{}) {
 	return
 }
 
-// Results iterates over a collection and returnes slice of interfaces
-func (q Query) Results() (r []interface{}) {
+// MinMax returns both the minimum and the maximum value in a collection of
+// values. Unlike calling Min and Max separately, MinMax iterates over the
+// collection only once. Method returns nil, nil if collection contains no
+// elements.
+func (q Query) MinMax() (min, max interface{}) {
 	next := q.Iterate()
-
-	for item, ok := next(); ok; item, ok = next() {
-		r = append(r, item)
+	item, ok := next()
+	if !ok {
+		return nil, nil
 	}
 
-	return
-}
-
-// SequenceEqual determines whether two collections are equal.
-func (q Query) SequenceEqual(q2 Query) bool {
-	next := q.Iterate()
-	next2 := q2.Iterate()
+	compare := q.comparer(item)
+	min, max = item, item
 
 	for item, ok := next(); ok; item, ok = next() {
-		item2, ok2 := next2()
-		if !ok2 || item != item2 {
-			return false
+		if compare(item, min) < 0 {
+			min = item
+		}
+
+		if compare(item, max) > 0 {
+			max = item
 		}
 	}
 
-	_, ok2 := next2()
-	return !ok2
+	return
 }
 
-// Single returns the only element of a collection, and nil if there is not
-// exactly one element in the collection.
-func (q Query) Single() interface{} {
+// MinMaxBy returns the elements of a collection having the minimum and the
+// maximum key in one iteration. Function selector is executed for each element
+// to get the key to compare. If several elements share the same extreme key,
+// the first one is returned.
+func (q Query) MinMaxBy(selector func(interface{}) interface{}) (min, max interface{}) {
 	next := q.Iterate()
 	item, ok := next()
 	if !ok {
-		return nil
-	}
-
-	_, ok = next()
-	if ok {
-		return nil
+		return nil, nil
 	}
 
-	return item
-}
-
-// SingleWith returns the only element of a collection that satisfies a
-// specified condition, and nil if more than one such element exists.
-func (q Query) SingleWith(predicate func(interface{}) bool) (r interface{}) {
-	next := q.Iterate()
-	found := false
+	key := selector(item)
+	compare := getComparer(key)
+	min, max = item, item
+	minKey, maxKey := key, key
 
 	for item, ok := next(); ok; item, ok = next() {
-		if predicate(item) {
-			if found {
-				return nil
-			}
+		key := selector(item)
 
-			found = true
-			r = item
+		if compare(key, minKey) < 0 {
+			min, minKey = item, key
+		}
+
+		if compare(key, maxKey) > 0 {
+			max, maxKey = item, key
 		}
 	}
 
 	return
 }
 
-// SingleWithT is the typed version of SingleWith.
+// MinMaxByT is the typed version of MinMaxBy.
 //
-//   - predicateFn is of type "func(TSource) bool"
+//   - selectorFn is of type "func(TSource) TKey"
 //
-// NOTE: SingleWith has better performance than SingleWithT.
-func (q Query) SingleWithT(predicateFn interface{}) interface{} {
-	predicateGenericFunc, err := newGenericFunc(
+// NOTE: MinMaxBy has better performance than MinMaxByT.
+func (q Query) MinMaxByT(selectorFn interface{}) (min, max interface{}) {
+	selectorGenericFunc, err := newGenericFunc(
+		"MinMaxByT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectorGenericFunc.Call(item)
+	}
+
+	return q.MinMaxBy(selectorFunc)
+}
+
+// Results iterates over a collection and returnes slice of interfaces
+func (q Query) Results() (r []interface{}) {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		r = append(r, item)
+	}
+
+	return
+}
+
+// SequenceEqual determines whether two collections are equal.
+//
+// Elements of comparable types are compared with ==. Elements of types that
+// are not comparable, such as []byte, are compared with reflect.DeepEqual
+// instead of causing a panic. If the query has a FloatTolerance set with
+// WithFloatTolerance, float elements that differ by at most the tolerance are
+// considered equal.
+func (q Query) SequenceEqual(q2 Query) bool {
+	if eps := q.floatTolerance(); eps > 0 {
+		return q.sequenceEqual(q2, func(a, b interface{}) bool {
+			return floatsWithin(a, b, eps) || equalItems(a, b)
+		})
+	}
+
+	return q.sequenceEqual(q2, equalItems)
+}
+
+// SequenceEqualDeep determines whether two collections are equal, comparing
+// their elements with reflect.DeepEqual. Unlike SequenceEqual, it also
+// compares the contents of pointers and of interface fields of structs.
+func (q Query) SequenceEqualDeep(q2 Query) bool {
+	return q.sequenceEqual(q2, reflect.DeepEqual)
+}
+
+func (q Query) sequenceEqual(q2 Query, equal func(interface{}, interface{}) bool) bool {
+	if q.length != nil && q2.length != nil && q.length() != q2.length() {
+		return false
+	}
+
+	next := q.Iterate()
+	next2 := q2.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		item2, ok2 := next2()
+		if !ok2 || !equal(item, item2) {
+			return false
+		}
+	}
+
+	_, ok2 := next2()
+	return !ok2
+}
+
+// Single returns the only element of a collection, and nil if there is not
+// exactly one element in the collection.
+func (q Query) Single() interface{} {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return nil
+	}
+
+	_, ok = next()
+	if ok {
+		return nil
+	}
+
+	return item
+}
+
+// SingleStrict returns the only element of a collection. Unlike Single, it
+// reports why there is no such element: it returns ErrEmpty if the collection
+// contains no elements, and ErrMoreThanOne if it contains more than one.
+func (q Query) SingleStrict() (interface{}, error) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return nil, ErrEmpty
+	}
+
+	if _, ok = next(); ok {
+		return nil, ErrMoreThanOne
+	}
+
+	return item, nil
+}
+
+// SingleWith returns the only element of a collection that satisfies a
+// specified condition, and nil if more than one such element exists.
+func (q Query) SingleWith(predicate func(interface{}) bool) (r interface{}) {
+	next := q.Iterate()
+	found := false
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			if found {
+				return nil
+			}
+
+			found = true
+			r = item
+		}
+	}
+
+	return
+}
+
+// SingleWithT is the typed version of SingleWith.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: SingleWith has better performance than SingleWithT.
+func (q Query) SingleWithT(predicateFn interface{}) interface{} {
+	predicateGenericFunc, err := newGenericFunc(
 		"SingleWithT", "predicateFn", predicateFn,
 		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
 	)
@@ -468,10 +973,65 @@ func (q Query) SingleWithT(predicateFn interface{}) interface{} {
 	return q.SingleWith(predicateFunc)
 }
 
+// SingleWithStrict returns the only element of a collection that satisfies a
+// specified condition. Unlike SingleWith, it reports why there is no such
+// element: it returns ErrEmpty if no element satisfies the condition, and
+// ErrMoreThanOne if more than one element does.
+func (q Query) SingleWithStrict(predicate func(interface{}) bool) (interface{}, error) {
+	next := q.Iterate()
+	var r interface{}
+	found := false
+
+	for item, ok := next(); ok; item, ok = next() {
+		if predicate(item) {
+			if found {
+				return nil, ErrMoreThanOne
+			}
+
+			found = true
+			r = item
+		}
+	}
+
+	if !found {
+		return nil, ErrEmpty
+	}
+
+	return r, nil
+}
+
+// SingleWithStrictT is the typed version of SingleWithStrict.
+//
+//   - predicateFn is of type "func(TSource) bool"
+//
+// NOTE: SingleWithStrict has better performance than SingleWithStrictT.
+func (q Query) SingleWithStrictT(predicateFn interface{}) (interface{}, error) {
+	predicateGenericFunc, err := newGenericFunc(
+		"SingleWithStrictT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.SingleWithStrict(predicateFunc)
+}
+
+// SumDurations computes the sum of a collection of time.Duration values.
+// Method returns zero if collection contains no elements.
+func (q Query) SumDurations() time.Duration {
+	return time.Duration(q.SumInts())
+}
+
 // SumInts computes the sum of a collection of numeric values.
 //
-// Values can be of any integer type: int, int8, int16, int32, int64. The result
-// is int64. Method returns zero if collection contains no elements.
+// Values can be of any integer type: int, int8, int16, int32, int64, or of type
+// time.Duration. The result is int64. Method returns zero if collection
+// contains no elements.
 func (q Query) SumInts() (r int64) {
 	next := q.Iterate()
 	item, ok := next()
@@ -489,6 +1049,35 @@ func (q Query) SumInts() (r int64) {
 	return
 }
 
+// SumIntsChecked computes the sum of a collection of numeric values and
+// reports whether the computation overflowed.
+//
+// Values can be of any integer type: int, int8, int16, int32, int64. The result
+// is int64. Unlike SumInts, which silently wraps around, this method stops
+// iterating and returns ErrOverflow as soon as the running sum no longer fits
+// into int64. Method returns zero if collection contains no elements.
+func (q Query) SumIntsChecked() (r int64, err error) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return 0, nil
+	}
+
+	conv := getIntConverter(item)
+
+	for ; ok; item, ok = next() {
+		v := conv(item)
+		s := r + v
+		if (v > 0 && s < r) || (v < 0 && s > r) {
+			return 0, ErrOverflow
+		}
+
+		r = s
+	}
+
+	return
+}
+
 // SumUInts computes the sum of a collection of numeric values.
 //
 // Values can be of any unsigned integer type: uint, uint8, uint16, uint32,
@@ -511,6 +1100,35 @@ func (q Query) SumUInts() (r uint64) {
 	return
 }
 
+// SumUIntsChecked computes the sum of a collection of numeric values and
+// reports whether the computation overflowed.
+//
+// Values can be of any unsigned integer type: uint, uint8, uint16, uint32,
+// uint64. The result is uint64. Unlike SumUInts, which silently wraps around,
+// this method stops iterating and returns ErrOverflow as soon as the running
+// sum no longer fits into uint64. Method returns zero if collection contains no
+// elements.
+func (q Query) SumUIntsChecked() (r uint64, err error) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return 0, nil
+	}
+
+	conv := getUIntConverter(item)
+
+	for ; ok; item, ok = next() {
+		s := r + conv(item)
+		if s < r {
+			return 0, ErrOverflow
+		}
+
+		r = s
+	}
+
+	return
+}
+
 // SumFloats computes the sum of a collection of numeric values.
 //
 // Values can be of any float type: float32 or float64. The result is float64.
@@ -532,6 +1150,77 @@ func (q Query) SumFloats() (r float64) {
 	return
 }
 
+// SumFloatsStable computes the sum of a collection of numeric values.
+//
+// Values can be of any float type: float32 or float64. The result is float64.
+// Unlike SumFloats, the sum is computed with Neumaier's variant of Kahan
+// compensated summation, which keeps the result accurate on long sequences of
+// floats with mixed magnitudes. Method returns zero if collection contains no
+// elements.
+func (q Query) SumFloatsStable() float64 {
+	sum, _ := q.sumFloatsStable()
+	return sum
+}
+
+// sumFloatsStable computes the compensated sum of a collection of float values
+// and returns it together with the number of elements.
+func (q Query) sumFloatsStable() (sum float64, n int) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return 0, 0
+	}
+
+	conv := getFloatConverter(item)
+	var s neumaierSum
+
+	for ; ok; item, ok = next() {
+		s.add(conv(item))
+		n++
+	}
+
+	return s.value(), n
+}
+
+// neumaierSum is a float sum computed with Neumaier's variant of Kahan
+// compensated summation.
+type neumaierSum struct {
+	sum, c float64
+}
+
+// add adds v to the sum. Once the sum is infinite or NaN, the compensation is
+// no longer updated, since it would become NaN.
+func (s *neumaierSum) add(v float64) {
+	t := s.sum + v
+	switch {
+	case math.IsInf(t, 0) || math.IsNaN(t):
+	case math.Abs(s.sum) >= math.Abs(v):
+		s.c += (s.sum - t) + v
+	default:
+		s.c += (v - t) + s.sum
+	}
+
+	s.sum = t
+}
+
+// value returns the compensated sum.
+func (s *neumaierSum) value() float64 {
+	return s.sum + s.c
+}
+
+// ToBytes iterates over a collection of bytes and returns them as a slice.
+// Elements of the collection have to be of type byte.
+func (q Query) ToBytes() []byte {
+	result := make([]byte, 0, q.capacity())
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		result = append(result, item.(byte))
+	}
+
+	return result
+}
+
 // ToChannel iterates over a collection and outputs each element to a channel,
 // then closes it.
 func (q Query) ToChannel(result chan<- interface{}) {
@@ -565,7 +1254,7 @@ func (q Query) ToChannelT(result interface{}) {
 // populate a map with elements of different type use ToMapBy method. ToMap
 // doesn't empty the result map before populating it.
 func (q Query) ToMap(result interface{}) {
-	q.ToMapBy(
+	q.toMapBy("ToMap",
 		result,
 		func(i interface{}) interface{} {
 			return i.(KeyValue).Key
@@ -579,17 +1268,33 @@ func (q Query) ToMap(result interface{}) {
 // elements. Functions keySelector and valueSelector are executed for each
 // element of the collection to generate key and value for the map. Generated
 // key and value types must be assignable to the map's key and value types.
-// ToMapBy doesn't empty the result map before populating it.
+// ToMapBy doesn't empty the result map before populating it. If the result map
+// is nil, a new map sized for the elements of the collection is allocated.
+//
+// ToMapBy panics with an error naming the expected and actual types if result
+// is not a non-nil pointer to a map, or if a generated key or value is not
+// assignable to the map's key or value type.
 func (q Query) ToMapBy(result interface{},
 	keySelector func(interface{}) interface{},
 	valueSelector func(interface{}) interface{}) {
-	res := reflect.ValueOf(result)
-	m := reflect.Indirect(res)
+	q.toMapBy("ToMapBy", result, keySelector, valueSelector)
+}
+
+func (q Query) toMapBy(method string, result interface{},
+	keySelector func(interface{}) interface{},
+	valueSelector func(interface{}) interface{}) {
+	res := validateResult(method, result, reflect.Map)
+	m := res.Elem()
+	if m.IsNil() {
+		m = reflect.MakeMapWithSize(m.Type(), q.capacity())
+	}
+
+	keyType, valueType := m.Type().Key(), m.Type().Elem()
 	next := q.Iterate()
 
 	for item, ok := next(); ok; item, ok = next() {
-		key := reflect.ValueOf(keySelector(item))
-		value := reflect.ValueOf(valueSelector(item))
+		key := assignableValue(method, "key", keySelector(item), keyType)
+		value := assignableValue(method, "value", valueSelector(item), valueType)
 
 		m.SetMapIndex(key, value)
 	}
@@ -629,7 +1334,58 @@ func (q Query) ToMapByT(result interface{},
 		return valueSelectorGenericFunc.Call(item)
 	}
 
-	q.ToMapBy(result, keySelectorFunc, valueSelectorFunc)
+	q.toMapBy("ToMapByT", result, keySelectorFunc, valueSelectorFunc)
+}
+
+// ToRunes iterates over a collection of runes and returns them as a slice.
+// Elements of the collection have to be of type rune.
+func (q Query) ToRunes() []rune {
+	result := make([]rune, 0, q.capacity())
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		result = append(result, item.(rune))
+	}
+
+	return result
+}
+
+// ToSet iterates over a collection and returns a set with its distinct
+// elements.
+func (q Query) ToSet() map[interface{}]struct{} {
+	next := q.Iterate()
+	r := make(map[interface{}]struct{})
+
+	for item, ok := next(); ok; item, ok = next() {
+		r[item] = struct{}{}
+	}
+
+	return r
+}
+
+// ToSetT is the typed version of ToSet. It iterates over a collection and
+// populates the result set with elements. ToSetT doesn't empty the result set
+// before populating it; if the map pointed by result is nil, a new map is
+// allocated.
+//
+//   - result is of type "*map[TSource]struct{}"
+//
+// NOTE: ToSet has better performance than ToSetT.
+func (q Query) ToSetT(result interface{}) {
+	res := reflect.ValueOf(result)
+	m := reflect.Indirect(res)
+	if m.IsNil() {
+		m = reflect.MakeMap(m.Type())
+	}
+
+	member := reflect.Zero(m.Type().Elem())
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		m.SetMapIndex(reflect.ValueOf(item), member)
+	}
+
+	res.Elem().Set(m)
 }
 
 // ToSlice iterates over a collection and saves the results in the slice pointed
@@ -638,12 +1394,39 @@ func (q Query) ToMapByT(result interface{},
 // If the slice pointed by v has sufficient capacity, v will be pointed to a
 // resliced slice. If it does not, a new underlying array will be allocated and
 // v will point to it.
+//
+// Pointers to []interface{}, []string, []int, []int64 and []float64 are filled
+// without reflection, which is considerably faster for large collections.
+//
+// ToSlice panics with an error naming the expected and actual types if v is not
+// a non-nil pointer to a slice, or if an element is not assignable to the
+// slice's element type.
 func (q Query) ToSlice(v interface{}) {
-	res := reflect.ValueOf(v)
-	slice := reflect.Indirect(res)
+	value := validateResult("ToSlice", v, reflect.Slice)
+
+	switch res := v.(type) {
+	case *[]interface{}:
+		*res = q.toInterfaceSlice(*res)
+		return
+	case *[]string:
+		*res = q.toStringSlice(*res)
+		return
+	case *[]int:
+		*res = q.toIntSlice(*res)
+		return
+	case *[]int64:
+		*res = q.toInt64Slice(*res)
+		return
+	case *[]float64:
+		*res = q.toFloat64Slice(*res)
+		return
+	}
+
+	slice := value.Elem()
+	elemType := slice.Type().Elem()
 
 	cap := slice.Cap()
-	res.Elem().Set(slice.Slice(0, cap)) // make len(slice)==cap(slice) from now on
+	value.Elem().Set(slice.Slice(0, cap)) // make len(slice)==cap(slice) from now on
 
 	next := q.Iterate()
 	index := 0
@@ -651,24 +1434,254 @@ func (q Query) ToSlice(v interface{}) {
 		if index >= cap {
 			slice, cap = grow(slice)
 		}
-		slice.Index(index).Set(reflect.ValueOf(item))
+		slice.Index(index).Set(assignableValue("ToSlice", "element", item, elemType))
 		index++
 	}
 
 	// reslice the len(res)==cap(res) actual res size
-	res.Elem().Set(slice.Slice(0, index))
+	value.Elem().Set(slice.Slice(0, index))
+}
+
+// WeightedAverage computes the weighted average of a collection in a single
+// iteration. Functions valueSelector and weightSelector are executed for each
+// element to get its value and its weight. Method returns NaN if collection
+// contains no elements or the sum of the weights is zero.
+func (q Query) WeightedAverage(valueSelector func(interface{}) float64,
+	weightSelector func(interface{}) float64) float64 {
+	next := q.Iterate()
+	var sum, weights float64
+
+	for item, ok := next(); ok; item, ok = next() {
+		w := weightSelector(item)
+		sum += valueSelector(item) * w
+		weights += w
+	}
+
+	if weights == 0 {
+		return math.NaN()
+	}
+
+	return sum / weights
+}
+
+// WeightedAverageT is the typed version of WeightedAverage.
+//
+//   - valueSelectorFn is of type "func(TSource) float64"
+//   - weightSelectorFn is of type "func(TSource) float64"
+//
+// NOTE: WeightedAverage has better performance than WeightedAverageT.
+func (q Query) WeightedAverageT(valueSelectorFn interface{},
+	weightSelectorFn interface{}) float64 {
+	valueSelectorGenericFunc, err := newGenericFunc(
+		"WeightedAverageT", "valueSelectorFn", valueSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(float64))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	valueSelectorFunc := func(item interface{}) float64 {
+		return valueSelectorGenericFunc.Call(item).(float64)
+	}
+
+	weightSelectorGenericFunc, err := newGenericFunc(
+		"WeightedAverageT", "weightSelectorFn", weightSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(float64))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	weightSelectorFunc := func(item interface{}) float64 {
+		return weightSelectorGenericFunc.Call(item).(float64)
+	}
+
+	return q.WeightedAverage(valueSelectorFunc, weightSelectorFunc)
+}
+
+// toInterfaceSlice is the reflection-free version of ToSlice for []interface{}.
+func (q Query) toInterfaceSlice(s []interface{}) []interface{} {
+	s = s[:cap(s)]
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index >= len(s) {
+			n := make([]interface{}, growCap(len(s)))
+			copy(n, s)
+			s = n
+		}
+		s[index] = item
+		index++
+	}
+
+	return s[:index]
+}
+
+// toStringSlice is the reflection-free version of ToSlice for []string.
+func (q Query) toStringSlice(s []string) []string {
+	s = s[:cap(s)]
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index >= len(s) {
+			n := make([]string, growCap(len(s)))
+			copy(n, s)
+			s = n
+		}
+		v, typed := item.(string)
+		if !typed {
+			assignableValue("ToSlice", "element", item, reflect.TypeOf(v))
+		}
+
+		s[index] = v
+		index++
+	}
+
+	return s[:index]
+}
+
+// toIntSlice is the reflection-free version of ToSlice for []int.
+func (q Query) toIntSlice(s []int) []int {
+	s = s[:cap(s)]
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index >= len(s) {
+			n := make([]int, growCap(len(s)))
+			copy(n, s)
+			s = n
+		}
+		v, typed := item.(int)
+		if !typed {
+			assignableValue("ToSlice", "element", item, reflect.TypeOf(v))
+		}
+
+		s[index] = v
+		index++
+	}
+
+	return s[:index]
+}
+
+// toInt64Slice is the reflection-free version of ToSlice for []int64.
+func (q Query) toInt64Slice(s []int64) []int64 {
+	s = s[:cap(s)]
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index >= len(s) {
+			n := make([]int64, growCap(len(s)))
+			copy(n, s)
+			s = n
+		}
+		v, typed := item.(int64)
+		if !typed {
+			assignableValue("ToSlice", "element", item, reflect.TypeOf(v))
+		}
+
+		s[index] = v
+		index++
+	}
+
+	return s[:index]
+}
+
+// toFloat64Slice is the reflection-free version of ToSlice for []float64.
+func (q Query) toFloat64Slice(s []float64) []float64 {
+	s = s[:cap(s)]
+	next := q.Iterate()
+	index := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		if index >= len(s) {
+			n := make([]float64, growCap(len(s)))
+			copy(n, s)
+			s = n
+		}
+		v, typed := item.(float64)
+		if !typed {
+			assignableValue("ToSlice", "element", item, reflect.TypeOf(v))
+		}
+
+		s[index] = v
+		index++
+	}
+
+	return s[:index]
+}
+
+// growCap returns the capacity a slice of capacity cap grows to, following
+// the same policy as grow.
+func growCap(cap int) int {
+	if cap == 0 {
+		return 1
+	}
+
+	return cap * 2
 }
 
 // grow grows the slice s by doubling its capacity, then it returns the new
 // slice (resliced to its full capacity) and the new capacity.
 func grow(s reflect.Value) (v reflect.Value, newCap int) {
-	cap := s.Cap()
-	if cap == 0 {
-		cap = 1
-	} else {
-		cap *= 2
-	}
+	cap := growCap(s.Cap())
 	newSlice := reflect.MakeSlice(s.Type(), cap, cap)
 	reflect.Copy(newSlice, s)
 	return newSlice, cap
 }
+
+// equalItems compares two elements with ==, or with reflect.DeepEqual if they
+// are of the same type that is not comparable.
+func equalItems(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	t := reflect.TypeOf(a)
+	if t != reflect.TypeOf(b) {
+		return false
+	}
+
+	if !t.Comparable() {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return a == b
+}
+
+// validateResult returns the value of result, which has to be a non-nil
+// pointer to a value of the specified kind, and panics with an error naming
+// the calling method otherwise.
+func validateResult(method string, result interface{}, kind reflect.Kind) reflect.Value {
+	res := reflect.ValueOf(result)
+	if res.Kind() != reflect.Ptr || res.IsNil() || res.Elem().Kind() != kind {
+		panic(fmt.Errorf("%s: parameter [result] has an invalid type. Expected: 'non-nil pointer to %s', actual: '%T'",
+			method, kind, result))
+	}
+
+	return res
+}
+
+// assignableValue returns the value of item, which has to be assignable to
+// type t, and panics with an error naming the calling method otherwise. A nil
+// item is converted to the zero value of t if t can be nil.
+func assignableValue(method, what string, item interface{}, t reflect.Type) reflect.Value {
+	if item == nil {
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t)
+		}
+
+		panic(fmt.Errorf("%s: %s <nil> is not assignable to type '%s'", method, what, t))
+	}
+
+	v := reflect.ValueOf(item)
+	if !v.Type().AssignableTo(t) {
+		panic(fmt.Errorf("%s: %s of type '%s' is not assignable to type '%s'", method, what, v.Type(), t))
+	}
+
+	return v
+}
@@ -1,9 +1,11 @@
 package linq
 
 import (
+	"context"
 	"math"
 	"reflect"
 	"testing"
+	"time"
 	"unsafe"
 )
 
@@ -75,6 +77,25 @@ func TestAnyWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestAppendTo(t *testing.T) {
+	result := []int{1, 2}
+	want := []int{1, 2, 3, 4, 5}
+
+	From([]int{3, 4}).AppendTo(&result)
+	Range(5, 1).AppendTo(&result)
+	From([]int{}).AppendTo(&result)
+
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("AppendTo()=%v expected %v", result, want)
+	}
+
+	var empty []string
+	From([]string{"a"}).AppendTo(&empty)
+	if !reflect.DeepEqual(empty, []string{"a"}) {
+		t.Errorf("AppendTo(nil)=%v expected %v", empty, []string{"a"})
+	}
+}
+
 func TestAverage(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -98,6 +119,50 @@ func TestAverageForNaN(t *testing.T) {
 	}
 }
 
+func TestAverageStreaming(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  float64
+	}{
+		{[]int{1, 2, 2, 3, 1}, 1.8},
+		{[]uint{2, 4}, 3},
+		{[]float32{1., 1.}, 1.},
+		{[]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}, math.MaxInt64},
+		{[]uint64{math.MaxUint64, math.MaxUint64}, math.MaxUint64},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).AverageStreaming(); r != test.want {
+			t.Errorf("From(%v).AverageStreaming()=%v expected %v", test.input, r, test.want)
+		}
+	}
+
+	if r := From([]int{}).AverageStreaming(); !math.IsNaN(r) {
+		t.Errorf("From([]int{}).AverageStreaming()=%v expected %v", r, math.NaN())
+	}
+}
+
+func TestAverageStable(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  float64
+	}{
+		{[]float64{1, 2, 3, 4}, 2.5},
+		{[]float32{1, 2}, 1.5},
+		{[]float64{1, 1e100, 1, -1e100}, 0.5},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).AverageStable(); r != test.want {
+			t.Errorf("From(%v).AverageStable()=%v expected %v", test.input, r, test.want)
+		}
+	}
+
+	if r := From([]float64{}).AverageStable(); !math.IsNaN(r) {
+		t.Errorf("From([]).AverageStable()=%v expected %v", r, math.NaN())
+	}
+}
+
 func TestContains(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -116,6 +181,75 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestContainsAll(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		values []interface{}
+		want   bool
+	}{
+		{[]int{1, 2, 3}, []interface{}{3, 1}, true},
+		{[]int{1, 2, 3}, []interface{}{1, 1, 2}, true},
+		{[]int{1, 2, 3}, []interface{}{1, 4}, false},
+		{[]int{1, 2, 3}, []interface{}{int64(1)}, false},
+		{[]int{}, nil, true},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).ContainsAll(test.values...); r != test.want {
+			t.Errorf("From(%v).ContainsAll(%v)=%v expected %v", test.input, test.values, r, test.want)
+		}
+	}
+
+	if r := Range(1, 10).Concat(Generate(0, func(i interface{}) interface{} { return i })).ContainsAll(2, 5); !r {
+		t.Errorf("ContainsAll() expected to stop once all values are found")
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		values []interface{}
+		want   bool
+	}{
+		{[]string{"a", "b"}, []interface{}{"x", "b"}, true},
+		{[]string{"a", "b"}, []interface{}{"x", "y"}, false},
+		{[]string{"a", "b"}, nil, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).ContainsAny(test.values...); r != test.want {
+			t.Errorf("From(%v).ContainsAny(%v)=%v expected %v", test.input, test.values, r, test.want)
+		}
+	}
+}
+
+func TestContainsDeep(t *testing.T) {
+	type tagged struct {
+		Name string
+		Tags []string
+	}
+
+	tests := []struct {
+		input  interface{}
+		value  interface{}
+		output bool
+	}{
+		{[][]int{{1, 2}, {3}}, []int{3}, true},
+		{[][]int{{1, 2}, {3}}, []int{2, 1}, false},
+		{[]map[string]int{{"a": 1}}, map[string]int{"a": 1}, true},
+		{[]tagged{{"a", []string{"x"}}}, tagged{"a", []string{"x"}}, true},
+		{[]tagged{{"a", []string{"x"}}}, tagged{"a", nil}, false},
+		{[]int{1, 2}, 2, true},
+		{[]int{1, 2}, int64(2), false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).ContainsDeep(test.value); r != test.output {
+			t.Errorf("From(%v).ContainsDeep(%v)=%v expected %v", test.input, test.value, r, test.output)
+		}
+	}
+}
+
 func TestCount(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -133,6 +267,76 @@ func TestCount(t *testing.T) {
 	}
 }
 
+func TestCountForKnownLength(t *testing.T) {
+	selector := func(i interface{}) interface{} {
+		panic("Count must not iterate over a collection with known length")
+	}
+
+	tests := []struct {
+		input Query
+		want  int
+	}{
+		{From([]int{1, 2, 3}).Select(selector), 3},
+		{From(map[int]int{1: 1, 2: 2}).Select(selector).Reverse(), 2},
+		{FromString("héllo").SelectIndexed(func(i int, x interface{}) interface{} {
+			return selector(x)
+		}), 5},
+		{Range(1, 10).Select(selector), 10},
+		{Repeat(1, -1), 0},
+		{From([]int{1, 2, 3}).Where(func(i interface{}) bool { return i.(int) > 1 }), 2},
+	}
+
+	for _, test := range tests {
+		if r := test.input.Count(); r != test.want {
+			t.Errorf("Count()=%v expected %v", r, test.want)
+		}
+	}
+}
+
+func TestCountAtLeast(t *testing.T) {
+	tests := []struct {
+		input  Query
+		n      int
+		output bool
+	}{
+		{From([]int{1, 2, 3}), 2, true},
+		{From([]int{1, 2, 3}), 3, true},
+		{From([]int{1, 2, 3}), 4, false},
+		{From([]int{}), 0, true},
+		{From([]int{}), -1, true},
+		{From([]int{1}).Cycle(), 1000, true},
+		{Range(1, 5).Where(func(interface{}) bool { return true }), 6, false},
+	}
+
+	for _, test := range tests {
+		if r := test.input.CountAtLeast(test.n); r != test.output {
+			t.Errorf("CountAtLeast(%v)=%v expected %v", test.n, r, test.output)
+		}
+	}
+}
+
+func TestCountAtMost(t *testing.T) {
+	tests := []struct {
+		input  Query
+		n      int
+		output bool
+	}{
+		{From([]int{1, 2, 3}), 2, false},
+		{From([]int{1, 2, 3}), 3, true},
+		{From([]int{1, 2, 3}), 4, true},
+		{From([]int{}), 0, true},
+		{From([]int{}), -1, false},
+		{From([]int{1}).Cycle(), 1000, false},
+		{Range(1, 5).Where(func(interface{}) bool { return true }), 5, true},
+	}
+
+	for _, test := range tests {
+		if r := test.input.CountAtMost(test.n); r != test.output {
+			t.Errorf("CountAtMost(%v)=%v expected %v", test.n, r, test.output)
+		}
+	}
+}
+
 func TestCountWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -157,6 +361,78 @@ func TestCountWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestCount64(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int64
+	}{
+		{[]int{1, 2, 2, 3, 1}, 5},
+		{[7]int{1, 2, 2, 3, 1, 2, 1}, 7},
+		{[]interface{}{}, 0},
+	}
+
+	for _, test := range tests {
+		if got := From(test.input).Count64(); got != test.want {
+			t.Errorf("From(%v).Count64()=%v expected %v", test.input, got, test.want)
+		}
+	}
+
+	if got := Range(1, 10).Where(func(i interface{}) bool { return i.(int) > 3 }).Count64(); got != 7 {
+		t.Errorf("Range(1, 10).Where().Count64()=%v expected 7", got)
+	}
+}
+
+func TestCountWith64(t *testing.T) {
+	got := From([]int{1, 2, 2, 3, 1}).CountWith64(func(i interface{}) bool { return i.(int) <= 2 })
+	if got != 4 {
+		t.Errorf("CountWith64()=%v expected 4", got)
+	}
+}
+
+func TestCountCtx(t *testing.T) {
+	if got, err := Range(1, 10).Where(func(interface{}) bool { return true }).CountCtx(context.Background()); got != 10 || err != nil {
+		t.Errorf("CountCtx()=%v, %v expected 10, nil", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q := Range(1, 10).Select(func(i interface{}) interface{} {
+		if i.(int) == 4 {
+			cancel()
+		}
+
+		return i
+	}).Where(func(interface{}) bool { return true })
+
+	if got, err := q.CountCtx(ctx); got != 4 || err != context.Canceled {
+		t.Errorf("CountCtx() with cancellation=%v, %v expected 4, %v", got, err, context.Canceled)
+	}
+
+	if got, err := From([]int{1, 2}).CountCtx(ctx); got != 0 || err != context.Canceled {
+		t.Errorf("CountCtx() with done context=%v, %v expected 0, %v", got, err, context.Canceled)
+	}
+}
+
+func TestElementAt(t *testing.T) {
+	tests := []struct {
+		input Query
+		index int
+		want  interface{}
+	}{
+		{From([]int{1, 2, 3}), 1, 2},
+		{From([]int{1, 2, 3}), 3, nil},
+		{From([]int{1, 2, 3}), -1, nil},
+		{FromString("abc"), 2, 'c'},
+		{From([]int{1, 2, 3}).Where(func(i interface{}) bool { return i.(int) > 1 }), 0, 2},
+		{From([]int{1, 2, 3}).Where(func(i interface{}) bool { return i.(int) > 1 }), 2, nil},
+	}
+
+	for _, test := range tests {
+		if r := test.input.ElementAt(test.index); r != test.want {
+			t.Errorf("ElementAt(%v)=%v expected %v", test.index, r, test.want)
+		}
+	}
+}
+
 func TestFirst(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -197,6 +473,19 @@ func TestFirstWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestFrequencies(t *testing.T) {
+	input := []string{"a", "b", "a", "c", "a", "b"}
+	want := map[interface{}]int{"a": 3, "b": 2, "c": 1}
+
+	if r := From(input).Frequencies(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).Frequencies()=%v expected %v", input, r, want)
+	}
+
+	if r := From([]int{}).Frequencies(); len(r) != 0 {
+		t.Errorf("From([]).Frequencies()=%v expected empty map", r)
+	}
+}
+
 func TestForEach(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -251,6 +540,72 @@ func TestForEachIndexedT_PanicWhenActionFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestHistogram(t *testing.T) {
+	input := []int{1, 5, 12, 17, 19, 25}
+	want := map[interface{}]int{0: 2, 10: 3, 20: 1}
+
+	if r := From(input).Histogram(func(i interface{}) interface{} {
+		return i.(int) / 10 * 10
+	}); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).Histogram()=%v expected %v", input, r, want)
+	}
+
+	if r := From(input).HistogramT(func(i int) bool {
+		return i%2 == 0
+	}); !reflect.DeepEqual(r, map[interface{}]int{true: 1, false: 5}) {
+		t.Errorf("From(%v).HistogramT()=%v expected %v", input, r, map[interface{}]int{true: 1, false: 5})
+	}
+}
+
+func TestHistogramT_PanicWhenBucketerFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "HistogramT: parameter [bucketerFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 2, 3}).HistogramT(func(item, j int) int { return item })
+	})
+}
+
+func TestJoinStrings(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		sep   string
+		want  string
+	}{
+		{[]string{"a", "b", "c"}, ", ", "a, b, c"},
+		{[]string{"", "b"}, "-", "-b"},
+		{[]int{1, 2, 3}, "", "123"},
+		{[]interface{}{"x", 1.5, true}, "|", "x|1.5|true"},
+		{[]string{}, ",", ""},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).JoinStrings(test.sep); r != test.want {
+			t.Errorf("From(%v).JoinStrings(%q)=%q expected %q", test.input, test.sep, r, test.want)
+		}
+	}
+}
+
+func TestJoinStringsBy(t *testing.T) {
+	input := []foo{{f3: "a"}, {f3: "b"}}
+	want := "a/b"
+
+	if r := From(input).JoinStringsBy("/", func(i interface{}) string {
+		return i.(foo).f3
+	}); r != want {
+		t.Errorf("From(%v).JoinStringsBy()=%q expected %q", input, r, want)
+	}
+
+	if r := From(input).JoinStringsByT("/", func(f foo) string {
+		return f.f3
+	}); r != want {
+		t.Errorf("From(%v).JoinStringsByT()=%q expected %q", input, r, want)
+	}
+}
+
+func TestJoinStringsByT_PanicWhenStringifyFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "JoinStringsByT: parameter [stringifyFn] has a invalid function signature. Expected: 'func(T)string', actual: 'func(int)int'", func() {
+		From([]int{1}).JoinStringsByT(",", func(i int) int { return i })
+	})
+}
+
 func TestLast(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -267,6 +622,27 @@ func TestLast(t *testing.T) {
 	}
 }
 
+func TestLastForRandomAccess(t *testing.T) {
+	tests := []struct {
+		input Query
+		want  interface{}
+	}{
+		{From([]int{1, 2, 3}), 3},
+		{From([]int{}), nil},
+		{From([]int{1, 2, 3, 4}).Skip(1).Take(2), 3},
+	}
+
+	for _, test := range tests {
+		if test.input.index == nil {
+			t.Errorf("query is expected to support random access")
+		}
+
+		if r := test.input.Last(); r != test.want {
+			t.Errorf("Last()=%v expected %v", r, test.want)
+		}
+	}
+}
+
 func TestLastWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -325,6 +701,49 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		input   interface{}
+		wantMin interface{}
+		wantMax interface{}
+	}{
+		{[]int{1, 2, 2, 3, 0}, 0, 3},
+		{[]int{1}, 1, 1},
+		{[]string{"b", "c", "a"}, "a", "c"},
+		{[]int{}, nil, nil},
+	}
+
+	for _, test := range tests {
+		if min, max := From(test.input).MinMax(); min != test.wantMin || max != test.wantMax {
+			t.Errorf("From(%v).MinMax()=%v,%v expected %v,%v", test.input, min, max, test.wantMin, test.wantMax)
+		}
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	input := []foo{{f1: 3, f3: "c"}, {f1: 1, f3: "a"}, {f1: 5, f3: "e"}, {f1: 1, f3: "b"}}
+	wantMin, wantMax := input[1], input[2]
+
+	min, max := From(input).MinMaxBy(func(i interface{}) interface{} {
+		return i.(foo).f1
+	})
+	if min != wantMin || max != wantMax {
+		t.Errorf("From(%v).MinMaxBy()=%v,%v expected %v,%v", input, min, max, wantMin, wantMax)
+	}
+
+	if min, max := From([]foo{}).MinMaxBy(func(i interface{}) interface{} {
+		return i.(foo).f1
+	}); min != nil || max != nil {
+		t.Errorf("From([]).MinMaxBy()=%v,%v expected nil,nil", min, max)
+	}
+}
+
+func TestMinMaxByT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MinMaxByT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 2, 3}).MinMaxByT(func(item, j int) int { return item })
+	})
+}
+
 func TestResults(t *testing.T) {
 	input := []int{1, 2, 3}
 	want := []interface{}{1, 2, 3}
@@ -343,6 +762,11 @@ func TestSequenceEqual(t *testing.T) {
 		{[]int{1, 2, 2, 3, 1}, []int{4, 6}, false},
 		{[]int{1, -1, 100}, []int{1, -1, 100}, true},
 		{[]int{}, []int{}, true},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+		{[][]byte{[]byte("a"), []byte("b")}, [][]byte{[]byte("a"), []byte("b")}, true},
+		{[][]byte{[]byte("a"), []byte("b")}, [][]byte{[]byte("a"), []byte("c")}, false},
+		{[]interface{}{1, []int{1}, nil}, []interface{}{1, []int{1}, nil}, true},
+		{[]interface{}{1, []int{1}}, []interface{}{1, []int64{1}}, false},
 	}
 
 	for _, test := range tests {
@@ -352,6 +776,30 @@ func TestSequenceEqual(t *testing.T) {
 	}
 }
 
+func TestSequenceEqualDeep(t *testing.T) {
+	one, another := 1, 1
+	tests := []struct {
+		input  interface{}
+		input2 interface{}
+		want   bool
+	}{
+		{[]*int{&one}, []*int{&another}, true},
+		{[]map[string]int{{"a": 1}}, []map[string]int{{"a": 1}}, true},
+		{[]map[string]int{{"a": 1}}, []map[string]int{{"a": 2}}, false},
+		{[]int{1}, []int{1, 2}, false},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SequenceEqualDeep(From(test.input2)); r != test.want {
+			t.Errorf("From(%v).SequenceEqualDeep(%v)=%v expected %v", test.input, test.input2, r, test.want)
+		}
+	}
+
+	if r := From([]*int{&one}).SequenceEqual(From([]*int{&another})); r {
+		t.Errorf("SequenceEqual() of distinct pointers=%v expected false", r)
+	}
+}
+
 func TestSingle(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -369,6 +817,24 @@ func TestSingle(t *testing.T) {
 	}
 }
 
+func TestSingleStrict(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  interface{}
+		err   error
+	}{
+		{[]int{1, 2, 2, 3, 1}, nil, ErrMoreThanOne},
+		{[]int{1}, 1, nil},
+		{[]int{}, nil, ErrEmpty},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).SingleStrict(); r != test.want || err != test.err {
+			t.Errorf("From(%v).SingleStrict()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+}
+
 func TestSingleWith(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -395,6 +861,70 @@ func TestSingleWithT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestSingleWithStrict(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  interface{}
+		err   error
+	}{
+		{[]int{1, 2, 2, 3, 1}, 3, nil},
+		{[]int{1, 1, 1}, nil, ErrEmpty},
+		{[]int{5, 1, 1, 10, 2, 2}, nil, ErrMoreThanOne},
+		{[]int{}, nil, ErrEmpty},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).SingleWithStrictT(func(i int) bool {
+			return i > 2
+		}); r != test.want || err != test.err {
+			t.Errorf("From(%v).SingleWithStrictT()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+}
+
+func TestSingleWithStrictT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SingleWithStrictT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SingleWithStrictT(func(item int) int { return item + 2 })
+	})
+}
+
+func TestSumDurations(t *testing.T) {
+	input := []time.Duration{time.Second, 2 * time.Minute, -time.Second}
+	if r := From(input).SumDurations(); r != 2*time.Minute {
+		t.Errorf("From(%v).SumDurations()=%v expected %v", input, r, 2*time.Minute)
+	}
+
+	if r := From([]time.Duration{}).SumDurations(); r != 0 {
+		t.Errorf("SumDurations()=%v expected 0", r)
+	}
+}
+
+func TestTimeMinMax(t *testing.T) {
+	times := []time.Time{time.Unix(300, 0), time.Unix(100, 0), time.Unix(200, 0)}
+	if r := From(times).Min(); r != times[1] {
+		t.Errorf("From(%v).Min()=%v expected %v", times, r, times[1])
+	}
+
+	if r := From(times).Max(); r != times[0] {
+		t.Errorf("From(%v).Max()=%v expected %v", times, r, times[0])
+	}
+
+	var sorted []time.Time
+	From(times).OrderBy(func(i interface{}) interface{} { return i }).ToSlice(&sorted)
+	if sorted[0] != times[1] || sorted[2] != times[0] {
+		t.Errorf("From(%v).OrderBy()=%v expected ascending order", times, sorted)
+	}
+
+	durations := []time.Duration{time.Minute, time.Second, time.Hour}
+	if r := From(durations).Max(); r != time.Hour {
+		t.Errorf("From(%v).Max()=%v expected %v", durations, r, time.Hour)
+	}
+
+	if r := From(durations).Average(); r != float64(time.Hour+time.Minute+time.Second)/3 {
+		t.Errorf("From(%v).Average()=%v expected %v", durations, r, float64(time.Hour+time.Minute+time.Second)/3)
+	}
+}
+
 func TestSumInts(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -412,6 +942,27 @@ func TestSumInts(t *testing.T) {
 	}
 }
 
+func TestSumIntsChecked(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  int64
+		err   error
+	}{
+		{[]int{1, 2, 2, 3, 1}, 9, nil},
+		{[]int8{4, -10}, -6, nil},
+		{[]int64{math.MaxInt64, -1, 1}, math.MaxInt64, nil},
+		{[]int64{math.MaxInt64, 1}, 0, ErrOverflow},
+		{[]int64{math.MinInt64, -1}, 0, ErrOverflow},
+		{[]int{}, 0, nil},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).SumIntsChecked(); r != test.want || err != test.err {
+			t.Errorf("From(%v).SumIntsChecked()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+}
+
 func TestSumUInts(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -429,6 +980,25 @@ func TestSumUInts(t *testing.T) {
 	}
 }
 
+func TestSumUIntsChecked(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  uint64
+		err   error
+	}{
+		{[]uint{1, 2, 2, 3, 1}, 9, nil},
+		{[]uint64{math.MaxUint64 - 1, 1}, math.MaxUint64, nil},
+		{[]uint64{math.MaxUint64, 1}, 0, ErrOverflow},
+		{[]uint{}, 0, nil},
+	}
+
+	for _, test := range tests {
+		if r, err := From(test.input).SumUIntsChecked(); r != test.want || err != test.err {
+			t.Errorf("From(%v).SumUIntsChecked()=%v,%v expected %v,%v", test.input, r, err, test.want, test.err)
+		}
+	}
+}
+
 func TestSumFloats(t *testing.T) {
 	tests := []struct {
 		input interface{}
@@ -446,6 +1016,36 @@ func TestSumFloats(t *testing.T) {
 	}
 }
 
+func TestSumFloatsStable(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  float64
+	}{
+		{[]float32{1, 2, 3}, 6},
+		{[]float64{1, 1e100, 1, -1e100}, 2},
+		{[]float64{0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1, 0.1}, 1},
+		{[]float64{1, math.Inf(1), 1}, math.Inf(1)},
+		{[]float64{}, 0},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).SumFloatsStable(); r != test.want {
+			t.Errorf("From(%v).SumFloatsStable()=%v expected %v", test.input, r, test.want)
+		}
+	}
+}
+
+func TestToBytes(t *testing.T) {
+	s := "hello"
+	if r := FromBytes([]byte(s)).Reverse().ToBytes(); string(r) != "olleh" {
+		t.Errorf("FromBytes(%v).Reverse().ToBytes()=%s expected olleh", s, r)
+	}
+
+	if r := Empty().ToBytes(); len(r) != 0 {
+		t.Errorf("Empty().ToBytes()=%v expected []", r)
+	}
+}
+
 func TestToChannel(t *testing.T) {
 	c := make(chan interface{})
 	input := []int{1, 2, 3, 4, 5}
@@ -536,6 +1136,61 @@ func TestToMapByT_PanicWhenValueSelectorFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestToMapBy_PanicWhenResultIsInvalid(t *testing.T) {
+	keySelector := func(i interface{}) interface{} { return i }
+
+	mustPanicWithError(t, "ToMapBy: parameter [result] has an invalid type. Expected: 'non-nil pointer to map', actual: 'map[int]int'", func() {
+		From([]int{1}).ToMapBy(map[int]int{}, keySelector, keySelector)
+	})
+
+	mustPanicWithError(t, "ToMap: parameter [result] has an invalid type. Expected: 'non-nil pointer to map', actual: '*[]int'", func() {
+		From([]KeyValue{{1, 1}}).ToMap(&[]int{})
+	})
+
+	mustPanicWithError(t, "ToMapBy: key of type 'string' is not assignable to type 'int'", func() {
+		result := make(map[int]int)
+		From([]string{"a"}).ToMapBy(&result, keySelector, func(interface{}) interface{} { return 1 })
+	})
+
+	mustPanicWithError(t, "ToMapByT: value of type 'int' is not assignable to type 'string'", func() {
+		result := make(map[int]string)
+		From([]int{1}).ToMapByT(&result, func(i int) int { return i }, func(i int) int { return i })
+	})
+}
+
+func TestToRunes(t *testing.T) {
+	s := "héllo, 世界"
+	if r := FromRunes(s).ToRunes(); string(r) != s {
+		t.Errorf("FromRunes(%v).ToRunes()=%v expected %v", s, string(r), s)
+	}
+
+	if r := FromRunes(s).Where(func(r interface{}) bool { return r.(rune) > 127 }).ToRunes(); string(r) != "é世界" {
+		t.Errorf("FromRunes(%v).Where().ToRunes()=%v expected é世界", s, string(r))
+	}
+}
+
+func TestToSet(t *testing.T) {
+	input := []int{1, 2, 2, 3, 1}
+	want := map[interface{}]struct{}{1: {}, 2: {}, 3: {}}
+
+	if r := From(input).ToSet(); !reflect.DeepEqual(r, want) {
+		t.Errorf("From(%v).ToSet()=%v expected %v", input, r, want)
+	}
+}
+
+func TestToSetT(t *testing.T) {
+	input := []string{"a", "b", "a"}
+	want := map[string]struct{}{"a": {}, "b": {}, "c": {}}
+
+	var result map[string]struct{}
+	From(input).ToSetT(&result)
+	From([]string{"c"}).ToSetT(&result)
+
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("From(%v).ToSetT()=%v expected %v", input, result, want)
+	}
+}
+
 func TestToSlice(t *testing.T) {
 	tests := []struct {
 		input             []int
@@ -620,3 +1275,123 @@ func TestToSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestToSlice_PanicWhenResultIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToSlice: parameter [result] has an invalid type. Expected: 'non-nil pointer to slice', actual: '[]int'", func() {
+		From([]int{1}).ToSlice([]int{})
+	})
+
+	mustPanicWithError(t, "ToSlice: parameter [result] has an invalid type. Expected: 'non-nil pointer to slice', actual: '*[]int'", func() {
+		var result *[]int
+		From([]int{1}).ToSlice(result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element of type 'string' is not assignable to type 'uint'", func() {
+		var result []uint
+		From([]string{"a"}).ToSlice(&result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element <nil> is not assignable to type 'uint'", func() {
+		var result []uint
+		From([]interface{}{nil}).ToSlice(&result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element of type 'int' is not assignable to type 'string'", func() {
+		var result []string
+		From([]int{1}).ToSlice(&result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element of type 'string' is not assignable to type 'int'", func() {
+		var result []int
+		From([]string{"a"}).ToSlice(&result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element of type 'int' is not assignable to type 'int64'", func() {
+		var result []int64
+		From([]int{1}).ToSlice(&result)
+	})
+
+	mustPanicWithError(t, "ToSlice: element <nil> is not assignable to type 'float64'", func() {
+		var result []float64
+		From([]interface{}{nil}).ToSlice(&result)
+	})
+}
+
+func TestToSliceWithNilElements(t *testing.T) {
+	var result []*int
+	From([]interface{}{nil, nil}).ToSlice(&result)
+
+	if len(result) != 2 || result[0] != nil || result[1] != nil {
+		t.Errorf("ToSlice()=%v expected [<nil> <nil>]", result)
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	type score struct {
+		value, weight float64
+	}
+
+	tests := []struct {
+		input []score
+		want  float64
+	}{
+		{[]score{{90, 1}, {60, 2}}, 70},
+		{[]score{{10, 0.5}, {20, 0.5}}, 15},
+	}
+
+	for _, test := range tests {
+		if r := From(test.input).WeightedAverage(func(i interface{}) float64 {
+			return i.(score).value
+		}, func(i interface{}) float64 {
+			return i.(score).weight
+		}); r != test.want {
+			t.Errorf("From(%v).WeightedAverage()=%v expected %v", test.input, r, test.want)
+		}
+	}
+
+	if r := From([]score{}).WeightedAverageT(func(s score) float64 {
+		return s.value
+	}, func(s score) float64 {
+		return s.weight
+	}); !math.IsNaN(r) {
+		t.Errorf("From([]).WeightedAverageT()=%v expected %v", r, math.NaN())
+	}
+}
+
+func TestWeightedAverageT_PanicWhenWeightSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WeightedAverageT: parameter [weightSelectorFn] has a invalid function signature. Expected: 'func(T)float64', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 3}).WeightedAverageT(func(i int) float64 { return float64(i) }, func(i int) int { return i })
+	})
+}
+
+func TestToSliceForCommonTypes(t *testing.T) {
+	var interfaces []interface{}
+	From([]interface{}{1, "a", nil}).ToSlice(&interfaces)
+	if want := []interface{}{1, "a", nil}; !reflect.DeepEqual(interfaces, want) {
+		t.Errorf("ToSlice(*[]interface{})=%v expected %v", interfaces, want)
+	}
+
+	strs := []string{"x", "y", "z", "w"}
+	From([]string{"a", "b"}).ToSlice(&strs)
+	if want := []string{"a", "b"}; !reflect.DeepEqual(strs, want) || cap(strs) != 4 {
+		t.Errorf("ToSlice(*[]string)=%v (cap=%d) expected %v (cap=4)", strs, cap(strs), want)
+	}
+
+	var int64s []int64
+	From([]int64{1, 2, 3}).ToSlice(&int64s)
+	if want := []int64{1, 2, 3}; !reflect.DeepEqual(int64s, want) || cap(int64s) != 4 {
+		t.Errorf("ToSlice(*[]int64)=%v (cap=%d) expected %v (cap=4)", int64s, cap(int64s), want)
+	}
+
+	var floats []float64
+	From([]float64{}).ToSlice(&floats)
+	if len(floats) != 0 {
+		t.Errorf("ToSlice(*[]float64)=%v expected []", floats)
+	}
+
+	var foos []foo
+	From([]foo{{f1: 1}}).ToSlice(&foos)
+	if want := []foo{{f1: 1}}; !reflect.DeepEqual(foos, want) {
+		t.Errorf("ToSlice(*[]foo)=%v expected %v", foos, want)
+	}
+}
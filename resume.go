@@ -0,0 +1,133 @@
+package linq
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Resumable is an interface that can be implemented by a custom collection, in
+// addition to Iterable, to let queries created from it resume an iteration
+// from a position token, such as the offset in a file or the last key read
+// from a database table.
+type Resumable interface {
+	Iterable
+
+	// IterateFrom returns an iterator over the elements that follow the
+	// position identified by token, or over all the elements if token is
+	// empty, and a function returning the token of the position that follows
+	// the last element returned by the iterator.
+	IterateFrom(token string) (next Iterator, position func() string, err error)
+}
+
+// FromResumable initializes a linq query with passed resumable collection as
+// the source. The query is iterated like a query created by FromIterable, and
+// IterateFrom resumes its iteration through the IterateFrom method of source.
+func FromResumable(source Resumable) Query {
+	return Query{
+		desc:    "FromResumable",
+		Iterate: source.Iterate,
+		resume:  source.IterateFrom,
+	}
+}
+
+// Cursor iterates over a query and reports its position as a token, so that a
+// long running job can save it as a checkpoint and resume the iteration with
+// IterateFrom after a restart.
+type Cursor struct {
+	next     Iterator
+	position func() string
+}
+
+// Next returns the next element of the query, and false once there are no
+// more elements.
+func (c *Cursor) Next() (item interface{}, ok bool) {
+	return c.next()
+}
+
+// Token returns the token of the position that follows the last element
+// returned by Next. Passing it to IterateFrom resumes the iteration with the
+// element that follows.
+func (c *Cursor) Token() string {
+	return c.position()
+}
+
+// IterateFrom returns a cursor iterating over the elements of a query that
+// follow the position identified by token, as returned by the Token method of
+// a previous cursor, or over all the elements if token is empty.
+//
+// Queries created from a slice, array or string, by FromResumable and by the
+// operators keeping random access, such as Skip and Take, are resumable, and
+// so are queries built from them with Where and Select. Tokens are only valid
+// for the query they were obtained from. IterateFrom returns ErrNotResumable
+// if the query is not resumable, and an error if token is invalid.
+func (q Query) IterateFrom(token string) (*Cursor, error) {
+	resume := q.resumer()
+	if resume == nil {
+		return nil, ErrNotResumable
+	}
+
+	next, position, err := resume(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cursor{next: next, position: position}, nil
+}
+
+// resumer returns the function resuming the iteration over q from a position
+// token, or nil if q is not resumable. Queries supporting random access are
+// resumed from the index in the token.
+func (q Query) resumer() func(string) (Iterator, func() string, error) {
+	if q.resume != nil {
+		return q.resume
+	}
+
+	if q.index == nil {
+		return nil
+	}
+
+	return func(token string) (Iterator, func() string, error) {
+		i := 0
+		if token != "" {
+			n, err := strconv.Atoi(token)
+			if err != nil || n < 0 {
+				return nil, nil, fmt.Errorf("linq: invalid resume token %q", token)
+			}
+
+			i = n
+		}
+
+		length := q.length()
+		next := func() (item interface{}, ok bool) {
+			ok = i < length
+			if ok {
+				item = q.index(i)
+				i++
+			}
+
+			return
+		}
+
+		return next, func() string { return strconv.Itoa(i) }, nil
+	}
+}
+
+// resumeWith returns the function resuming the iteration over the query built
+// from q by wrapping the iterator of q with wrap, or nil if q is not
+// resumable. The position of the result is the position of q, so wrap must
+// transform each element independently of the preceding ones.
+func (q Query) resumeWith(wrap func(Iterator) Iterator) func(string) (Iterator, func() string, error) {
+	resume := q.resumer()
+	if resume == nil {
+		return nil
+	}
+
+	return func(token string) (Iterator, func() string, error) {
+		next, position, err := resume(token)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return wrap(next), position, nil
+	}
+}
@@ -0,0 +1,100 @@
+package linq
+
+import (
+	"strconv"
+	"testing"
+)
+
+// resumableLines is a Resumable collection resuming from the index of the
+// next line.
+type resumableLines []string
+
+func (r resumableLines) Iterate() Iterator {
+	next, _, _ := r.IterateFrom("")
+	return next
+}
+
+func (r resumableLines) IterateFrom(token string) (Iterator, func() string, error) {
+	i := 0
+	if token != "" {
+		i, _ = strconv.Atoi(token)
+	}
+
+	next := func() (item interface{}, ok bool) {
+		ok = i < len(r)
+		if ok {
+			item = r[i]
+			i++
+		}
+
+		return
+	}
+
+	return next, func() string { return "line " + strconv.Itoa(i) }, nil
+}
+
+func TestIterateFrom(t *testing.T) {
+	tests := []struct {
+		input Query
+		want  []interface{}
+	}{
+		{From([]int{1, 2, 3, 4, 5}), []interface{}{3, 4, 5}},
+		{From([]int{1, 2, 3, 4, 5, 6}).Where(func(i interface{}) bool { return i.(int)%2 == 0 }), []interface{}{6}},
+		{Range(1, 10).Skip(0), nil},
+		{From([]int{1, 2, 3, 4, 5}).Skip(1).Select(func(i interface{}) interface{} { return i.(int) * 10 }), []interface{}{40, 50}},
+	}
+
+	for _, test := range tests {
+		c, err := test.input.IterateFrom("")
+		if test.want == nil {
+			if err != ErrNotResumable {
+				t.Errorf("%v.IterateFrom()=%v expected %v", test.input, err, ErrNotResumable)
+			}
+
+			continue
+		}
+
+		// Read two elements, checkpoint, and resume from a new cursor.
+		c.Next()
+		c.Next()
+
+		c, err = test.input.IterateFrom(c.Token())
+		if err != nil {
+			t.Fatalf("%v.IterateFrom()=%v expected no error", test.input, err)
+		}
+
+		var got []interface{}
+		for item, ok := c.Next(); ok; item, ok = c.Next() {
+			got = append(got, item)
+		}
+
+		if !validateQuery(From(got), test.want) {
+			t.Errorf("%v.IterateFrom() resumed with %v expected %v", test.input, got, test.want)
+		}
+	}
+
+	if _, err := From([]int{1}).IterateFrom("x"); err == nil || err.Error() != `linq: invalid resume token "x"` {
+		t.Errorf("IterateFrom(x)=%v expected an invalid token error", err)
+	}
+}
+
+func TestFromResumable(t *testing.T) {
+	q := FromResumable(resumableLines{"a", "b", "c"}).Select(func(s interface{}) interface{} {
+		return s.(string) + "!"
+	})
+
+	if w := []interface{}{"a!", "b!", "c!"}; !validateQuery(q, w) {
+		t.Errorf("FromResumable()=%v expected %v", toSlice(q), w)
+	}
+
+	c, _ := q.IterateFrom("")
+	c.Next()
+	if token := c.Token(); token != "line 1" {
+		t.Errorf("Cursor.Token()=%v expected line 1", token)
+	}
+
+	c, _ = q.IterateFrom("1")
+	if item, ok := c.Next(); !ok || item != "b!" {
+		t.Errorf("IterateFrom(1).Next()=%v, %v expected b!, true", item, ok)
+	}
+}
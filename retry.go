@@ -0,0 +1,88 @@
+package linq
+
+import "time"
+
+// RetrySource initializes a linq query with a collection built by factory that
+// is rebuilt when it fails. A failure is either an error returned by factory,
+// or a panic with an error while iterating over the collection, as the
+// sources reading from an io.Reader do.
+//
+// After a failure RetrySource waits for backoff, calls factory again and skips
+// the elements that have already been produced, so the iteration resumes where
+// it failed as long as the rebuilt collection produces the same elements.
+// factory is called at most attempts times per iteration; when the last
+// attempt fails, iterating over the query panics with its error. An attempts
+// value less than 1 is treated as 1.
+//
+// Runtime panics and panics with values that are not errors are not
+// recovered.
+func RetrySource(factory func() (Query, error), attempts int, backoff time.Duration) Query {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return Query{
+		desc: "RetrySource",
+		Iterate: func() Iterator {
+			var next Iterator
+			produced, failures := 0, 0
+
+			// fail records a failure and panics with err if no attempts
+			// remain.
+			fail := func(err error) {
+				next = nil
+				failures++
+				if failures >= attempts {
+					panic(err)
+				}
+
+				time.Sleep(backoff)
+			}
+
+			// open builds the collection and skips the elements that have
+			// already been produced.
+			open := func() bool {
+				q, err := factory()
+				if err != nil {
+					fail(err)
+					return false
+				}
+
+				next = q.Iterate()
+				for i := 0; i < produced; i++ {
+					_, ok, err := tryNext(next)
+					if err != nil {
+						fail(err)
+						return false
+					}
+
+					if !ok {
+						break
+					}
+				}
+
+				return true
+			}
+
+			return func() (item interface{}, ok bool) {
+				for {
+					if next == nil && !open() {
+						continue
+					}
+
+					item, ok, err := tryNext(next)
+					if err != nil {
+						fail(err)
+						continue
+					}
+
+					if ok {
+						produced++
+					}
+
+					return item, ok
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRetrySource(t *testing.T) {
+	errFlaky := errors.New("connection reset")
+	calls := 0
+	factory := func() (Query, error) {
+		calls++
+		switch calls {
+		case 1:
+			return Range(1, 5).Select(func(i interface{}) interface{} {
+				if i.(int) == 3 {
+					panic(errFlaky)
+				}
+
+				return i
+			}), nil
+		case 2:
+			return Query{}, errFlaky
+		}
+
+		return Range(1, 5), nil
+	}
+
+	q := RetrySource(factory, 3, 0)
+	if w := []interface{}{1, 2, 3, 4, 5}; !validateQuery(q, w) {
+		t.Errorf("RetrySource()=%v expected %v", toSlice(q), w)
+	}
+
+	if calls != 3 {
+		t.Errorf("RetrySource() called factory %d times expected 3", calls)
+	}
+}
+
+func TestRetrySource_PanicWhenAttemptsAreExhausted(t *testing.T) {
+	calls := 0
+	factory := func() (Query, error) {
+		calls++
+		return Query{}, errors.New("unavailable")
+	}
+
+	mustPanicWithError(t, "unavailable", func() {
+		RetrySource(factory, 2, 0).Results()
+	})
+
+	if calls != 2 {
+		t.Errorf("RetrySource() called factory %d times expected 2", calls)
+	}
+
+	calls = 0
+	mustPanicWithError(t, "unavailable", func() {
+		RetrySource(factory, 0, 0).Results()
+	})
+
+	if calls != 1 {
+		t.Errorf("RetrySource(0) called factory %d times expected 1", calls)
+	}
+}
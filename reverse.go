@@ -5,8 +5,24 @@ package linq
 // Unlike OrderBy, this sorting method does not consider the actual values
 // themselves in determining the order. Rather, it just returns the elements in
 // the reverse order from which they are produced by the underlying source.
+//
+// If the collection supports random access, such as a query created from a
+// slice, array or string, its elements are read backwards by index instead of
+// being buffered, and the result supports random access too. Since
+// SelectPure, Skip and Take preserve random access, q.Reverse().Take(10) then
+// reads only the last ten elements of the collection.
 func (q Query) Reverse() Query {
+	if q.index != nil {
+		n := q.length()
+
+		return fromIndex(n, func(i int) interface{} {
+			return q.index(n - 1 - i)
+		}).describe(q.chain("Reverse")).elementsOf(q)
+	}
+
 	return Query{
+		desc:   q.chain("Reverse"),
+		length: q.length,
 		Iterate: func() Iterator {
 			next := q.Iterate()
 
@@ -26,5 +42,5 @@ func (q Query) Reverse() Query {
 				return
 			}
 		},
-	}
+	}.elementsOf(q)
 }
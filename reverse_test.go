@@ -1,6 +1,10 @@
 package linq
 
-import "testing"
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
 
 func TestReverse(t *testing.T) {
 	tests := []struct {
@@ -16,3 +20,62 @@ func TestReverse(t *testing.T) {
 		}
 	}
 }
+
+func TestReverseForRandomAccess(t *testing.T) {
+	tests := []struct {
+		input  Query
+		output []interface{}
+	}{
+		{From([]int{1, 2, 3}), []interface{}{3, 2, 1}},
+		{FromString("ab"), []interface{}{'b', 'a'}},
+		{From([]int{1, 2, 3, 4}).Skip(1), []interface{}{4, 3, 2}},
+		{From([]int{}), []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := test.input.Reverse()
+		if q.index == nil || !validateQuery(q, test.output) {
+			t.Errorf("Reverse()=%v expected %v", toSlice(q), test.output)
+		}
+	}
+
+	if r := From([]int{1, 2, 3}).Reverse().Last(); r != 1 {
+		t.Errorf("Reverse().Last()=%v expected 1", r)
+	}
+}
+
+func TestReverseOfSelectPureForRandomAccess(t *testing.T) {
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i + 1
+	}
+
+	calls := 0
+	q := From(input).SelectPure(func(i interface{}) interface{} {
+		calls++
+		return i.(int) * 2
+	}).Reverse().Take(3)
+
+	if got, w := toSlice(q), []interface{}{200, 198, 196}; !reflect.DeepEqual(got, w) || calls != 3 {
+		t.Errorf("SelectPure().Reverse().Take(3)=%v with %d calls expected %v with 3 calls", got, calls, w)
+	}
+}
+
+func TestReverseOfSelectCallsSelectorInOrder(t *testing.T) {
+	var calls []interface{}
+	q := From([]int{1, 2, 3}).Select(func(i interface{}) interface{} {
+		calls = append(calls, i)
+		return i
+	}).Reverse()
+
+	if w := []interface{}{3, 2, 1}; !validateQuery(q, w) || !reflect.DeepEqual(calls, []interface{}{1, 2, 3}) {
+		t.Errorf("Select().Reverse()=%v with calls %v expected %v with calls [1 2 3]", toSlice(q), calls, w)
+	}
+
+	q = From([]string{"a", "b", "c"}).SelectIndexed(func(i int, s interface{}) interface{} {
+		return s.(string) + strconv.Itoa(i)
+	}).Reverse()
+	if w := []interface{}{"c2", "b1", "a0"}; q.index != nil || !validateQuery(q, w) {
+		t.Errorf("SelectIndexed().Reverse()=%v expected %v without random access", toSlice(q), w)
+	}
+}
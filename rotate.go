@@ -0,0 +1,157 @@
+package linq
+
+import "strconv"
+
+// Rotate shifts the elements of a collection cyclically by n positions: the
+// first n elements are moved to the end of the collection, so the element at
+// index n becomes the first one. If n is negative, the last -n elements are
+// moved to the beginning instead. n can be greater than the number of
+// elements, in which case the collection is rotated by n modulo its length.
+//
+// If the collection supports random access, such as a query created from a
+// slice, the result supports it too and nothing is buffered. Otherwise, if n
+// is positive, the first n elements are buffered while the others are
+// returned as they are iterated over, and if n is negative, the whole
+// collection is buffered.
+func (q Query) Rotate(n int) Query {
+	desc := q.chain("Rotate(" + strconv.Itoa(n) + ")")
+
+	if n == 0 {
+		return q.describe(desc)
+	}
+
+	if q.index != nil {
+		length := q.length()
+		if length == 0 {
+			return q.describe(desc)
+		}
+
+		shift := rotation(n, length)
+		return fromIndex(length, func(i int) interface{} {
+			return q.index((i + shift) % length)
+		}).describe(desc)
+	}
+
+	return Query{
+		desc:   desc,
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var buffer []interface{}
+			buffered, streaming := false, false
+			start, emitted := 0, 0
+
+			return func() (item interface{}, ok bool) {
+				if !buffered {
+					buffered = true
+					for n < 0 || len(buffer) < n {
+						if item, ok = next(); !ok {
+							break
+						}
+
+						buffer = append(buffer, item)
+					}
+
+					if ok {
+						streaming = true
+					} else if len(buffer) > 0 {
+						start = rotation(n, len(buffer))
+					}
+				}
+
+				if streaming {
+					if item, ok = next(); ok {
+						return
+					}
+
+					streaming = false
+				}
+
+				if emitted < len(buffer) {
+					item, ok = buffer[(start+emitted)%len(buffer)], true
+					emitted++
+					return
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// MoveToFront moves the elements of a collection that satisfy a specified
+// condition to the beginning of the collection, followed by the other
+// elements. The relative order of the elements that satisfy the condition and
+// of the other elements is kept.
+//
+// Elements that satisfy the condition are returned as soon as they are
+// iterated over, and only the other elements are buffered until the end of the
+// collection is reached.
+func (q Query) MoveToFront(predicate func(interface{}) bool) Query {
+	return Query{
+		desc:   q.chain("MoveToFront"),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var rest []interface{}
+			ended := false
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				for !ended {
+					if item, ok = next(); !ok {
+						ended = true
+						break
+					}
+
+					if predicate(item) {
+						return
+					}
+
+					rest = append(rest, item)
+				}
+
+				if index < len(rest) {
+					item, ok = rest[index], true
+					rest[index] = nil
+					index++
+					return
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// MoveToFrontT is the typed version of MoveToFront.
+//
+//   - predicateFn is of type "func(TSource)bool"
+//
+// NOTE: MoveToFront has better performance than MoveToFrontT.
+func (q Query) MoveToFrontT(predicateFn interface{}) Query {
+	predicateGenericFunc, err := newGenericFunc(
+		"MoveToFrontT", "predicateFn", predicateFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(bool))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	predicateFunc := func(item interface{}) bool {
+		return predicateGenericFunc.Call(item).(bool)
+	}
+
+	return q.MoveToFront(predicateFunc)
+}
+
+// rotation returns the index of the first element of a collection of length
+// elements rotated by n positions, where length is positive.
+func rotation(n, length int) int {
+	shift := n % length
+	if shift < 0 {
+		shift += length
+	}
+
+	return shift
+}
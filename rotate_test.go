@@ -0,0 +1,66 @@
+package linq
+
+import "testing"
+
+func TestRotate(t *testing.T) {
+	where := func(interface{}) bool { return true }
+
+	tests := []struct {
+		n    int
+		want []interface{}
+	}{
+		{0, []interface{}{1, 2, 3, 4, 5}},
+		{1, []interface{}{2, 3, 4, 5, 1}},
+		{3, []interface{}{4, 5, 1, 2, 3}},
+		{5, []interface{}{1, 2, 3, 4, 5}},
+		{7, []interface{}{3, 4, 5, 1, 2}},
+		{-1, []interface{}{5, 1, 2, 3, 4}},
+		{-7, []interface{}{4, 5, 1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		if q := Range(1, 5).Where(where).Rotate(test.n); !validateQuery(q, test.want) {
+			t.Errorf("Range(1, 5).Where().Rotate(%d)=%v expected %v", test.n, toSlice(q), test.want)
+		}
+
+		if q := From([]int{1, 2, 3, 4, 5}).Rotate(test.n); !validateQuery(q, test.want) || q.ElementAt(0) != test.want[0] {
+			t.Errorf("From([1 2 3 4 5]).Rotate(%d)=%v expected %v", test.n, toSlice(q), test.want)
+		}
+	}
+
+	if q := Empty().Rotate(3); !validateQuery(q, []interface{}{}) {
+		t.Errorf("Empty().Rotate(3)=%v expected []", toSlice(q))
+	}
+
+	if q := From([]int{}).Rotate(-3); !validateQuery(q, []interface{}{}) {
+		t.Errorf("From([]).Rotate(-3)=%v expected []", toSlice(q))
+	}
+}
+
+func TestMoveToFront(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	want := []interface{}{2, 4, 6, 1, 3, 5}
+
+	if q := From(input).MoveToFront(func(i interface{}) bool {
+		return i.(int)%2 == 0
+	}); !validateQuery(q, want) || q.Count() != 6 {
+		t.Errorf("From(%v).MoveToFront()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := From(input).MoveToFrontT(func(i int) bool { return i > 4 }); !validateQuery(q, []interface{}{5, 6, 1, 2, 3, 4}) {
+		t.Errorf("From(%v).MoveToFrontT()=%v expected [5 6 1 2 3 4]", input, toSlice(q))
+	}
+
+	ch := make(chan interface{}, 1)
+	ch <- 1
+	first, _ := FromChannel(ch).MoveToFront(func(i interface{}) bool { return i == 1 }).Iterate()()
+	if first != 1 {
+		t.Errorf("MoveToFront() first=%v expected 1 before the end of the collection", first)
+	}
+}
+
+func TestMoveToFrontT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "MoveToFrontT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'", func() {
+		From([]int{1}).MoveToFrontT(func(i int) int { return i })
+	})
+}
@@ -0,0 +1,154 @@
+package linq
+
+import (
+	"sort"
+	"sync"
+)
+
+// Router splits a collection into several named queries in a single pass over
+// the collection, sending each element to the first route whose predicate it
+// matches, in the order the routes were added. It is created with the Router
+// method of a query.
+type Router struct {
+	source Query
+	names  []string
+	preds  []func(interface{}) bool
+}
+
+// Router returns a Router that splits the collection into the routes added to
+// it with Route and Otherwise. For example, to split orders by status:
+//
+//	outputs := From(orders).Router().
+//		Route("failed", isFailed).
+//		Route("large", isLarge).
+//		Otherwise("rest").
+//		Queries()
+func (q Query) Router() *Router {
+	return &Router{source: q}
+}
+
+// Route adds a route with the specified name, which receives the elements
+// that match predicate and none of the routes added before. Adding a route
+// with the name of an existing route replaces its predicate, keeping its
+// position.
+func (r *Router) Route(name string, predicate func(interface{}) bool) *Router {
+	for i, n := range r.names {
+		if n == name {
+			r.preds[i] = predicate
+			return r
+		}
+	}
+
+	r.names = append(r.names, name)
+	r.preds = append(r.preds, predicate)
+	return r
+}
+
+// Otherwise adds a route with the specified name, which receives the elements
+// that match none of the routes added before. Elements that match no route are
+// dropped.
+func (r *Router) Otherwise(name string) *Router {
+	return r.Route(name, func(interface{}) bool { return true })
+}
+
+// Queries returns a query for each route, by name. The collection is iterated
+// once, as the queries are iterated: when a query needs an element, elements
+// are read from the collection until one is routed to it, and the elements
+// routed to the other queries are buffered until these are iterated. The
+// queries can be iterated in any order, or concurrently from different
+// goroutines, but a query whose elements are never read keeps them buffered.
+//
+// Like a query created from a channel, each query can be iterated only once:
+// iterating it again continues where the previous iteration stopped, and once
+// it has been exhausted, the iterator of a new iteration panics with
+// ErrSourceExhausted. Each call of Queries splits the collection anew.
+func (r *Router) Queries() map[string]Query {
+	state := &routeState{
+		source:  r.source,
+		preds:   append([]func(interface{}) bool(nil), r.preds...),
+		buffers: make([][]interface{}, len(r.preds)),
+	}
+
+	queries := make(map[string]Query, len(r.names))
+	for i, name := range r.names {
+		route := i
+		queries[name] = Query{
+			desc: r.source.chain("Route(" + name + ")"),
+			Iterate: singleUse(func() Iterator {
+				return func() (interface{}, bool) {
+					return state.get(route)
+				}
+			}),
+		}
+	}
+
+	return queries
+}
+
+// Route splits a collection into a query per route in a single pass over the
+// collection, sending each element to the first route, in ascending order of
+// names, whose predicate it matches. Elements that match no route are
+// dropped. Use Router to choose the order in which routes are tested; see
+// Router.Queries for how the returned queries are iterated.
+func (q Query) Route(routes map[string]func(interface{}) bool) map[string]Query {
+	names := make([]string, 0, len(routes))
+	for name := range routes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	r := q.Router()
+	for _, name := range names {
+		r.Route(name, routes[name])
+	}
+
+	return r.Queries()
+}
+
+// routeState holds the iteration over the collection split by a Router and
+// the elements routed to each route that have not been read yet.
+type routeState struct {
+	mu      sync.Mutex
+	source  Query
+	next    Iterator
+	done    bool
+	preds   []func(interface{}) bool
+	buffers [][]interface{}
+}
+
+// get returns the next element of the specified route, reading elements from
+// the collection until one is routed to it.
+func (s *routeState) get(route int) (item interface{}, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.next == nil && !s.done {
+		s.next = s.source.Iterate()
+	}
+
+	for len(s.buffers[route]) == 0 && !s.done {
+		item, ok := s.next()
+		if !ok {
+			s.done = true
+			break
+		}
+
+		for i, predicate := range s.preds {
+			if predicate(item) {
+				s.buffers[i] = append(s.buffers[i], item)
+				break
+			}
+		}
+	}
+
+	buffer := s.buffers[route]
+	if len(buffer) == 0 {
+		return nil, false
+	}
+
+	item = buffer[0]
+	buffer[0] = nil
+	s.buffers[route] = buffer[1:]
+	return item, true
+}
@@ -0,0 +1,88 @@
+package linq
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRouter(t *testing.T) {
+	reads := 0
+	source := Range(1, 10).Select(func(i interface{}) interface{} {
+		reads++
+		return i
+	})
+
+	outputs := source.Router().
+		Route("fizz", func(i interface{}) bool { return i.(int)%3 == 0 }).
+		Route("buzz", func(i interface{}) bool { return i.(int)%5 == 0 }).
+		Route("even", func(i interface{}) bool { return i.(int)%2 == 0 }).
+		Otherwise("rest").
+		Queries()
+
+	tests := []struct {
+		name string
+		want []interface{}
+	}{
+		{"even", []interface{}{2, 4, 8}},
+		{"fizz", []interface{}{3, 6, 9}},
+		{"buzz", []interface{}{5, 10}},
+		{"rest", []interface{}{1, 7}},
+	}
+
+	for _, test := range tests {
+		if q := outputs[test.name]; !validateQuery(q, test.want) {
+			t.Errorf("Router() route %v=%v expected %v", test.name, toSlice(q), test.want)
+		}
+	}
+
+	if reads != 10 {
+		t.Errorf("Router() read %d elements expected 10", reads)
+	}
+
+	mustPanicWithError(t, ErrSourceExhausted.Error(), func() {
+		toSlice(outputs["rest"])
+	})
+}
+
+func TestRouterConcurrent(t *testing.T) {
+	outputs := Range(0, 1000).Route(map[string]func(interface{}) bool{
+		"even": func(i interface{}) bool { return i.(int)%2 == 0 },
+		"odd":  func(i interface{}) bool { return true },
+	})
+
+	var wg sync.WaitGroup
+	sums := make(map[string]int64)
+	var mu sync.Mutex
+	for name, q := range outputs {
+		wg.Add(1)
+		go func(name string, q Query) {
+			defer wg.Done()
+			sum := q.SumInts()
+
+			mu.Lock()
+			sums[name] = sum
+			mu.Unlock()
+		}(name, q)
+	}
+
+	wg.Wait()
+	if sums["even"] != 249500 || sums["odd"] != 250000 {
+		t.Errorf("Route() sums=%v expected even 249500 and odd 250000", sums)
+	}
+}
+
+func TestRoute(t *testing.T) {
+	// Routes are tested in ascending order of names, so "a" takes precedence.
+	outputs := Range(1, 4).Route(map[string]func(interface{}) bool{
+		"b": func(i interface{}) bool { return i.(int) > 1 },
+		"a": func(i interface{}) bool { return i.(int) > 2 },
+	})
+
+	if w := []interface{}{3, 4}; !validateQuery(outputs["a"], w) {
+		t.Errorf("Route() a=%v expected %v", toSlice(outputs["a"]), w)
+	}
+
+	if w := []interface{}{2}; !validateQuery(outputs["b"], w) {
+		t.Errorf("Route() b=%v expected %v", toSlice(outputs["b"]), w)
+	}
+}
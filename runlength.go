@@ -0,0 +1,71 @@
+package linq
+
+// RunLengthEncode replaces every run of consecutive equal elements of a
+// collection with one KeyValue element, whose Key is the element and whose
+// Value is the int length of the run. For example, [a a b a] is encoded as
+// [{a 2} {b 1} {a 1}].
+//
+// Elements are compared like in SequenceEqual. Use RunLengthDecode to get the
+// original collection back.
+func (q Query) RunLengthEncode() Query {
+	return Query{
+		desc: q.chain("RunLengthEncode"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var current interface{}
+			has, started := false, false
+
+			return func() (item interface{}, ok bool) {
+				if !started {
+					started = true
+					current, has = next()
+				}
+
+				if !has {
+					return
+				}
+
+				run := 1
+				for {
+					item, ok := next()
+					if !ok || !equalItems(item, current) {
+						encoded := KeyValue{Key: current, Value: run}
+						current, has = item, ok
+						return encoded, true
+					}
+
+					run++
+				}
+			}
+		},
+	}
+}
+
+// RunLengthDecode is the inverse of RunLengthEncode: it replaces every element
+// of a collection, which has to be a KeyValue whose Value is an int, with Value
+// repetitions of its Key. Elements whose Value is not positive are dropped.
+func (q Query) RunLengthDecode() Query {
+	return Query{
+		desc: q.chain("RunLengthDecode"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var current interface{}
+			remaining := 0
+
+			return func() (item interface{}, ok bool) {
+				for remaining <= 0 {
+					item, ok = next()
+					if !ok {
+						return
+					}
+
+					kv := item.(KeyValue)
+					current, remaining = kv.Key, kv.Value.(int)
+				}
+
+				remaining--
+				return current, true
+			}
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package linq
+
+import "testing"
+
+func TestRunLengthEncode(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  []interface{}
+	}{
+		{"aabaaa", []interface{}{KeyValue{'a', 2}, KeyValue{'b', 1}, KeyValue{'a', 3}}},
+		{[]int{1}, []interface{}{KeyValue{1, 1}}},
+		{[]interface{}{nil, nil, 1}, []interface{}{KeyValue{nil, 2}, KeyValue{1, 1}}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).RunLengthEncode(); !validateQuery(q, test.want) {
+			t.Errorf("From(%v).RunLengthEncode()=%v expected %v", test.input, toSlice(q), test.want)
+		}
+	}
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	input := []KeyValue{{"a", 2}, {"b", 0}, {"c", 1}, {"a", 3}}
+	want := []interface{}{"a", "a", "c", "a", "a", "a"}
+
+	if q := From(input).RunLengthDecode(); !validateQuery(q, want) {
+		t.Errorf("From(%v).RunLengthDecode()=%v expected %v", input, toSlice(q), want)
+	}
+
+	if q := FromString("xxyzzz").RunLengthEncode().RunLengthDecode(); !validateQuery(q, toSlice(FromString("xxyzzz"))) {
+		t.Errorf("RunLengthEncode().RunLengthDecode()=%v expected [x x y z z z]", toSlice(q))
+	}
+}
@@ -0,0 +1,232 @@
+package linq
+
+import (
+	"errors"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SampleEvery returns a query that emits the most recent element produced by
+// a collection once every d, and drops the other elements. Ticks during which
+// the collection produced no element emit nothing. When the collection ends,
+// the most recent element that has not been emitted yet is emitted, then the
+// query ends.
+//
+// The collection is iterated in its own goroutine as fast as it produces
+// elements, so SampleEvery is meant for channel-backed sources, such as
+// telemetry streams feeding a dashboard at a fixed rate. The goroutine stops
+// once the collection ends, once the iterator of the returned query has been
+// abandoned and garbage collected, for example after Take or First, or once
+// the Context of the query passed to SampleEvery, set with WithOptions, is
+// done, which also ends the returned query. Since the goroutine can't be
+// interrupted while it waits for the next element of the collection, it stops
+// at the latest when that element is produced, without handing it on.
+//
+// If iterating over the collection panics, the iterator of the returned query
+// panics with the same value. SampleEvery panics if d is not positive.
+func (q Query) SampleEvery(d time.Duration) Query {
+	if d <= 0 {
+		panic(errors.New("SampleEvery: non-positive interval"))
+	}
+
+	ctx := q.context()
+
+	return Query{
+		desc: q.chain("SampleEvery"),
+		Iterate: func() Iterator {
+			var mu sync.Mutex
+			var latest interface{}
+			has := false
+
+			h := startPump(q, func(item interface{}) {
+				mu.Lock()
+				latest, has = item, true
+				mu.Unlock()
+			})
+
+			// take returns the most recent element that has not been
+			// emitted yet.
+			take := func() (item interface{}, ok bool) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				item, ok = latest, has
+				latest, has = nil, false
+				return
+			}
+
+			tick := newTicks(d)
+			finished := false
+
+			return func() (item interface{}, ok bool) {
+				for !finished {
+					if ctx.Err() != nil {
+						finished = true
+						h.p.stop()
+						break
+					}
+
+					select {
+					case <-tick.wait():
+						if item, ok = take(); ok {
+							return
+						}
+					case <-h.p.done:
+						finished = true
+						h.p.rethrow()
+						return take()
+					case <-ctx.Done():
+						finished = true
+						h.p.stop()
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// pump iterates over a collection in its own goroutine.
+type pump struct {
+	done     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+	panicked bool
+	reason   interface{}
+}
+
+// pumpHandle is held by the iterator consuming a pump. When the handle is
+// garbage collected, because the iteration has been abandoned, the pump is
+// stopped so that its goroutine doesn't drain the collection forever. The
+// goroutine itself must not reference the handle.
+type pumpHandle struct {
+	p *pump
+}
+
+// startPump iterates over q in a new goroutine, calling onItem for each
+// element, until the iteration ends or the pump is stopped. The done channel
+// of the pump is closed when the goroutine ends.
+func startPump(q Query, onItem func(interface{})) *pumpHandle {
+	p := &pump{done: make(chan struct{}), stopped: make(chan struct{})}
+
+	go func() {
+		defer close(p.done)
+		defer func() {
+			if r := recover(); r != nil {
+				p.panicked, p.reason = true, r
+			}
+		}()
+
+		next := q.Iterate()
+		for item, ok := next(); ok; item, ok = next() {
+			select {
+			case <-p.stopped:
+				return
+			default:
+			}
+
+			onItem(item)
+		}
+	}()
+
+	h := &pumpHandle{p}
+	runtime.SetFinalizer(h, func(h *pumpHandle) { h.p.stop() })
+	return h
+}
+
+// stop makes the goroutine of the pump end before it hands the next element
+// to onItem.
+func (p *pump) stop() {
+	p.stopOnce.Do(func() { close(p.stopped) })
+}
+
+// rethrow panics with the value the iteration panicked with, if any. It must
+// be called after the done channel has been closed.
+func (p *pump) rethrow() {
+	if p.panicked {
+		panic(p.reason)
+	}
+}
+
+// ticks is a sequence of points in time that are d apart, where d is positive.
+// Unlike a time.Ticker, it needs no stopping when it is no longer used.
+type ticks struct {
+	d    time.Duration
+	next time.Time
+}
+
+func newTicks(d time.Duration) *ticks {
+	return &ticks{d: d, next: time.Now().Add(d)}
+}
+
+// wait returns a channel that receives a value at the next tick. Ticks that
+// have already passed are skipped.
+func (t *ticks) wait() <-chan time.Time {
+	now := time.Now()
+	for !t.next.After(now) {
+		t.next = t.next.Add(t.d)
+	}
+
+	c := time.After(t.next.Sub(now))
+	t.next = t.next.Add(t.d)
+	return c
+}
+
+// Sample returns a uniform random sample of n elements of a collection, taken
+// with reservoir sampling: the collection is iterated once, when the first
+// element of the sample is requested, and only n elements are kept in memory,
+// so a sample can be taken from an arbitrarily large or channel-backed stream.
+// Each iteration of the query takes a new sample.
+//
+// The elements of the sample are in no particular order. If the collection
+// contains n elements or less, the sample contains all of them. Sample uses
+// the default source of math/rand and panics if n is negative.
+func (q Query) Sample(n int) Query {
+	if n < 0 {
+		panic(errors.New("Sample: negative size"))
+	}
+
+	return q.sample(n, rand.Intn).describe(q.chain("Sample(" + strconv.Itoa(n) + ")"))
+}
+
+// sample returns a random sample of n elements of q, using intn to get random
+// numbers in [0, n).
+func (q Query) sample(n int, intn func(int) int) Query {
+	return Query{
+		Iterate: func() Iterator {
+			var reservoir []interface{}
+			sampled := false
+			index := 0
+
+			return func() (item interface{}, ok bool) {
+				if !sampled {
+					sampled = true
+					reservoir = make([]interface{}, 0, n)
+
+					next := q.Iterate()
+					seen := 0
+					for item, ok := next(); ok; item, ok = next() {
+						seen++
+						if len(reservoir) < n {
+							reservoir = append(reservoir, item)
+						} else if j := intn(seen); j < n {
+							reservoir[j] = item
+						}
+					}
+				}
+
+				ok = index < len(reservoir)
+				if ok {
+					item = reservoir[index]
+					index++
+				}
+
+				return
+			}
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSampleEvery(t *testing.T) {
+	const d = 50 * time.Millisecond
+	ch := make(chan interface{})
+
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+
+		time.Sleep(3 * d)
+		ch <- 4
+		close(ch)
+	}()
+
+	if w := []interface{}{3, 4}; !validateQuery(FromChannel(ch).SampleEvery(d), w) {
+		t.Errorf("SampleEvery() expected %v", w)
+	}
+}
+
+func TestSampleEvery_PanicWhenSourcePanics(t *testing.T) {
+	mustPanicWithError(t, "read failed", func() {
+		Range(1, 3).Select(func(interface{}) interface{} {
+			panic(errors.New("read failed"))
+		}).SampleEvery(time.Millisecond).Results()
+	})
+
+	mustPanicWithError(t, "SampleEvery: non-positive interval", func() {
+		Range(1, 3).SampleEvery(0)
+	})
+}
+
+func TestSampleEveryStopsWhenAbandoned(t *testing.T) {
+	var produced int64
+	source := Generate(0, func(i interface{}) interface{} {
+		atomic.AddInt64(&produced, 1)
+		return i.(int) + 1
+	})
+
+	if first := source.SampleEvery(time.Millisecond).First(); first == nil {
+		t.Fatalf("SampleEvery().First()=nil expected an element")
+	}
+
+	if !pumpStops(&produced) {
+		t.Errorf("SampleEvery() kept iterating over the collection after First()")
+	}
+}
+
+func TestSampleEveryWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var produced int64
+	source := Generate(0, func(i interface{}) interface{} {
+		atomic.AddInt64(&produced, 1)
+		return i.(int) + 1
+	})
+
+	next := source.WithOptions(Options{Context: ctx}).SampleEvery(time.Millisecond).Iterate()
+	if _, ok := next(); !ok {
+		t.Fatalf("SampleEvery() ended before the context was canceled")
+	}
+
+	cancel()
+	if item, ok := next(); ok {
+		t.Errorf("SampleEvery()=%v after the context was canceled expected no element", item)
+	}
+
+	if !pumpStops(&produced) {
+		t.Errorf("SampleEvery() kept iterating over the collection after the context was canceled")
+	}
+}
+
+// pumpStops reports whether the counter of produced elements stops growing
+// within a second, running the garbage collector so that the pumps of
+// abandoned iterations are stopped.
+func pumpStops(produced *int64) bool {
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+		runtime.GC()
+		before := atomic.LoadInt64(produced)
+		time.Sleep(10 * time.Millisecond)
+		if atomic.LoadInt64(produced) == before {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestSample(t *testing.T) {
+	q := Range(1, 1000).Sample(10)
+	for i := 0; i < 3; i++ {
+		sample := toSlice(q)
+		if len(sample) != 10 || From(sample).Distinct().Count() != 10 ||
+			!From(sample).All(func(i interface{}) bool { return i.(int) >= 1 && i.(int) <= 1000 }) {
+			t.Errorf("Range(1, 1000).Sample(10)=%v expected 10 distinct elements of the range", sample)
+		}
+	}
+
+	ch := make(chan interface{}, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	if c := FromChannel(ch).Sample(5).OrderBy(func(i interface{}) interface{} { return i }).Query; !validateQuery(c, []interface{}{1, 2, 3}) {
+		t.Errorf("FromChannel([1 2 3]).Sample(5)=%v expected [1 2 3]", toSlice(c))
+	}
+
+	if q := Range(1, 10).Sample(0); !validateQuery(q, []interface{}{}) {
+		t.Errorf("Range(1, 10).Sample(0)=%v expected []", toSlice(q))
+	}
+
+	if q := Range(1, 10).sample(3, func(int) int { return 0 }); !validateQuery(q, []interface{}{10, 2, 3}) {
+		t.Errorf("Range(1, 10).sample(3)=%v expected [10 2 3]", toSlice(q))
+	}
+
+	if q := Range(1, 10).sample(3, func(n int) int { return n - 1 }); !validateQuery(q, []interface{}{1, 2, 3}) {
+		t.Errorf("Range(1, 10).sample(3)=%v expected [1 2 3]", toSlice(q))
+	}
+}
+
+func TestSample_PanicWhenSizeIsNegative(t *testing.T) {
+	mustPanicWithError(t, "Sample: negative size", func() {
+		Range(1, 3).Sample(-1)
+	})
+}
@@ -0,0 +1,52 @@
+package linq
+
+// Scan applies an accumulator function over a sequence and returns a query
+// with every intermediate accumulator value. The specified seed value is used
+// as the initial accumulator value.
+//
+// Scan works like AggregateWithSeed, but instead of returning only the final
+// result of f(), it emits the value returned by f() for each element of the
+// source. This makes it simple to compute running totals and other stateful
+// transformations while preserving lazy evaluation.
+func (q Query) Scan(seed interface{},
+	f func(interface{}, interface{}) interface{}) Query {
+	return Query{
+		desc: q.chain("Scan"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			result := seed
+
+			return func() (item interface{}, ok bool) {
+				var current interface{}
+				current, ok = next()
+				if ok {
+					result = f(result, current)
+					item = result
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// ScanT is the typed version of Scan.
+//
+//   - f is of type "func(TAccumulate, TSource) TAccumulate"
+//
+// NOTE: Scan has better performance than ScanT.
+func (q Query) ScanT(seed interface{}, f interface{}) Query {
+	fGenericFunc, err := newGenericFunc(
+		"ScanT", "f", f,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fFunc := func(result interface{}, current interface{}) interface{} {
+		return fGenericFunc.Call(result, current)
+	}
+
+	return q.Scan(seed, fFunc)
+}
@@ -0,0 +1,43 @@
+package linq
+
+import "testing"
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		seed   interface{}
+		f      func(interface{}, interface{}) interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 3, 4}, 0, func(r interface{}, i interface{}) interface{} {
+			return r.(int) + i.(int)
+		}, []interface{}{1, 3, 6, 10}},
+		{"abc", "", func(r interface{}, i interface{}) interface{} {
+			return r.(string) + string(i.(rune))
+		}, []interface{}{"a", "ab", "abc"}},
+		{[]int{}, 0, func(r interface{}, i interface{}) interface{} {
+			return r.(int) + i.(int)
+		}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Scan(test.seed, test.f); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).Scan()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestScanT(t *testing.T) {
+	input := []int{1, 2, 3}
+	want := []interface{}{1, 2, 6}
+
+	if q := From(input).ScanT(1, func(r, i int) int { return r * i }); !validateQuery(q, want) {
+		t.Errorf("From(%v).ScanT()=%v expected %v", input, toSlice(q), want)
+	}
+}
+
+func TestScanT_PanicWhenFunctionIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ScanT: parameter [f] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int)int'", func() {
+		From([]int{1, 2, 3}).ScanT(0, func(i int) int { return i })
+	})
+}
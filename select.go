@@ -12,22 +12,44 @@ package linq
 // the SelectMany method instead of Select. Although SelectMany works similarly
 // to Select, it differs in that the transform function returns a collection
 // that is then expanded by SelectMany before it is returned.
+//
+// selector is called once for each element, in order, from the goroutine
+// iterating over the query. Use SelectPure to keep the random access of the
+// collection instead.
 func (q Query) Select(selector func(interface{}) interface{}) Query {
+	project := func(next Iterator) Iterator {
+		return (&selectEnumerator{iteratorEnumerator{next}, selector}).Next
+	}
+
+	enumerate := func() Enumerator {
+		return &selectEnumerator{q.enumerator(), selector}
+	}
+
 	return Query{
+		desc:      q.chain("Select"),
+		length:    q.length,
+		resume:    q.resumeWith(project),
+		enumerate: enumerate,
 		Iterate: func() Iterator {
-			next := q.Iterate()
+			return enumerate().Next
+		},
+	}
+}
 
-			return func() (item interface{}, ok bool) {
-				var it interface{}
-				it, ok = next()
-				if ok {
-					item = selector(it)
-				}
+// selectEnumerator returns the elements of source projected by selector.
+type selectEnumerator struct {
+	source   Enumerator
+	selector func(interface{}) interface{}
+}
 
-				return
-			}
-		},
+func (e *selectEnumerator) Next() (item interface{}, ok bool) {
+	var it interface{}
+	it, ok = e.source.Next()
+	if ok {
+		item = e.selector(it)
 	}
+
+	return
 }
 
 // SelectT is the typed version of Select.
@@ -47,7 +69,52 @@ func (q Query) SelectT(selectorFn interface{}) Query {
 		return selectGenericFunc.Call(item)
 	}
 
-	return q.Select(selectorFunc)
+	return q.Select(selectorFunc).selectedBy(q, selectGenericFunc, 0)
+}
+
+// SelectPure is like Select, but selector must be a pure function: it has to
+// return the same value for the same element, have no side effects and be
+// safe to call from several goroutines at once. In return, if the collection
+// supports random access, such as a query created from a slice, array or
+// string, the result supports it too, calling selector only for the elements
+// that are read. For example,
+//
+//	From(slice).SelectPure(selector).Reverse().Take(10)
+//
+// reads only the last ten elements of slice and projects only them.
+//
+// selector may then be called out of order, more than once for an element,
+// not at all for elements that are skipped, and concurrently by the parallel
+// methods, such as AggregateParallel.
+func (q Query) SelectPure(selector func(interface{}) interface{}) Query {
+	if q.index == nil {
+		return q.Select(selector).describe(q.chain("SelectPure"))
+	}
+
+	return fromIndex(q.length(), func(i int) interface{} {
+		return selector(q.index(i))
+	}).describe(q.chain("SelectPure"))
+}
+
+// SelectPureT is the typed version of SelectPure.
+//
+//   - selectorFn is of type "func(TSource)TResult"
+//
+// NOTE: SelectPure has better performance than SelectPureT.
+func (q Query) SelectPureT(selectorFn interface{}) Query {
+	selectGenericFunc, err := newGenericFunc(
+		"SelectPureT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectGenericFunc.Call(item)
+	}
+
+	return q.SelectPure(selectorFunc).selectedBy(q, selectGenericFunc, 0)
 }
 
 // SelectIndexed projects each element of a collection into a new form by
@@ -71,6 +138,8 @@ func (q Query) SelectT(selectorFn interface{}) Query {
 // that is then expanded by SelectMany before it is returned.
 func (q Query) SelectIndexed(selector func(int, interface{}) interface{}) Query {
 	return Query{
+		desc:   q.chain("SelectIndexed"),
+		length: q.length,
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			index := 0
@@ -105,5 +174,5 @@ func (q Query) SelectIndexedT(selectorFn interface{}) Query {
 		return selectGenericFunc.Call(index, item)
 	}
 
-	return q.SelectIndexed(selectorFunc)
+	return q.SelectIndexed(selectorFunc).selectedBy(q, selectGenericFunc, 1)
 }
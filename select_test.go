@@ -32,6 +32,26 @@ func TestSelectT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
 	})
 }
 
+func TestSelectPure(t *testing.T) {
+	double := func(i interface{}) interface{} { return i.(int) * 2 }
+
+	q := From([]int{1, 2, 3}).SelectPure(double)
+	if w := []interface{}{2, 4, 6}; q.index == nil || !validateQuery(q, w) {
+		t.Errorf("From([1 2 3]).SelectPure()=%v expected %v with random access", toSlice(q), w)
+	}
+
+	q = Range(1, 3).SelectPureT(func(i int) int { return i * 2 })
+	if w := []interface{}{2, 4, 6}; !validateQuery(q, w) {
+		t.Errorf("Range(1, 3).SelectPureT()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestSelectPureT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SelectPureT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1, 2}).SelectPureT(func(item, idx int) int { return item + 2 })
+	})
+}
+
 func TestSelectIndexed(t *testing.T) {
 	tests := []struct {
 		input    interface{}
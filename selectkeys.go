@@ -0,0 +1,39 @@
+package linq
+
+import "fmt"
+
+// SelectKeys projects each element of a collection of KeyValue or Group
+// elements, such as a query created from a map or returned by GroupBy, into
+// its Key.
+//
+// SelectKeys panics if an element is neither a KeyValue nor a Group.
+func (q Query) SelectKeys() Query {
+	return q.Select(func(item interface{}) interface{} {
+		switch e := item.(type) {
+		case KeyValue:
+			return e.Key
+		case Group:
+			return e.Key
+		}
+
+		panic(fmt.Errorf("SelectKeys: element [%v] of type '%T' is neither a KeyValue nor a Group", item, item))
+	}).describe(q.chain("SelectKeys"))
+}
+
+// SelectValues projects each element of a collection of KeyValue or Group
+// elements, such as a query created from a map or returned by GroupBy, into
+// its Value, or into the []interface{} holding the elements of a Group.
+//
+// SelectValues panics if an element is neither a KeyValue nor a Group.
+func (q Query) SelectValues() Query {
+	return q.Select(func(item interface{}) interface{} {
+		switch e := item.(type) {
+		case KeyValue:
+			return e.Value
+		case Group:
+			return e.Group
+		}
+
+		panic(fmt.Errorf("SelectValues: element [%v] of type '%T' is neither a KeyValue nor a Group", item, item))
+	}).describe(q.chain("SelectValues"))
+}
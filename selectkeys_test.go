@@ -0,0 +1,48 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectKeys(t *testing.T) {
+	input := map[string]int{"a": 1, "b": 2}
+
+	keys := From(input).SelectKeys().OrderBy(func(i interface{}) interface{} { return i }).Query
+	if w := []interface{}{"a", "b"}; !validateQuery(keys, w) {
+		t.Errorf("From(%v).SelectKeys()=%v expected %v", input, toSlice(keys), w)
+	}
+
+	groups := Range(1, 4).GroupBySorted(func(i interface{}) interface{} { return i.(int) % 2 }, nil)
+	if q := groups.SelectKeys(); !validateQuery(q, []interface{}{0, 1}) {
+		t.Errorf("GroupBySorted().SelectKeys()=%v expected [0 1]", toSlice(q))
+	}
+
+	if s := From(input).SelectKeys().String(); s != "From(map[2]).SelectKeys" {
+		t.Errorf("SelectKeys().String()=%s expected From(map[2]).SelectKeys", s)
+	}
+}
+
+func TestSelectValues(t *testing.T) {
+	input := []KeyValue{{"a", 1}, {"b", 2}}
+
+	if q := From(input).SelectValues(); !validateQuery(q, []interface{}{1, 2}) || q.Count() != 2 {
+		t.Errorf("From(%v).SelectValues()=%v expected [1 2]", input, toSlice(q))
+	}
+
+	groups := Range(1, 4).GroupBySorted(func(i interface{}) interface{} { return i.(int) % 2 }, nil)
+	want := []interface{}{[]interface{}{2, 4}, []interface{}{1, 3}}
+	if r := toSlice(groups.SelectValues()); !reflect.DeepEqual(r, want) {
+		t.Errorf("GroupBySorted().SelectValues()=%v expected %v", r, want)
+	}
+}
+
+func TestSelectKeys_PanicWhenElementIsNotKeyValue(t *testing.T) {
+	mustPanicWithError(t, "SelectKeys: element [1] of type 'int' is neither a KeyValue nor a Group", func() {
+		Range(1, 3).SelectKeys().Results()
+	})
+
+	mustPanicWithError(t, "SelectValues: element [a] of type 'string' is neither a KeyValue nor a Group", func() {
+		From([]string{"a"}).SelectValues().Results()
+	})
+}
@@ -4,6 +4,7 @@ package linq
 // flattens the resulting collection into one collection.
 func (q Query) SelectMany(selector func(interface{}) Query) Query {
 	return Query{
+		desc: q.chain("SelectMany"),
 		Iterate: func() Iterator {
 			outernext := q.Iterate()
 			var inner interface{}
@@ -65,6 +66,7 @@ func (q Query) SelectManyT(selectorFn interface{}) Query {
 // element to process.
 func (q Query) SelectManyIndexed(selector func(int, interface{}) Query) Query {
 	return Query{
+		desc: q.chain("SelectManyIndexed"),
 		Iterate: func() Iterator {
 			outernext := q.Iterate()
 			index := 0
@@ -124,6 +126,7 @@ func (q Query) SelectManyBy(selector func(interface{}) Query,
 	resultSelector func(interface{}, interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("SelectManyBy"),
 		Iterate: func() Iterator {
 			outernext := q.Iterate()
 			var outer interface{}
@@ -197,6 +200,7 @@ func (q Query) SelectManyByIndexed(selector func(int, interface{}) Query,
 	resultSelector func(interface{}, interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("SelectManyByIndexed"),
 		Iterate: func() Iterator {
 			outernext := q.Iterate()
 			index := 0
@@ -0,0 +1,115 @@
+package linq
+
+import (
+	"errors"
+	"time"
+)
+
+// SelectWithTimeout projects each element of a collection into a new form like
+// Select, but bounds how long the transformation of a single element may take.
+// If selector doesn't return within d, the element is projected by fallback
+// instead, which is useful when selector calls an external service.
+//
+// Each element is projected by selector in its own goroutine. When d expires,
+// the goroutine is abandoned and its result is discarded once selector
+// returns. If selector panics within d, the iterator panics with the same
+// value. SelectWithTimeout panics if d is not positive.
+func (q Query) SelectWithTimeout(selector func(interface{}) interface{}, d time.Duration,
+	fallback func(interface{}) interface{}) Query {
+	if d <= 0 {
+		panic(errors.New("SelectWithTimeout: non-positive timeout"))
+	}
+
+	return Query{
+		desc:   q.chain("SelectWithTimeout(" + d.String() + ")"),
+		length: q.length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item, ok = next()
+				if !ok {
+					return
+				}
+
+				return selectWithTimeout(selector, item, d, fallback), true
+			}
+		},
+	}
+}
+
+// selectionResult is the result of a selector run by SelectWithTimeout, or the
+// value it panicked with.
+type selectionResult struct {
+	value    interface{}
+	panicked interface{}
+}
+
+// selectWithTimeout projects item by selector, or by fallback if selector
+// doesn't return within d.
+func selectWithTimeout(selector func(interface{}) interface{}, item interface{}, d time.Duration,
+	fallback func(interface{}) interface{}) interface{} {
+	// The channel is buffered so that an abandoned selector can still send its
+	// result and exit.
+	c := make(chan selectionResult, 1)
+	go func() {
+		var result selectionResult
+		defer func() {
+			if r := recover(); r != nil {
+				result.panicked = r
+			}
+
+			c <- result
+		}()
+
+		result.value = selector(item)
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case result := <-c:
+		if result.panicked != nil {
+			panic(result.panicked)
+		}
+
+		return result.value
+	case <-timer.C:
+		return fallback(item)
+	}
+}
+
+// SelectWithTimeoutT is the typed version of SelectWithTimeout.
+//
+//   - selectorFn is of type "func(TSource)TResult"
+//   - fallbackFn is of type "func(TSource)TResult"
+//
+// NOTE: SelectWithTimeout has better performance than SelectWithTimeoutT.
+func (q Query) SelectWithTimeoutT(selectorFn interface{}, d time.Duration, fallbackFn interface{}) Query {
+	selectGenericFunc, err := newGenericFunc(
+		"SelectWithTimeoutT", "selectorFn", selectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	fallbackGenericFunc, err := newGenericFunc(
+		"SelectWithTimeoutT", "fallbackFn", fallbackFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	selectorFunc := func(item interface{}) interface{} {
+		return selectGenericFunc.Call(item)
+	}
+
+	fallbackFunc := func(item interface{}) interface{} {
+		return fallbackGenericFunc.Call(item)
+	}
+
+	return q.SelectWithTimeout(selectorFunc, d, fallbackFunc)
+}
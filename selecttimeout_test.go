@@ -0,0 +1,58 @@
+package linq
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSelectWithTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	selector := func(i interface{}) interface{} {
+		if i.(int) == 2 {
+			<-release
+		}
+
+		return i.(int) * 10
+	}
+	fallback := func(i interface{}) interface{} {
+		return -i.(int)
+	}
+
+	q := Range(1, 3).SelectWithTimeout(selector, 20*time.Millisecond, fallback)
+	if w := []interface{}{10, -2, 30}; !validateQuery(q, w) {
+		t.Errorf("SelectWithTimeout()=%v expected %v", toSlice(q), w)
+	}
+
+	mustPanicWithError(t, "SelectWithTimeout: non-positive timeout", func() {
+		Range(1, 3).SelectWithTimeout(selector, 0, fallback)
+	})
+
+	mustPanicWithError(t, "select failed", func() {
+		Range(1, 3).SelectWithTimeout(func(interface{}) interface{} {
+			panic(errors.New("select failed"))
+		}, time.Second, fallback).ToSlice(new([]int))
+	})
+}
+
+func TestSelectWithTimeoutT(t *testing.T) {
+	q := Range(1, 3).SelectWithTimeoutT(func(i int) int { return i * 2 }, time.Second,
+		func(i int) int { return 0 })
+	if w := []interface{}{2, 4, 6}; !validateQuery(q, w) {
+		t.Errorf("SelectWithTimeoutT()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestSelectWithTimeoutT_PanicWhenSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SelectWithTimeoutT: parameter [selectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		Range(1, 3).SelectWithTimeoutT(func(item, idx int) int { return item + 2 }, time.Second, func(i int) int { return i })
+	})
+}
+
+func TestSelectWithTimeoutT_PanicWhenFallbackFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "SelectWithTimeoutT: parameter [fallbackFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int)'", func() {
+		Range(1, 3).SelectWithTimeoutT(func(i int) int { return i }, time.Second, func(i int) {})
+	})
+}
@@ -1,8 +1,10 @@
 package linq
 
-import "testing"
-
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 type foo struct {
 	f1 int
@@ -81,3 +83,9 @@ func mustPanicWithError(t *testing.T, expectedErr string, f func()) {
 	}()
 	f()
 }
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
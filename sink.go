@@ -0,0 +1,28 @@
+package linq
+
+// Sink is an interface that has to be implemented by a custom destination in
+// order to receive the elements of a query with Into method.
+type Sink interface {
+	// Collect receives the next element of the query. Returning an error
+	// stops the iteration.
+	Collect(item interface{}) error
+	// Finish is called once after the last element has been collected.
+	Finish() error
+}
+
+// Into iterates over a collection and passes each element to the Collect
+// method of sink, then calls its Finish method.
+//
+// If Collect returns an error, Into stops iterating and returns that error
+// without calling Finish. Otherwise Into returns the error returned by Finish.
+func (q Query) Into(sink Sink) error {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if err := sink.Collect(item); err != nil {
+			return err
+		}
+	}
+
+	return sink.Finish()
+}
@@ -0,0 +1,53 @@
+package linq
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type sliceSink struct {
+	items    []interface{}
+	limit    int
+	finished bool
+}
+
+func (s *sliceSink) Collect(item interface{}) error {
+	if s.limit > 0 && len(s.items) == s.limit {
+		return errors.New("sink is full")
+	}
+
+	s.items = append(s.items, item)
+	return nil
+}
+
+func (s *sliceSink) Finish() error {
+	s.finished = true
+	return nil
+}
+
+func TestInto(t *testing.T) {
+	sink := &sliceSink{}
+	want := []interface{}{1, 2, 3}
+
+	if err := From([]int{1, 2, 3}).Into(sink); err != nil {
+		t.Fatalf("Into()=%v expected nil", err)
+	}
+
+	if !reflect.DeepEqual(sink.items, want) || !sink.finished {
+		t.Errorf("Into() collected %v (finished=%v) expected %v (finished=true)", sink.items, sink.finished, want)
+	}
+}
+
+func TestInto_StopsWhenCollectFails(t *testing.T) {
+	sink := &sliceSink{limit: 2}
+	want := []interface{}{1, 2}
+
+	if err := From([]int{1, 2, 3}).Into(sink); err == nil || err.Error() != "sink is full" {
+		t.Fatalf("Into()=%v expected sink is full", err)
+	}
+
+	if !reflect.DeepEqual(sink.items, want) || sink.finished {
+		t.Errorf("Into() collected %v (finished=%v) expected %v (finished=false)", sink.items, sink.finished, want)
+	}
+}
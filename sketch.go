@@ -0,0 +1,169 @@
+package linq
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math"
+	"reflect"
+)
+
+// hashItem returns a 64-bit hash of an element for the probabilistic data
+// structures used by approximate methods. Elements that are equal with == have
+// equal hashes: values are hashed together with their type, floating-point
+// numbers by their bits with negative zero treated as zero, and pointers and
+// channels by address, recursing into arrays, structs and interfaces. Byte
+// slices are hashed by content, and other elements that cannot be compared
+// with ==, such as maps and other slices, by their Go syntax representation.
+func hashItem(item interface{}) uint64 {
+	h := fnv.New64a()
+	var buf [9]byte
+
+	switch v := item.(type) {
+	case string:
+		h.Write([]byte{1})
+		io.WriteString(h, v)
+	case []byte:
+		h.Write([]byte{2})
+		h.Write(v)
+	case int:
+		buf[0] = 3
+		binary.LittleEndian.PutUint64(buf[1:], uint64(v))
+		h.Write(buf[:])
+	case int64:
+		buf[0] = 4
+		binary.LittleEndian.PutUint64(buf[1:], uint64(v))
+		h.Write(buf[:])
+	case uint64:
+		buf[0] = 5
+		binary.LittleEndian.PutUint64(buf[1:], v)
+		h.Write(buf[:])
+	default:
+		if item == nil || !reflect.TypeOf(item).Comparable() {
+			fmt.Fprintf(h, "%T:%#v", item, item)
+			break
+		}
+
+		h.Write([]byte{6})
+		io.WriteString(h, reflect.TypeOf(item).String())
+		hashValue(h, reflect.ValueOf(item))
+	}
+
+	return mix64(h.Sum64())
+}
+
+// hashValue writes the value of a comparable v to h, so that values that are
+// equal with == write the same bytes.
+func hashValue(h hash.Hash64, v reflect.Value) {
+	var buf [8]byte
+	word := func(x uint64) {
+		binary.LittleEndian.PutUint64(buf[:], x)
+		h.Write(buf[:])
+	}
+	float := func(f float64) {
+		if f == 0 {
+			f = 0
+		}
+		word(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			word(1)
+		} else {
+			word(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		word(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		word(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		float(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		float(real(v.Complex()))
+		float(imag(v.Complex()))
+	case reflect.String:
+		word(uint64(v.Len()))
+		io.WriteString(h, v.String())
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		word(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			hashValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			hashValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			word(0)
+			break
+		}
+
+		io.WriteString(h, v.Elem().Type().String())
+		hashValue(h, v.Elem())
+	}
+}
+
+// mix64 scrambles the bits of x with the finalizer of SplitMix64, so that all
+// bits of the result depend on all bits of x.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// bloomFilter is a set of hashes that can report false positives but no false
+// negatives.
+type bloomFilter struct {
+	bits   []uint64
+	m      uint64
+	hashes int
+}
+
+// newBloomFilter returns an empty Bloom filter sized for n elements with a
+// false-positive rate of p, where n is positive and p is between 0 and 1.
+func newBloomFilter(n int, p float64) *bloomFilter {
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	if m < 64 {
+		m = 64
+	}
+
+	hashes := int(math.Round(m / float64(n) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+
+	words := (uint64(m) + 63) / 64
+	return &bloomFilter{bits: make([]uint64, words), m: words * 64, hashes: hashes}
+}
+
+// add adds a hash returned by hashItem to the filter.
+func (f *bloomFilter) add(hash uint64) {
+	h1, h2 := hash, mix64(hash^0x9e3779b97f4a7c15)|1
+	for i := 0; i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// contains reports whether a hash returned by hashItem may have been added to
+// the filter.
+func (f *bloomFilter) contains(hash uint64) bool {
+	h1, h2 := hash, mix64(hash^0x9e3779b97f4a7c15)|1
+	for i := 0; i < f.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,72 @@
+package linq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHashItem(t *testing.T) {
+	shared := 1
+
+	equal := [][2]interface{}{
+		{"go", "go"},
+		{[]byte("go"), []byte("go")},
+		{42, 42},
+		{int64(42), int64(42)},
+		{uint64(42), uint64(42)},
+		{3.5, 3.5},
+		{foo{f1: 1}, foo{f1: 1}},
+		{nil, nil},
+		{0.0, math.Copysign(0, -1)},
+		{float32(0), float32(math.Copysign(0, -1))},
+		{[2]interface{}{1, 0.0}, [2]interface{}{1, math.Copysign(0, -1)}},
+		{&shared, &shared},
+	}
+
+	for _, test := range equal {
+		if hashItem(test[0]) != hashItem(test[1]) {
+			t.Errorf("hashItem(%v) != hashItem(%v) expected equal hashes", test[0], test[1])
+		}
+	}
+
+	different := [][2]interface{}{
+		{"go", "og"},
+		{"42", 42},
+		{42, int64(42)},
+		{[]byte("go"), "go"},
+		{1, 2},
+		{new(int), new(int)},
+		{foo{f1: 1}, foo{f1: 2}},
+		{[2]interface{}{1, "a"}, [2]interface{}{1, 'a'}},
+	}
+
+	for _, test := range different {
+		if hashItem(test[0]) == hashItem(test[1]) {
+			t.Errorf("hashItem(%v) == hashItem(%v) expected different hashes", test[0], test[1])
+		}
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	f := newBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.add(hashItem(i))
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !f.contains(hashItem(i)) {
+			t.Fatalf("bloomFilter.contains(%d)=false expected true", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.contains(hashItem(i)) {
+			falsePositives++
+		}
+	}
+
+	if falsePositives > 200 {
+		t.Errorf("bloomFilter false positives=%d of 10000 expected about 100", falsePositives)
+	}
+}
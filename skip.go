@@ -1,9 +1,32 @@
 package linq
 
+import "strconv"
+
 // Skip bypasses a specified number of elements in a collection and then returns
 // the remaining elements.
+//
+// If the collection supports random access, such as a query created from a
+// slice, array or string, the bypassed elements are not iterated over.
 func (q Query) Skip(count int) Query {
+	desc := q.chain("Skip(" + strconv.Itoa(count) + ")")
+
+	if q.index != nil {
+		if count < 0 {
+			count = 0
+		}
+
+		n := q.length() - count
+		if n < 0 {
+			n = 0
+		}
+
+		return fromIndex(n, func(i int) interface{} {
+			return q.index(count + i)
+		}).describe(desc).elementsOf(q)
+	}
+
 	return Query{
+		desc: desc,
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			n := count
@@ -19,7 +42,7 @@ func (q Query) Skip(count int) Query {
 				return next()
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // SkipWhile bypasses elements in a collection as long as a specified condition
@@ -31,6 +54,7 @@ func (q Query) Skip(count int) Query {
 // there are no more invocations of predicate.
 func (q Query) SkipWhile(predicate func(interface{}) bool) Query {
 	return Query{
+		desc: q.chain("SkipWhile"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			ready := false
@@ -51,7 +75,7 @@ func (q Query) SkipWhile(predicate func(interface{}) bool) Query {
 				return next()
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // SkipWhileT is the typed version of SkipWhile.
@@ -73,7 +97,7 @@ func (q Query) SkipWhileT(predicateFn interface{}) Query {
 		return predicateGenericFunc.Call(item).(bool)
 	}
 
-	return q.SkipWhile(predicateFunc)
+	return q.SkipWhile(predicateFunc).filteredBy(q, predicateGenericFunc, 0)
 }
 
 // SkipWhileIndexed bypasses elements in a collection as long as a specified
@@ -86,6 +110,7 @@ func (q Query) SkipWhileT(predicateFn interface{}) Query {
 // there are no more invocations of predicate.
 func (q Query) SkipWhileIndexed(predicate func(int, interface{}) bool) Query {
 	return Query{
+		desc: q.chain("SkipWhileIndexed"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			ready := false
@@ -109,7 +134,7 @@ func (q Query) SkipWhileIndexed(predicate func(int, interface{}) bool) Query {
 				return next()
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // SkipWhileIndexedT is the typed version of SkipWhileIndexed.
@@ -130,5 +155,5 @@ func (q Query) SkipWhileIndexedT(predicateFn interface{}) Query {
 		return predicateGenericFunc.Call(index, item).(bool)
 	}
 
-	return q.SkipWhileIndexed(predicateFunc)
+	return q.SkipWhileIndexed(predicateFunc).filteredBy(q, predicateGenericFunc, 1)
 }
@@ -85,3 +85,23 @@ func TestSkipWhileIndexedT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).SkipWhileIndexedT(func(item int, x int, y int) bool { return item == 1 })
 	})
 }
+
+func TestSkipForRandomAccess(t *testing.T) {
+	tests := []struct {
+		input  Query
+		count  int
+		output []interface{}
+	}{
+		{From([]int{1, 2, 3, 4}), 1, []interface{}{2, 3, 4}},
+		{From([]int{1, 2, 3, 4}), -1, []interface{}{1, 2, 3, 4}},
+		{From([]int{1, 2, 3, 4}), 10, []interface{}{}},
+		{FromString("abc").Skip(1), 1, []interface{}{'c'}},
+	}
+
+	for _, test := range tests {
+		q := test.input.Skip(test.count)
+		if q.index == nil || q.Count() != len(test.output) || !validateQuery(q, test.output) {
+			t.Errorf("Skip(%v)=%v expected %v", test.count, toSlice(q), test.output)
+		}
+	}
+}
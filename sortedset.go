@@ -0,0 +1,102 @@
+package linq
+
+// DistinctSorted returns distinct elements from a collection whose equal
+// elements are adjacent, such as a sorted collection, by dropping the elements
+// equal to the element before them. Unlike Distinct, it doesn't keep the
+// elements seen so far in memory, so it can deduplicate sorted files or
+// indexes of any size.
+//
+// Elements are compared like in SequenceEqual, so they don't have to be
+// comparable.
+func (q Query) DistinctSorted() Query {
+	return Query{
+		desc: q.chain("DistinctSorted"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var prev interface{}
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if !started || !equalItems(item, prev) {
+						started, prev = true, item
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// ExceptSorted produces the set difference of two collections sorted in
+// ascending order, as determined by less: the distinct elements of the source
+// collection that don't appear in q2, in ascending order.
+//
+// Unlike Except, it merges the two collections as they are iterated instead of
+// building a set from q2, using constant memory. If less is nil, elements are
+// compared like in OrderBy, so they have to be of a basic type or implement
+// Comparable interface. If a collection is not sorted, the result is
+// unspecified.
+func (q Query) ExceptSorted(q2 Query, less func(interface{}, interface{}) bool) Query {
+	return q.mergeSorted(q2, less, false).describe(q.chain("ExceptSorted"))
+}
+
+// IntersectSorted produces the set intersection of two collections sorted in
+// ascending order, as determined by less: the distinct elements of the source
+// collection that also appear in q2, in ascending order.
+//
+// Unlike Intersect, it merges the two collections as they are iterated
+// instead of building a set from q2, using constant memory. If less is nil,
+// elements are compared like in OrderBy, so they have to be of a basic type or
+// implement Comparable interface. If a collection is not sorted, the result is
+// unspecified.
+func (q Query) IntersectSorted(q2 Query, less func(interface{}, interface{}) bool) Query {
+	return q.mergeSorted(q2, less, true).describe(q.chain("IntersectSorted"))
+}
+
+// mergeSorted returns the distinct elements of q that appear in q2 if common
+// is true, or that don't appear in q2 otherwise, merging the two sorted
+// collections.
+func (q Query) mergeSorted(q2 Query, less func(interface{}, interface{}) bool, common bool) Query {
+	return Query{
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var next2 Iterator
+			var item2, prev interface{}
+			has2, started := false, false
+			lessFunc := less
+
+			return func() (item interface{}, ok bool) {
+				for item, ok = next(); ok; item, ok = next() {
+					if lessFunc == nil {
+						compare := q.comparer(item)
+						lessFunc = func(a, b interface{}) bool { return compare(a, b) < 0 }
+					}
+
+					if started && !lessFunc(prev, item) {
+						continue
+					}
+
+					started, prev = true, item
+
+					if next2 == nil {
+						next2 = q2.Iterate()
+						item2, has2 = next2()
+					}
+
+					for has2 && lessFunc(item2, item) {
+						item2, has2 = next2()
+					}
+
+					if found := has2 && !lessFunc(item, item2); found == common {
+						return
+					}
+				}
+
+				return
+			}
+		},
+	}
+}
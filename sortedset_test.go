@@ -0,0 +1,72 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistinctSorted(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 1, 2, 3, 3, 3, 4}, []interface{}{1, 2, 3, 4}},
+		{[]interface{}{nil, nil, 1}, []interface{}{nil, 1}},
+		{[]string{"a", "b", "a"}, []interface{}{"a", "b", "a"}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).DistinctSorted(); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).DistinctSorted()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestDistinctSortedWithUncomparableElements(t *testing.T) {
+	input := [][]int{{1}, {1}, {2}, {2}, {1}}
+	want := []interface{}{[]int{1}, []int{2}, []int{1}}
+
+	if got := toSlice(From(input).DistinctSorted()); !reflect.DeepEqual(got, want) {
+		t.Errorf("From(%v).DistinctSorted()=%v expected %v", input, got, want)
+	}
+}
+
+func TestExceptSorted(t *testing.T) {
+	tests := []struct {
+		input1, input2 interface{}
+		output         []interface{}
+	}{
+		{[]int{1, 2, 2, 3, 5, 7, 8}, []int{2, 4, 5, 5, 9}, []interface{}{1, 3, 7, 8}},
+		{[]int{1, 2}, []int{}, []interface{}{1, 2}},
+		{[]int{}, []int{1}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input1).ExceptSorted(From(test.input2), nil); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).ExceptSorted(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.output)
+		}
+	}
+
+	desc := func(a, b interface{}) bool { return a.(int) > b.(int) }
+	if q := From([]int{5, 4, 3}).ExceptSorted(From([]int{4}), desc); !validateQuery(q, []interface{}{5, 3}) {
+		t.Errorf("ExceptSorted() descending=%v expected [5 3]", toSlice(q))
+	}
+}
+
+func TestIntersectSorted(t *testing.T) {
+	tests := []struct {
+		input1, input2 interface{}
+		output         []interface{}
+	}{
+		{[]int{1, 2, 2, 3, 5, 7, 8}, []int{2, 4, 5, 5, 8, 9}, []interface{}{2, 5, 8}},
+		{[]string{"a", "c"}, []string{"b", "c", "d"}, []interface{}{"c"}},
+		{[]int{1, 2}, []int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input1).IntersectSorted(From(test.input2), nil); !validateQuery(q, test.output) {
+			t.Errorf("From(%v).IntersectSorted(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.output)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package linq
+
+// SortableItems is a sort.Interface over the elements of a collection
+// materialized by SortInterface. Once sorted, with sort.Sort, sort.Stable or any
+// other function that accepts a sort.Interface, the elements are available in
+// Items.
+type SortableItems struct {
+	Items []interface{}
+	less  func(a, b interface{}) bool
+}
+
+// Len implements sort.Interface.
+func (s *SortableItems) Len() int {
+	return len(s.Items)
+}
+
+// Less implements sort.Interface.
+func (s *SortableItems) Less(i, j int) bool {
+	return s.less(s.Items[i], s.Items[j])
+}
+
+// Swap implements sort.Interface.
+func (s *SortableItems) Swap(i, j int) {
+	s.Items[i], s.Items[j] = s.Items[j], s.Items[i]
+}
+
+// Query returns a query of the elements in their current order.
+func (s *SortableItems) Query() Query {
+	return From(s.Items)
+}
+
+// SortInterface iterates over a collection and returns its elements in a
+// buffer that implements sort.Interface with the order defined by less, so
+// they can be passed to the sorting functions of the standard library or to
+// existing code that sorts a sort.Interface. If less is nil, the elements are
+// compared with Compare.
+func (q Query) SortInterface(less func(a, b interface{}) bool) *SortableItems {
+	if less == nil {
+		less = func(a, b interface{}) bool { return Compare(a, b) < 0 }
+	}
+
+	return &SortableItems{Items: q.Results(), less: less}
+}
+
+// Compare compares two elements of a basic type, time.Time, time.Duration or a
+// type implementing Comparable, the same way as OrderBy and Min do. It returns
+// -1 if a is less than b, 0 if they are equal and 1 if a is greater than b.
+// Both elements have to be of the same type.
+//
+// Compare can be used as the comparison function of slices.SortFunc and
+// slices.BinarySearchFunc to sort and search the results of a query, e.g.
+//
+//	items := q.Results()
+//	slices.SortFunc(items, linq.Compare)
+func Compare(a, b interface{}) int {
+	return getComparer(a)(a, b)
+}
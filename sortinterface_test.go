@@ -0,0 +1,56 @@
+package linq
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSortInterface(t *testing.T) {
+	s := From([]string{"ccc", "a", "bb"}).SortInterface(func(a, b interface{}) bool {
+		return len(a.(string)) < len(b.(string))
+	})
+
+	if s.Len() != 3 {
+		t.Errorf("SortInterface().Len()=%d expected 3", s.Len())
+	}
+
+	sort.Sort(s)
+	if w := []interface{}{"a", "bb", "ccc"}; !validateQuery(s.Query(), w) {
+		t.Errorf("sort.Sort(SortInterface())=%v expected %v", s.Items, w)
+	}
+
+	s = Range(1, 5).SortInterface(nil)
+	sort.Sort(sort.Reverse(s))
+	if w := []interface{}{5, 4, 3, 2, 1}; !validateQuery(s.Query(), w) {
+		t.Errorf("sort.Sort(sort.Reverse(SortInterface(nil)))=%v expected %v", s.Items, w)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		a, b interface{}
+		want int
+	}{
+		{1, 2, -1},
+		{"b", "a", 1},
+		{2.5, 2.5, 0},
+		{now, now.Add(time.Second), -1},
+		{time.Minute, time.Second, 1},
+		{foo{f1: 1}, foo{f1: 1}, 0},
+	}
+
+	for _, test := range tests {
+		if got := Compare(test.a, test.b); got != test.want {
+			t.Errorf("Compare(%v, %v)=%d expected %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	items := From([]int{3, 1, 2}).Results()
+	sort.Slice(items, func(i, j int) bool { return Compare(items[i], items[j]) < 0 })
+	if w := []interface{}{1, 2, 3}; !validateQuery(From(items), w) {
+		t.Errorf("sort.Slice() with Compare=%v expected %v", items, w)
+	}
+}
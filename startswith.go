@@ -0,0 +1,94 @@
+package linq
+
+// StartsWith determines whether a collection starts with the elements of
+// prefix, in the same order. Only as many elements of the collection as prefix
+// contains are iterated over. Every collection starts with an empty prefix.
+//
+// Elements are compared like in SequenceEqual.
+func (q Query) StartsWith(prefix Query) bool {
+	return q.StartsWithFunc(prefix, equalItems)
+}
+
+// StartsWithFunc determines whether a collection starts with the elements of
+// prefix, in the same order, comparing the elements with function equal. The
+// first argument of equal is an element of the collection and the second one
+// an element of prefix.
+func (q Query) StartsWithFunc(prefix Query, equal func(interface{}, interface{}) bool) bool {
+	if q.length != nil && prefix.length != nil && q.length() < prefix.length() {
+		return false
+	}
+
+	next := q.Iterate()
+	nextPrefix := prefix.Iterate()
+
+	for item2, ok2 := nextPrefix(); ok2; item2, ok2 = nextPrefix() {
+		item, ok := next()
+		if !ok || !equal(item, item2) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EndsWith determines whether a collection ends with the elements of suffix,
+// in the same order. Every collection ends with an empty suffix.
+//
+// Elements are compared like in SequenceEqual.
+func (q Query) EndsWith(suffix Query) bool {
+	return q.EndsWithFunc(suffix, equalItems)
+}
+
+// EndsWithFunc determines whether a collection ends with the elements of
+// suffix, in the same order, comparing the elements with function equal. The
+// first argument of equal is an element of the collection and the second one
+// an element of suffix.
+//
+// The elements of suffix are buffered. If the collection supports random
+// access, such as a query created from a slice, only its last elements are
+// read, otherwise it is iterated over while keeping only as many of its last
+// elements as suffix contains.
+func (q Query) EndsWithFunc(suffix Query, equal func(interface{}, interface{}) bool) bool {
+	want := suffix.Results()
+	m := len(want)
+
+	if q.index != nil {
+		n := q.length()
+		if n < m {
+			return false
+		}
+
+		for i, item2 := range want {
+			if !equal(q.index(n-m+i), item2) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if m == 0 {
+		return true
+	}
+
+	last := make([]interface{}, m)
+	count := 0
+
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		last[count%m] = item
+		count++
+	}
+
+	if count < m {
+		return false
+	}
+
+	for i, item2 := range want {
+		if !equal(last[(count+i)%m], item2) {
+			return false
+		}
+	}
+
+	return true
+}
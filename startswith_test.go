@@ -0,0 +1,80 @@
+package linq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStartsWith(t *testing.T) {
+	where := func(interface{}) bool { return true }
+
+	tests := []struct {
+		input  Query
+		prefix Query
+		want   bool
+	}{
+		{Range(1, 5), Range(1, 3), true},
+		{Range(1, 5), Range(1, 5), true},
+		{Range(1, 5), Range(1, 6), false},
+		{Range(1, 5).Where(where), Range(1, 6).Where(where), false},
+		{Range(1, 5), Range(2, 3), false},
+		{Range(1, 5), Empty(), true},
+		{Empty(), Empty(), true},
+		{Empty(), Range(1, 1), false},
+	}
+
+	for _, test := range tests {
+		if r := test.input.StartsWith(test.prefix); r != test.want {
+			t.Errorf("%v.StartsWith(%v)=%v expected %v", test.input, test.prefix, r, test.want)
+		}
+	}
+
+	taken := 0
+	Range(1, 100).Select(func(i interface{}) interface{} {
+		taken++
+		return i
+	}).StartsWith(Range(1, 2))
+
+	if taken != 2 {
+		t.Errorf("StartsWith() iterated over %d elements expected 2", taken)
+	}
+
+	if !FromString("GoLang").StartsWithFunc(FromString("go"), func(a, b interface{}) bool {
+		return strings.EqualFold(string(a.(rune)), string(b.(rune)))
+	}) {
+		t.Errorf("FromString(GoLang).StartsWithFunc(go)=false expected true")
+	}
+}
+
+func TestEndsWith(t *testing.T) {
+	where := func(interface{}) bool { return true }
+
+	tests := []struct {
+		input  Query
+		suffix Query
+		want   bool
+	}{
+		{Range(1, 5), Range(3, 3), true},
+		{Range(1, 5).Where(where), Range(3, 3), true},
+		{Range(1, 5).Where(where), Range(1, 5), true},
+		{Range(1, 5).Where(where), Range(0, 6), false},
+		{Range(1, 5), Range(0, 6), false},
+		{Range(1, 5).Where(where), Range(2, 3), false},
+		{Range(1, 5), Range(2, 3), false},
+		{Range(1, 5).Where(where), Empty(), true},
+		{From([]int{1, 2, 3}), Empty(), true},
+		{Empty(), Range(1, 1), false},
+	}
+
+	for _, test := range tests {
+		if r := test.input.EndsWith(test.suffix); r != test.want {
+			t.Errorf("%v.EndsWith(%v)=%v expected %v", test.input, test.suffix, r, test.want)
+		}
+	}
+
+	if !From([]string{"a", "B", "C"}).Where(where).EndsWithFunc(From([]string{"b", "c"}), func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}) {
+		t.Errorf("From([a B C]).EndsWithFunc([b c])=false expected true")
+	}
+}
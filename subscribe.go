@@ -0,0 +1,128 @@
+package linq
+
+import (
+	"context"
+	"sync"
+)
+
+// Subscription is returned by Subscribe method and represents an iteration
+// running in its own goroutine.
+type Subscription struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   *sync.Once
+	closed chan struct{}
+
+	// mu orders Unsubscribe with the decision of the goroutine to call a
+	// handler.
+	mu *sync.Mutex
+}
+
+// Unsubscribe stops the iteration without calling any further handler, except
+// one that has already been started. Unsubscribe can be called more than once.
+func (s Subscription) Unsubscribe() {
+	s.mu.Lock()
+	s.once.Do(func() { close(s.closed) })
+	s.mu.Unlock()
+	s.cancel()
+}
+
+// Done returns a channel that is closed when the iteration has stopped,
+// either because the collection ended or failed, or because the subscription
+// was cancelled.
+func (s Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Subscribe iterates over a collection in a new goroutine and pushes its
+// elements to onNext. When the collection ends, onComplete is called. If
+// iterating over the collection panics with an error, as the sources reading
+// from an io.Reader do, onError is called with the error. If ctx is cancelled
+// before the collection ends, onError is called with the error of ctx. Any of
+// the handlers can be nil.
+//
+// The iteration stops when ctx is cancelled or Unsubscribe is called, which is
+// checked before each element is requested from the collection; a collection
+// blocked waiting for its next element, such as a channel, stops once that
+// element arrives. Runtime panics and panics with values that are not errors
+// are not recovered.
+func (q Query) Subscribe(ctx context.Context, onNext func(interface{}),
+	onError func(error), onComplete func()) Subscription {
+	ctx, cancel := context.WithCancel(ctx)
+	s := Subscription{
+		cancel: cancel,
+		done:   make(chan struct{}),
+		once:   new(sync.Once),
+		closed: make(chan struct{}),
+		mu:     new(sync.Mutex),
+	}
+
+	unsubscribed := func() bool {
+		select {
+		case <-s.closed:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// begin reports whether a handler can be called, under the lock taken by
+	// Unsubscribe, so that no handler is started once Unsubscribe has
+	// returned, even if the cancellation of ctx is observed first.
+	begin := func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return !unsubscribed()
+	}
+
+	go func() {
+		defer close(s.done)
+		defer cancel()
+
+		next := q.Iterate()
+		for {
+			if unsubscribed() {
+				return
+			}
+
+			if err := ctx.Err(); err != nil {
+				if onError != nil && begin() {
+					onError(err)
+				}
+
+				return
+			}
+
+			item, ok, err := tryNext(next)
+			if unsubscribed() {
+				return
+			}
+
+			switch {
+			case err != nil:
+				if onError != nil && begin() {
+					onError(err)
+				}
+
+				return
+			case !ok:
+				if onComplete != nil && begin() {
+					onComplete()
+				}
+
+				return
+			}
+
+			if onNext != nil {
+				if !begin() {
+					return
+				}
+
+				onNext(item)
+			}
+		}
+	}()
+
+	return s
+}
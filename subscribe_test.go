@@ -0,0 +1,92 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	var items []interface{}
+	completed := false
+
+	s := Range(1, 3).Subscribe(context.Background(),
+		func(item interface{}) { items = append(items, item) },
+		func(err error) { t.Errorf("Subscribe() onError(%v) expected no error", err) },
+		func() { completed = true })
+	<-s.Done()
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(From(items), w) || !completed {
+		t.Errorf("Subscribe()=%v, completed=%v expected %v, true", items, completed, w)
+	}
+}
+
+func TestSubscribeWithError(t *testing.T) {
+	var got error
+	s := Range(1, 3).Select(func(interface{}) interface{} {
+		panic(errors.New("read failed"))
+	}).Subscribe(context.Background(), nil, func(err error) { got = err }, nil)
+	<-s.Done()
+
+	if got == nil || got.Error() != "read failed" {
+		t.Errorf("Subscribe() onError(%v) expected read failed", got)
+	}
+}
+
+func TestSubscribeCancel(t *testing.T) {
+	ch := make(chan interface{}, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var got error
+	received := make(chan struct{}, 2)
+	s := FromChannel(ch).Subscribe(ctx,
+		func(interface{}) { received <- struct{}{} },
+		func(err error) { got = err },
+		func() { t.Errorf("Subscribe() onComplete called after cancellation") })
+
+	ch <- 1
+	<-received
+	cancel()
+	ch <- 2
+	<-s.Done()
+
+	if got != context.Canceled {
+		t.Errorf("Subscribe() onError(%v) expected %v", got, context.Canceled)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	ch := make(chan interface{}, 1)
+	received := make(chan struct{}, 2)
+	s := FromChannel(ch).Subscribe(context.Background(),
+		func(interface{}) { received <- struct{}{} },
+		func(err error) { t.Errorf("Subscribe() onError(%v) called after Unsubscribe", err) },
+		func() { t.Errorf("Subscribe() onComplete called after Unsubscribe") })
+
+	ch <- 1
+	<-received
+	s.Unsubscribe()
+	s.Unsubscribe()
+	ch <- 2
+	<-s.Done()
+}
+
+func TestUnsubscribeDoesNotReportCancellation(t *testing.T) {
+	// Unsubscribe cancels the context of the iteration, which must not be
+	// reported to onError, however the goroutine interleaves with it.
+	for i := 0; i < 100; i++ {
+		var reported int32
+		s := Generate(0, func(i interface{}) interface{} { return i.(int) + 1 }).Subscribe(context.Background(),
+			nil,
+			func(error) { atomic.StoreInt32(&reported, 1) },
+			nil)
+
+		s.Unsubscribe()
+		<-s.Done()
+
+		if atomic.LoadInt32(&reported) != 0 {
+			t.Fatalf("Subscribe() called onError after Unsubscribe")
+		}
+	}
+}
@@ -1,29 +1,59 @@
 package linq
 
+import "strconv"
+
 // Take returns a specified number of contiguous elements from the start of a
 // collection.
+//
+// If the collection supports random access, such as a query created from a
+// slice, array or string, the result supports it too, so Skip and Take can be
+// chained to page over a large slice without iterating over the preceding
+// pages.
 func (q Query) Take(count int) Query {
+	desc := q.chain("Take(" + strconv.Itoa(count) + ")")
+
+	if q.index != nil {
+		n := q.length()
+		if count < n {
+			n = count
+		}
+
+		return fromIndex(knownLength(n), q.index).describe(desc).elementsOf(q)
+	}
+
+	enumerate := func() Enumerator {
+		return &takeEnumerator{q.enumerator(), count}
+	}
+
 	return Query{
+		desc:      desc,
+		enumerate: enumerate,
 		Iterate: func() Iterator {
-			next := q.Iterate()
-			n := count
+			return enumerate().Next
+		},
+	}.elementsOf(q)
+}
 
-			return func() (item interface{}, ok bool) {
-				if n <= 0 {
-					return
-				}
+// takeEnumerator returns the first n elements of source.
+type takeEnumerator struct {
+	source Enumerator
+	n      int
+}
 
-				n--
-				return next()
-			}
-		},
+func (e *takeEnumerator) Next() (item interface{}, ok bool) {
+	if e.n <= 0 {
+		return
 	}
+
+	e.n--
+	return e.source.Next()
 }
 
 // TakeWhile returns elements from a collection as long as a specified condition
 // is true, and then skips the remaining elements.
 func (q Query) TakeWhile(predicate func(interface{}) bool) Query {
 	return Query{
+		desc: q.chain("TakeWhile"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			done := false
@@ -47,7 +77,7 @@ func (q Query) TakeWhile(predicate func(interface{}) bool) Query {
 				return nil, false
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // TakeWhileT is the typed version of TakeWhile.
@@ -69,7 +99,7 @@ func (q Query) TakeWhileT(predicateFn interface{}) Query {
 		return predicateGenericFunc.Call(item).(bool)
 	}
 
-	return q.TakeWhile(predicateFunc)
+	return q.TakeWhile(predicateFunc).filteredBy(q, predicateGenericFunc, 0)
 }
 
 // TakeWhileIndexed returns elements from a collection as long as a specified
@@ -79,6 +109,7 @@ func (q Query) TakeWhileT(predicateFn interface{}) Query {
 // test.
 func (q Query) TakeWhileIndexed(predicate func(int, interface{}) bool) Query {
 	return Query{
+		desc: q.chain("TakeWhileIndexed"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			done := false
@@ -104,7 +135,7 @@ func (q Query) TakeWhileIndexed(predicate func(int, interface{}) bool) Query {
 				return nil, false
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // TakeWhileIndexedT is the typed version of TakeWhileIndexed.
@@ -125,5 +156,5 @@ func (q Query) TakeWhileIndexedT(predicateFn interface{}) Query {
 		return whereFunc.Call(index, item).(bool)
 	}
 
-	return q.TakeWhileIndexed(predicateFunc)
+	return q.TakeWhileIndexed(predicateFunc).filteredBy(q, whereFunc, 1)
 }
@@ -78,3 +78,32 @@ func TestTakeWhileIndexedT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).TakeWhileIndexedT(func(item int) int { return item + 2 })
 	})
 }
+
+func TestTakeForRandomAccess(t *testing.T) {
+	tests := []struct {
+		input  Query
+		count  int
+		output []interface{}
+	}{
+		{From([]int{1, 2, 3, 4}), 2, []interface{}{1, 2}},
+		{From([]int{1, 2, 3, 4}), -1, []interface{}{}},
+		{From([]int{1, 2, 3, 4}), 10, []interface{}{1, 2, 3, 4}},
+	}
+
+	for _, test := range tests {
+		q := test.input.Take(test.count)
+		if q.Count() != len(test.output) || !validateQuery(q, test.output) {
+			t.Errorf("Take(%v)=%v expected %v", test.count, toSlice(q), test.output)
+		}
+	}
+
+	page := make([]int, 1000)
+	for i := range page {
+		page[i] = i
+	}
+
+	want := []interface{}{500, 501, 502}
+	if q := From(page).Skip(500).Take(3); q.index == nil || !validateQuery(q, want) {
+		t.Errorf("From(page).Skip(500).Take(3)=%v expected %v", toSlice(q), want)
+	}
+}
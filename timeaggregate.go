@@ -0,0 +1,59 @@
+package linq
+
+import "time"
+
+// AverageDuration computes the average of a collection of time.Duration
+// values, rounded toward zero to the nanosecond. Like in SumDurations, the sum
+// of the values has to fit in a time.Duration. Method returns zero if
+// collection contains no elements.
+func (q Query) AverageDuration() time.Duration {
+	next := q.Iterate()
+	var sum time.Duration
+	n := 0
+
+	for item, ok := next(); ok; item, ok = next() {
+		sum += item.(time.Duration)
+		n++
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	return sum / time.Duration(n)
+}
+
+// MaxTime returns the latest of a collection of time.Time values, as
+// determined by Time.After, so values with different locations are compared
+// by the instant they represent. Method returns the zero time if collection
+// contains no elements.
+func (q Query) MaxTime() time.Time {
+	return q.extremeTime(func(t, r time.Time) bool { return t.After(r) })
+}
+
+// MinTime returns the earliest of a collection of time.Time values, as
+// determined by Time.Before, so values with different locations are compared
+// by the instant they represent. Method returns the zero time if collection
+// contains no elements.
+func (q Query) MinTime() time.Time {
+	return q.extremeTime(func(t, r time.Time) bool { return t.Before(r) })
+}
+
+// extremeTime returns the first value of q for which better returns true when
+// compared with every other value.
+func (q Query) extremeTime(better func(t, r time.Time) bool) (r time.Time) {
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return
+	}
+
+	r = item.(time.Time)
+	for item, ok = next(); ok; item, ok = next() {
+		if t := item.(time.Time); better(t, r) {
+			r = t
+		}
+	}
+
+	return
+}
@@ -0,0 +1,40 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAverageDuration(t *testing.T) {
+	input := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}
+
+	if r := From(input).AverageDuration(); r != 2333333333*time.Nanosecond {
+		t.Errorf("From(%v).AverageDuration()=%v expected 2.333333333s", input, r)
+	}
+
+	if r := From([]time.Duration{}).AverageDuration(); r != 0 {
+		t.Errorf("AverageDuration()=%v expected 0", r)
+	}
+}
+
+func TestMinMaxTime(t *testing.T) {
+	base := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*3600)
+	input := []time.Time{base, base.Add(-time.Hour).In(tokyo), base.Add(time.Hour)}
+
+	if r := From(input).MinTime(); !r.Equal(base.Add(-time.Hour)) || r.Location() != tokyo {
+		t.Errorf("From(%v).MinTime()=%v expected %v", input, r, input[1])
+	}
+
+	if r := From(input).MaxTime(); !r.Equal(base.Add(time.Hour)) {
+		t.Errorf("From(%v).MaxTime()=%v expected %v", input, r, input[2])
+	}
+
+	if r := Empty().MinTime(); !r.IsZero() {
+		t.Errorf("Empty().MinTime()=%v expected the zero time", r)
+	}
+
+	if r := Empty().MaxTime(); !r.IsZero() {
+		t.Errorf("Empty().MaxTime()=%v expected the zero time", r)
+	}
+}
@@ -0,0 +1,58 @@
+package linq
+
+import "time"
+
+// Timestamped is a type that is used to store the result of Timestamp method.
+type Timestamped struct {
+	Value interface{}
+	Time  time.Time
+}
+
+// TimeIntervaled is a type that is used to store the result of TimeInterval
+// method.
+type TimeIntervaled struct {
+	Value    interface{}
+	Interval time.Duration
+}
+
+// Timestamp wraps each element of a collection into a Timestamped value that
+// records the wall-clock time at which the element was produced.
+func (q Query) Timestamp() Query {
+	return Query{
+		desc: q.chain("Timestamp"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				if item, ok = next(); ok {
+					item = Timestamped{Value: item, Time: time.Now()}
+				}
+
+				return
+			}
+		},
+	}
+}
+
+// TimeInterval wraps each element of a collection into a TimeIntervaled value
+// that records the time elapsed since the previous element was produced. For
+// the first element, it is the time elapsed since the iteration started.
+func (q Query) TimeInterval() Query {
+	return Query{
+		desc: q.chain("TimeInterval"),
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			last := time.Now()
+
+			return func() (item interface{}, ok bool) {
+				if item, ok = next(); ok {
+					now := time.Now()
+					item = TimeIntervaled{Value: item, Interval: now.Sub(last)}
+					last = now
+				}
+
+				return
+			}
+		},
+	}
+}
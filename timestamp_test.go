@@ -0,0 +1,50 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	before := time.Now()
+	items := Range(1, 3).Timestamp().Results()
+	after := time.Now()
+
+	if len(items) != 3 {
+		t.Fatalf("Timestamp() returned %d elements expected 3", len(items))
+	}
+
+	prev := before
+	for i, item := range items {
+		ts := item.(Timestamped)
+		if ts.Value != i+1 || ts.Time.Before(prev) || ts.Time.After(after) {
+			t.Errorf("Timestamp()[%d]=%v expected value %d between %v and %v", i, ts, i+1, prev, after)
+		}
+
+		prev = ts.Time
+	}
+}
+
+func TestTimeInterval(t *testing.T) {
+	const d = 20 * time.Millisecond
+	slow := Range(1, 2).Select(func(i interface{}) interface{} {
+		if i.(int) == 2 {
+			time.Sleep(d)
+		}
+
+		return i
+	})
+
+	items := slow.TimeInterval().Results()
+	if len(items) != 2 {
+		t.Fatalf("TimeInterval() returned %d elements expected 2", len(items))
+	}
+
+	if ti := items[0].(TimeIntervaled); ti.Value != 1 || ti.Interval >= d {
+		t.Errorf("TimeInterval()[0]=%v expected value 1 with interval below %v", ti, d)
+	}
+
+	if ti := items[1].(TimeIntervaled); ti.Value != 2 || ti.Interval < d {
+		t.Errorf("TimeInterval()[1]=%v expected value 2 with interval of at least %v", ti, d)
+	}
+}
@@ -0,0 +1,99 @@
+package linq
+
+import "errors"
+
+// OverflowPolicy specifies what ToChannelWithOverflow does with an element
+// when the channel is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until the element can be sent, like ToChannel.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest receives and discards the oldest element buffered in
+	// the channel to make room for the element.
+	OverflowDropOldest
+	// OverflowDropNewest discards the element.
+	OverflowDropNewest
+	// OverflowError stops iterating and returns ErrChannelFull.
+	OverflowError
+)
+
+// ToChannelWithOverflow iterates over a collection and outputs each element to
+// a channel, then closes it. Unlike ToChannel, it doesn't necessarily wait for
+// a slow consumer: policy specifies what happens to an element when the
+// channel is full. The channel is closed even if an error is returned.
+//
+// The channel has to be bidirectional, because the OverflowDropOldest policy
+// receives from it. Unbuffered channels are full unless a consumer is waiting;
+// as they buffer nothing that could be dropped, OverflowDropOldest discards the
+// element for them.
+func (q Query) ToChannelWithOverflow(result chan interface{}, policy OverflowPolicy) error {
+	defer close(result)
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if err := sendWithOverflow(result, item, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendWithOverflow sends item to result, applying policy if result is full.
+func sendWithOverflow(result chan interface{}, item interface{}, policy OverflowPolicy) error {
+	if policy == OverflowBlock {
+		result <- item
+		return nil
+	}
+
+	for {
+		select {
+		case result <- item:
+			return nil
+		default:
+		}
+
+		switch {
+		case policy == OverflowDropOldest && cap(result) > 0:
+			select {
+			case <-result:
+			default:
+			}
+		case policy == OverflowError:
+			return ErrChannelFull
+		default:
+			return nil
+		}
+	}
+}
+
+// ToChannelBatched iterates over a collection, groups its elements into slices
+// of batchSize elements and outputs each slice to a channel, then closes it.
+// The last slice holds the remaining elements and can be shorter. Sending one
+// slice per batch instead of one element at a time reduces the
+// synchronization overhead of the channel in high-throughput pipelines.
+//
+// Every slice is newly allocated, so the receiver can keep it. ToChannelBatched
+// panics if batchSize is not positive.
+func (q Query) ToChannelBatched(result chan<- []interface{}, batchSize int) {
+	if batchSize <= 0 {
+		panic(errors.New("ToChannelBatched: non-positive batch size"))
+	}
+
+	defer close(result)
+	next := q.Iterate()
+	batch := make([]interface{}, 0, batchSize)
+
+	for item, ok := next(); ok; item, ok = next() {
+		batch = append(batch, item)
+		if len(batch) == batchSize {
+			result <- batch
+			batch = make([]interface{}, 0, batchSize)
+		}
+	}
+
+	if len(batch) > 0 {
+		result <- batch
+	}
+}
@@ -0,0 +1,80 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToChannelWithOverflow(t *testing.T) {
+	tests := []struct {
+		policy OverflowPolicy
+		output []interface{}
+		err    error
+	}{
+		{OverflowBlock, []interface{}{1, 2, 3, 4, 5}, nil},
+		{OverflowDropOldest, []interface{}{4, 5}, nil},
+		{OverflowDropNewest, []interface{}{1, 2}, nil},
+		{OverflowError, []interface{}{1, 2}, ErrChannelFull},
+	}
+
+	for _, test := range tests {
+		capacity := 2
+		if test.policy == OverflowBlock {
+			capacity = 5
+		}
+
+		c := make(chan interface{}, capacity)
+		err := Range(1, 5).ToChannelWithOverflow(c, test.policy)
+		if err != test.err {
+			t.Errorf("ToChannelWithOverflow(%v) err=%v expected %v", test.policy, err, test.err)
+		}
+
+		if q := FromChannel(c); !validateQuery(q, test.output) {
+			t.Errorf("ToChannelWithOverflow(%v)=%v expected %v", test.policy, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestToChannelWithOverflowUnbuffered(t *testing.T) {
+	c := make(chan interface{})
+	if err := Range(1, 3).ToChannelWithOverflow(c, OverflowDropOldest); err != nil {
+		t.Errorf("ToChannelWithOverflow() err=%v expected nil", err)
+	}
+
+	if q := FromChannel(c); !validateQuery(q, []interface{}{}) {
+		t.Errorf("ToChannelWithOverflow()=%v expected []", toSlice(q))
+	}
+}
+
+func TestToChannelBatched(t *testing.T) {
+	tests := []struct {
+		input     Query
+		batchSize int
+		want      [][]interface{}
+	}{
+		{Range(1, 5), 2, [][]interface{}{{1, 2}, {3, 4}, {5}}},
+		{Range(1, 4), 2, [][]interface{}{{1, 2}, {3, 4}}},
+		{Range(1, 2), 5, [][]interface{}{{1, 2}}},
+		{Empty(), 3, nil},
+	}
+
+	for _, test := range tests {
+		c := make(chan []interface{})
+		go test.input.ToChannelBatched(c, test.batchSize)
+
+		var batches [][]interface{}
+		for batch := range c {
+			batches = append(batches, batch)
+		}
+
+		if !reflect.DeepEqual(batches, test.want) {
+			t.Errorf("%v.ToChannelBatched(%d)=%v expected %v", test.input, test.batchSize, batches, test.want)
+		}
+	}
+}
+
+func TestToChannelBatched_PanicWhenBatchSizeIsNotPositive(t *testing.T) {
+	mustPanicWithError(t, "ToChannelBatched: non-positive batch size", func() {
+		Range(1, 3).ToChannelBatched(make(chan []interface{}), 0)
+	})
+}
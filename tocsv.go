@@ -0,0 +1,112 @@
+package linq
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// ToCSV iterates over a collection and writes its elements to w as CSV records
+// with encoding/csv. The options Comma and Header of opts are honored; the
+// remaining options only apply to reading.
+//
+// The columns are derived from the first element of the collection:
+//
+//   - a struct, or a pointer to struct, is written as one field per exported
+//     struct field, named after the field or the struct tag opts.Tag;
+//   - a map is written as one field per key of the first element, in
+//     ascending order of keys; keys missing from subsequent elements are
+//     written as empty fields;
+//   - a []string is written as is;
+//   - any other value is written as a single field.
+//
+// If opts.Header is true, a header record with the column names is written
+// before the first element. Values are formatted with fmt.Sprint. ToCSV stops
+// iterating and returns the error if writing to w fails.
+func (q Query) ToCSV(w io.Writer, opts CSVOptions) error {
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+
+	next := q.Iterate()
+	item, ok := next()
+	if !ok {
+		return nil
+	}
+
+	header, record := csvRecordFunc(item, opts.Tag)
+	if opts.Header && header != nil {
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for ; ok; item, ok = next() {
+		if err := writer.Write(record(item)); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvRecordFunc returns the header derived from the first element of a
+// collection and a function that converts an element into a CSV record.
+func csvRecordFunc(first interface{}, tag string) (header []string, record func(interface{}) []string) {
+	if _, ok := first.([]string); ok {
+		return nil, func(item interface{}) []string {
+			return item.([]string)
+		}
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(first))
+
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := structFields(v.Type(), tag)
+		header = make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = f.Name
+		}
+
+		return header, func(item interface{}) []string {
+			v := reflect.Indirect(reflect.ValueOf(item))
+			r := make([]string, len(fields))
+			for i, f := range fields {
+				r[i] = fmt.Sprint(v.Field(f.Index).Interface())
+			}
+
+			return r
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+
+		header = make([]string, len(keys))
+		for i, k := range keys {
+			header[i] = fmt.Sprint(k.Interface())
+		}
+
+		return header, func(item interface{}) []string {
+			v := reflect.ValueOf(item)
+			r := make([]string, len(keys))
+			for i, k := range keys {
+				if value := v.MapIndex(k); value.IsValid() {
+					r[i] = fmt.Sprint(value.Interface())
+				}
+			}
+
+			return r
+		}
+	}
+
+	return nil, func(item interface{}) []string {
+		return []string{fmt.Sprint(item)}
+	}
+}
@@ -0,0 +1,51 @@
+package linq
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestToCSV(t *testing.T) {
+	type user struct {
+		Name  string `csv:"name"`
+		Age   int    `csv:"age"`
+		Admin bool   `csv:"-"`
+	}
+
+	tests := []struct {
+		input interface{}
+		opts  CSVOptions
+		want  string
+	}{
+		{[]user{{"alice", 30, true}, {"bob", 25, false}}, CSVOptions{Header: true}, "Name,Age,Admin\nalice,30,true\nbob,25,false\n"},
+		{[]*user{{"alice", 30, true}}, CSVOptions{Header: true, Tag: "csv", Comma: ';'}, "name;age\nalice;30\n"},
+		{[]user{{"alice", 30, true}}, CSVOptions{}, "alice,30,true\n"},
+		{[]map[string]int{{"b": 2, "a": 1}, {"a": 3}}, CSVOptions{Header: true}, "a,b\n1,2\n3,\n"},
+		{[][]string{{"x", "y,z"}}, CSVOptions{Header: true}, "x,\"y,z\"\n"},
+		{[]int{1, 2}, CSVOptions{Header: true}, "1\n2\n"},
+		{[]user{}, CSVOptions{Header: true}, ""},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := From(test.input).ToCSV(&buf, test.opts); err != nil || buf.String() != test.want {
+			t.Errorf("From(%v).ToCSV()=%q,%v expected %q,nil", test.input, buf.String(), err, test.want)
+		}
+	}
+}
+
+func TestToCSV_RoundTrip(t *testing.T) {
+	input := "age,name\n30,alice\n25,bob\n"
+
+	var buf bytes.Buffer
+	if err := FromCSV(strings.NewReader(input), CSVOptions{Header: true}).ToCSV(&buf, CSVOptions{Header: true}); err != nil || buf.String() != input {
+		t.Errorf("FromCSV().ToCSV()=%q,%v expected %q,nil", buf.String(), err, input)
+	}
+}
+
+func TestToCSV_ReturnsErrorWhenWriterFails(t *testing.T) {
+	if err := From([]int{1}).ToCSV(failingWriter{}, CSVOptions{}); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToCSV()=%v expected write failed", err)
+	}
+}
@@ -0,0 +1,80 @@
+package linq
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// ToJSON iterates over a collection and writes it to w as a JSON array. Each
+// element is encoded with encoding/json and written as soon as it is produced,
+// so the collection is never held in memory as a whole. An empty collection is
+// written as "[]".
+//
+// ToJSON stops iterating and returns the error if an element can not be
+// encoded or writing to w fails.
+func (q Query) ToJSON(w io.Writer) error {
+	next := q.Iterate()
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	sep := ""
+	for item, ok := next(); ok; item, ok = next() {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+
+		sep = ","
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// ToJSONLines iterates over a collection and writes it to w as newline
+// delimited JSON (JSON Lines): each element is encoded with encoding/json and
+// written on its own line. The output can be read back with FromJSONLines.
+//
+// ToJSONLines stops iterating and returns the error if an element can not be
+// encoded or writing to w fails.
+func (q Query) ToJSONLines(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. It iterates over the
+// collection and encodes it as a JSON array like ToJSON, so that a query can be
+// passed to json.Marshal or an encoder directly, for example to return the
+// result of a query from an HTTP handler. The zero value of Query is encoded as
+// null.
+func (q Query) MarshalJSON() ([]byte, error) {
+	if q.Iterate == nil {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	if err := q.ToJSON(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
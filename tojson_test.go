@@ -0,0 +1,98 @@
+package linq
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestToJSON(t *testing.T) {
+	type point struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	}
+
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{[]int{1, 2, 3}, "[1,2,3]"},
+		{[]point{{1, 2}, {3, 4}}, `[{"x":1,"y":2},{"x":3,"y":4}]`},
+		{[]string{}, "[]"},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := From(test.input).ToJSON(&buf); err != nil || buf.String() != test.want {
+			t.Errorf("From(%v).ToJSON()=%v,%v expected %v,nil", test.input, buf.String(), err, test.want)
+		}
+	}
+}
+
+func TestToJSON_ReturnsErrorWhenElementIsInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := From([]interface{}{1, make(chan int)}).ToJSON(&buf); err == nil {
+		t.Errorf("ToJSON() expected an error for a channel element")
+	}
+}
+
+func TestToJSON_ReturnsErrorWhenWriterFails(t *testing.T) {
+	if err := From([]int{1}).ToJSON(failingWriter{}); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToJSON()=%v expected write failed", err)
+	}
+}
+
+func TestToJSONLines(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  string
+	}{
+		{[]int{1, 2, 3}, "1\n2\n3\n"},
+		{[]map[string]string{{"a": "b"}, {"c": "d"}}, "{\"a\":\"b\"}\n{\"c\":\"d\"}\n"},
+		{[]string{}, ""},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := From(test.input).ToJSONLines(&buf); err != nil || buf.String() != test.want {
+			t.Errorf("From(%v).ToJSONLines()=%q,%v expected %q,nil", test.input, buf.String(), err, test.want)
+		}
+	}
+
+	if err := From([]int{1}).ToJSONLines(failingWriter{}); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToJSONLines()=%v expected write failed", err)
+	}
+}
+
+func TestToJSONLines_RoundTrip(t *testing.T) {
+	input := []interface{}{"a", 1.5, true}
+
+	var buf bytes.Buffer
+	if err := From(input).ToJSONLines(&buf); err != nil {
+		t.Fatalf("ToJSONLines()=%v expected nil", err)
+	}
+
+	if q := FromJSONLines(&buf, nil); !validateQuery(q, input) {
+		t.Errorf("FromJSONLines(ToJSONLines(%v))=%v", input, toSlice(q))
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	response := struct {
+		Items Query `json:"items"`
+		Empty Query `json:"empty"`
+		None  Query `json:"none"`
+	}{
+		Items: Range(1, 5).Where(func(i interface{}) bool { return i.(int)%2 == 1 }),
+		Empty: Empty(),
+	}
+
+	b, err := json.Marshal(response)
+	if want := `{"items":[1,3,5],"empty":[],"none":null}`; err != nil || string(b) != want {
+		t.Errorf("json.Marshal()=%s, %v expected %s", b, err, want)
+	}
+
+	if _, err := json.Marshal(From([]interface{}{make(chan int)})); err == nil {
+		t.Errorf("json.Marshal() expected an error for a channel element")
+	}
+}
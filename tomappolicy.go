@@ -0,0 +1,104 @@
+package linq
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DuplicatePolicy specifies what ToMapByWithPolicy does with an element whose
+// key is already in the map. Use one of KeepLast, KeepFirst and
+// ErrorOnDuplicate, or the policy returned by Combine.
+type DuplicatePolicy struct {
+	kind    duplicateKind
+	combine func(interface{}, interface{}) interface{}
+}
+
+type duplicateKind int
+
+const (
+	duplicateKeepLast duplicateKind = iota
+	duplicateKeepFirst
+	duplicateError
+	duplicateCombine
+)
+
+var (
+	// KeepLast replaces the value already in the map, like ToMapBy.
+	KeepLast = DuplicatePolicy{kind: duplicateKeepLast}
+	// KeepFirst discards the value of the element and keeps the value
+	// already in the map.
+	KeepFirst = DuplicatePolicy{kind: duplicateKeepFirst}
+	// ErrorOnDuplicate stops populating the map and returns a
+	// DuplicateKeyError.
+	ErrorOnDuplicate = DuplicatePolicy{kind: duplicateError}
+)
+
+// Combine returns a policy that replaces the value already in the map with the
+// result of f, which is passed the value already in the map and the value of
+// the element, such as their sum or a slice holding both.
+func Combine(f func(old, new interface{}) interface{}) DuplicatePolicy {
+	return DuplicatePolicy{kind: duplicateCombine, combine: f}
+}
+
+// DuplicateKeyError is the error ToMapByWithPolicy returns with the
+// ErrorOnDuplicate policy when a key is generated for several elements.
+type DuplicateKeyError struct {
+	// Key is the duplicate key.
+	Key interface{}
+}
+
+// Error returns a message with the duplicate key.
+func (e DuplicateKeyError) Error() string {
+	return fmt.Sprintf("linq: duplicate key %v", e.Key)
+}
+
+// ToMapByWithPolicy iterates over a collection and populates the result map
+// with elements, like ToMapBy. Unlike ToMapBy, which silently keeps the value
+// of the last element with a given key, policy specifies what happens when a
+// key is already in the map, whether it was generated for a previous element
+// or was in the map before.
+//
+// With the ErrorOnDuplicate policy, a DuplicateKeyError is returned at the
+// first duplicate key, and the map holds the elements that precede it. With
+// the other policies, the returned error is nil. ToMapByWithPolicy panics like
+// ToMapBy if result is not a non-nil pointer to a map, or if a generated key,
+// value or combined value is not assignable to the map's key or value type.
+func (q Query) ToMapByWithPolicy(result interface{},
+	keySelector func(interface{}) interface{},
+	valueSelector func(interface{}) interface{},
+	policy DuplicatePolicy) error {
+	const method = "ToMapByWithPolicy"
+
+	res := validateResult(method, result, reflect.Map)
+	m := res.Elem()
+	if m.IsNil() {
+		m = reflect.MakeMapWithSize(m.Type(), q.capacity())
+	}
+
+	defer res.Elem().Set(m)
+
+	keyType, valueType := m.Type().Key(), m.Type().Elem()
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		k := keySelector(item)
+		key := assignableValue(method, "key", k, keyType)
+		value := assignableValue(method, "value", valueSelector(item), valueType)
+
+		if old := m.MapIndex(key); old.IsValid() {
+			switch policy.kind {
+			case duplicateKeepFirst:
+				continue
+			case duplicateError:
+				return DuplicateKeyError{Key: k}
+			case duplicateCombine:
+				value = assignableValue(method, "combined value",
+					policy.combine(old.Interface(), value.Interface()), valueType)
+			}
+		}
+
+		m.SetMapIndex(key, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToMapByWithPolicy(t *testing.T) {
+	input := []KeyValue{{"a", 1}, {"b", 2}, {"a", 3}}
+	key := func(i interface{}) interface{} { return i.(KeyValue).Key }
+	value := func(i interface{}) interface{} { return i.(KeyValue).Value }
+
+	tests := []struct {
+		policy DuplicatePolicy
+		want   map[string]int
+	}{
+		{KeepLast, map[string]int{"a": 3, "b": 2}},
+		{KeepFirst, map[string]int{"a": 1, "b": 2}},
+		{Combine(func(old, new interface{}) interface{} { return old.(int) + new.(int) }), map[string]int{"a": 4, "b": 2}},
+	}
+
+	for _, test := range tests {
+		var m map[string]int
+		if err := From(input).ToMapByWithPolicy(&m, key, value, test.policy); err != nil || !reflect.DeepEqual(m, test.want) {
+			t.Errorf("From(%v).ToMapByWithPolicy()=%v, %v expected %v", input, m, err, test.want)
+		}
+	}
+
+	m := map[string]int{"c": 0}
+	err := From(input).ToMapByWithPolicy(&m, key, value, ErrorOnDuplicate)
+	if want := (map[string]int{"a": 1, "b": 2, "c": 0}); err != (DuplicateKeyError{Key: "a"}) || !reflect.DeepEqual(m, want) {
+		t.Errorf("ToMapByWithPolicy(ErrorOnDuplicate)=%v, %v expected %v, linq: duplicate key a", m, err, want)
+	}
+
+	if err.Error() != "linq: duplicate key a" {
+		t.Errorf("DuplicateKeyError.Error()=%s expected linq: duplicate key a", err)
+	}
+
+	m = map[string]int{"b": 10}
+	if err := From(input).ToMapByWithPolicy(&m, key, value, KeepFirst); err != nil || m["b"] != 10 {
+		t.Errorf("ToMapByWithPolicy(KeepFirst)=%v, %v expected b kept at 10", m, err)
+	}
+}
+
+func TestToMapByWithPolicy_PanicWhenCombinedValueIsNotAssignable(t *testing.T) {
+	mustPanicWithError(t, "ToMapByWithPolicy: combined value of type 'string' is not assignable to type 'int'", func() {
+		m := map[int]int{}
+		From([]int{1, 1}).ToMapByWithPolicy(&m, func(i interface{}) interface{} { return i },
+			func(i interface{}) interface{} { return i },
+			Combine(func(old, new interface{}) interface{} { return "x" }))
+	})
+}
@@ -0,0 +1,74 @@
+package linq
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// ToSQLInsert iterates over a collection and inserts its elements into table
+// of db, using batched parameterized INSERT statements. Function valuer is
+// executed for each element to get the values of the specified columns, in the
+// same order.
+//
+// Elements are inserted batchSize rows at a time with a single multi-row
+// INSERT statement, and every batch is executed in its own transaction. If
+// batchSize is less than 1, each element is inserted in its own batch. The
+// statements use "?" placeholders; table and column names are written to the
+// statements verbatim and must not come from untrusted input.
+//
+// ToSQLInsert stops iterating and returns the error as soon as a batch fails.
+// The transaction of the failed batch is rolled back, but batches committed
+// before it are kept.
+func (q Query) ToSQLInsert(ctx context.Context, db *sql.DB, table string,
+	columns []string, valuer func(interface{}) []interface{}, batchSize int) error {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	prefix := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES "
+	row := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ") + ")"
+
+	next := q.Iterate()
+	args := make([]interface{}, 0, batchSize*len(columns))
+	rows := 0
+
+	flush := func() error {
+		if rows == 0 {
+			return nil
+		}
+
+		stmt := prefix + strings.TrimSuffix(strings.Repeat(row+", ", rows), ", ")
+		err := execInTx(ctx, db, stmt, args)
+		args, rows = args[:0], 0
+		return err
+	}
+
+	for item, ok := next(); ok; item, ok = next() {
+		args = append(args, valuer(item)...)
+		rows++
+
+		if rows == batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
+// execInTx executes a statement in a new transaction of db.
+func execInTx(ctx context.Context, db *sql.DB, stmt string, args []interface{}) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, stmt, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
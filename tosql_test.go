@@ -0,0 +1,197 @@
+package linq
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingDriver is a database/sql driver that records the statements it
+// executes. Statements containing failOn fail. Queries return rows with the
+// specified columns.
+type recordingDriver struct {
+	mu      sync.Mutex
+	log     []string
+	failOn  string
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *recordingDriver) record(entry string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.log = append(d.log, entry)
+}
+
+func (d *recordingDriver) Open(name string) (driver.Conn, error) {
+	return &recordingConn{d}, nil
+}
+
+type recordingConn struct {
+	d *recordingDriver
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{c.d, query}, nil
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	c.d.record("BEGIN")
+	return &recordingTx{c.d}, nil
+}
+
+type recordingTx struct {
+	d *recordingDriver
+}
+
+func (tx *recordingTx) Commit() error {
+	tx.d.record("COMMIT")
+	return nil
+}
+
+func (tx *recordingTx) Rollback() error {
+	tx.d.record("ROLLBACK")
+	return nil
+}
+
+type recordingStmt struct {
+	d     *recordingDriver
+	query string
+}
+
+func (s *recordingStmt) Close() error {
+	return nil
+}
+
+func (s *recordingStmt) NumInput() int {
+	return -1
+}
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	values := make([]string, len(args))
+	for i, arg := range args {
+		values[i] = fmt.Sprint(arg)
+	}
+
+	s.d.record(s.query + " " + strings.Join(values, ","))
+	if s.d.failOn != "" && strings.Contains(strings.Join(values, ","), s.d.failOn) {
+		return nil, errors.New("exec failed")
+	}
+
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.d.record(s.query)
+	return &recordingRows{d: s.d}, nil
+}
+
+type recordingRows struct {
+	d    *recordingDriver
+	next int
+}
+
+func (r *recordingRows) Columns() []string {
+	return r.d.columns
+}
+
+func (r *recordingRows) Close() error {
+	r.d.record("CLOSE")
+	return nil
+}
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.d.rows) {
+		return io.EOF
+	}
+
+	if r.d.failOn != "" && fmt.Sprint(r.d.rows[r.next]) == r.d.failOn {
+		return errors.New("read failed")
+	}
+
+	copy(dest, r.d.rows[r.next])
+	r.next++
+	return nil
+}
+
+var recordingDriverID = 0
+
+func openRecordingDB(t *testing.T, failOn string) (*sql.DB, *recordingDriver) {
+	d := &recordingDriver{failOn: failOn}
+	recordingDriverID++
+	name := fmt.Sprintf("linq-recording-%d", recordingDriverID)
+	sql.Register(name, d)
+
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db, d
+}
+
+func TestToSQLInsert(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+
+	users := []user{{"a", 1}, {"b", 2}, {"c", 3}}
+	valuer := func(i interface{}) []interface{} {
+		return []interface{}{i.(user).name, i.(user).age}
+	}
+
+	db, d := openRecordingDB(t, "")
+	defer db.Close()
+
+	if err := From(users).ToSQLInsert(context.Background(), db, "users", []string{"name", "age"}, valuer, 2); err != nil {
+		t.Fatalf("ToSQLInsert()=%v expected nil", err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"INSERT INTO users (name, age) VALUES (?, ?), (?, ?) a,1,b,2",
+		"COMMIT",
+		"BEGIN",
+		"INSERT INTO users (name, age) VALUES (?, ?) c,3",
+		"COMMIT",
+	}
+	if !reflect.DeepEqual(d.log, want) {
+		t.Errorf("ToSQLInsert() executed %q expected %q", d.log, want)
+	}
+}
+
+func TestToSQLInsert_ReturnsErrorWhenBatchFails(t *testing.T) {
+	db, d := openRecordingDB(t, "c")
+	defer db.Close()
+
+	err := From([]string{"a", "b", "c", "d"}).ToSQLInsert(context.Background(), db, "letters", []string{"letter"}, func(i interface{}) []interface{} {
+		return []interface{}{i}
+	}, 2)
+	if err == nil || err.Error() != "exec failed" {
+		t.Fatalf("ToSQLInsert()=%v expected exec failed", err)
+	}
+
+	want := []string{
+		"BEGIN",
+		"INSERT INTO letters (letter) VALUES (?), (?) a,b",
+		"COMMIT",
+		"BEGIN",
+		"INSERT INTO letters (letter) VALUES (?), (?) c,d",
+		"ROLLBACK",
+	}
+	if !reflect.DeepEqual(d.log, want) {
+		t.Errorf("ToSQLInsert() executed %q expected %q", d.log, want)
+	}
+}
@@ -0,0 +1,124 @@
+package linq
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ToStructs iterates over a collection of maps with string keys, such as the
+// map[string]interface{} values decoded from JSON documents or read from
+// dynamic sources, and converts each of them into a struct of the element
+// type of the slice pointed by slicePtr, which is a struct or a pointer to
+// struct. It overwrites the existing slice once all the elements have been
+// converted.
+//
+// Keys are mapped to the exported fields of the struct like in ScanStructs: by
+// the name in the struct tag with the specified key, e.g. "json", or by the Go
+// name of fields without a tag name, ignoring case if no name matches exactly.
+// The mapping is computed once per struct type and key. Keys without a
+// matching field are ignored.
+//
+// A value is stored in its field if it is assignable to it. Otherwise numbers
+// are converted to numeric fields if they fit without loss, so float64 values
+// decoded from JSON can be stored in integer fields, strings are parsed like
+// in FromCSVStructs, values are stored in pointer fields through a new pointer
+// and nil values set fields to their zero value.
+//
+// ToStructs panics if slicePtr is not a non-nil pointer to a slice of structs
+// or pointers to structs. It stops iterating and returns an error, leaving the
+// slice unchanged, if an element is not a map with string keys or a value
+// can't be stored in its field.
+func (q Query) ToStructs(slicePtr interface{}, tag string) error {
+	value := validateResult("ToStructs", slicePtr, reflect.Slice)
+	sliceType := value.Elem().Type()
+	elemType := sliceType.Elem()
+
+	t := elemType
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Errorf("ToStructs: parameter [slicePtr] has an invalid type. Expected: 'pointer to slice of structs', actual: '%T'", slicePtr))
+	}
+
+	slice := reflect.MakeSlice(sliceType, 0, q.capacity())
+	next := q.Iterate()
+	index := 0
+	for item, ok := next(); ok; item, ok = next() {
+		m := reflect.ValueOf(item)
+		if m.Kind() != reflect.Map || m.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("linq: ToStructs: element %d of type %T is not a map with string keys", index, item)
+		}
+
+		v := reflect.New(t)
+		for _, key := range m.MapKeys() {
+			field := columnField(t, tag, key.String())
+			if field == nil {
+				continue
+			}
+
+			if err := setStructValue(v.Elem().FieldByIndex(field), m.MapIndex(key).Interface()); err != nil {
+				return fmt.Errorf("linq: ToStructs: element %d, key %s: %v", index, key.String(), err)
+			}
+		}
+
+		if elemType.Kind() != reflect.Ptr {
+			v = v.Elem()
+		}
+
+		slice = reflect.Append(slice, v)
+		index++
+	}
+
+	value.Elem().Set(slice)
+	return nil
+}
+
+// setStructValue stores item in the field v for ToStructs.
+func setStructValue(v reflect.Value, item interface{}) error {
+	if item == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	src := reflect.ValueOf(item)
+	switch {
+	case src.Type().AssignableTo(v.Type()):
+		v.Set(src)
+		return nil
+	case isNumberKind(src.Kind()) && isNumberKind(v.Kind()):
+		converted := src.Convert(v.Type())
+		if converted.Convert(src.Type()).Interface() != item {
+			return fmt.Errorf("value %v doesn't fit in type %v", item, v.Type())
+		}
+
+		v.Set(converted)
+		return nil
+	case src.Kind() == reflect.String:
+		return parseCSVValue(v, src.String())
+	case v.Kind() == reflect.Ptr:
+		p := reflect.New(v.Type().Elem())
+		if err := setStructValue(p.Elem(), item); err != nil {
+			return err
+		}
+
+		v.Set(p)
+		return nil
+	}
+
+	return fmt.Errorf("value of type %T is not assignable to type %v", item, v.Type())
+}
+
+// isNumberKind reports whether k is the kind of an integer, unsigned integer
+// or float type.
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type structsRow struct {
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	Score   *float64  `json:"score"`
+	Created time.Time `json:"created"`
+	Skipped string    `json:"-"`
+}
+
+func TestToStructs(t *testing.T) {
+	score := 9.5
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	input := []map[string]interface{}{
+		{"id": float64(1), "name": "a", "score": score, "created": "2020-01-02T03:04:05Z", "Skipped": "x", "extra": true},
+		{"ID": "2", "Name": "b", "score": nil},
+	}
+
+	var rows []structsRow
+	if err := From(input).ToStructs(&rows, "json"); err != nil {
+		t.Fatalf("ToStructs() returned %v", err)
+	}
+
+	want := []structsRow{
+		{ID: 1, Name: "a", Score: &score, Created: created},
+		{ID: 2, Name: "b"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("ToStructs()=%+v expected %+v", rows, want)
+	}
+
+	var pointers []*structsRow
+	if err := From(input).ToStructs(&pointers, "json"); err != nil || len(pointers) != 2 || pointers[1].ID != 2 {
+		t.Errorf("ToStructs() to pointers=%v, %v expected 2 rows", pointers, err)
+	}
+}
+
+func TestToStructsWithError(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		err   string
+	}{
+		{[]interface{}{1}, "linq: ToStructs: element 0 of type int is not a map with string keys"},
+		{[]map[string]interface{}{{"id": 1.5}}, "linq: ToStructs: element 0, key id: value 1.5 doesn't fit in type int"},
+		{[]map[string]interface{}{{"name": true}}, "linq: ToStructs: element 0, key name: value of type bool is not assignable to type string"},
+	}
+
+	for _, test := range tests {
+		rows := []structsRow{{ID: 7}}
+		if err := From(test.input).ToStructs(&rows, "json"); err == nil || err.Error() != test.err {
+			t.Errorf("From(%v).ToStructs()=%v expected %v", test.input, err, test.err)
+		}
+
+		if len(rows) != 1 || rows[0].ID != 7 {
+			t.Errorf("From(%v).ToStructs() changed the slice to %v", test.input, rows)
+		}
+	}
+
+	mustPanicWithError(t, "ToStructs: parameter [slicePtr] has an invalid type. Expected: 'pointer to slice of structs', actual: '*[]int'", func() {
+		From([]int{}).ToStructs(new([]int), "")
+	})
+}
@@ -0,0 +1,92 @@
+package linq
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+)
+
+// ColumnSpec is a type that is used to describe a column of the table written
+// by ToTable method.
+type ColumnSpec struct {
+	// Header is the name of the column, written in the first row.
+	Header string
+	// Value is executed for each element to get the value of the cell. The
+	// value is formatted with fmt.Sprint.
+	Value func(interface{}) interface{}
+}
+
+// ToTable iterates over a collection and writes it to w as a text table with
+// aligned columns, using text/tabwriter. The first row holds the headers of
+// the columns, followed by one row per element.
+//
+// If no columns are specified and the elements are structs or pointers to
+// structs, one column per exported field of the first element is used. Any
+// other element is written as a single column without a header.
+//
+// ToTable returns the error if writing to w fails.
+func (q Query) ToTable(w io.Writer, columns ...ColumnSpec) error {
+	next := q.Iterate()
+	item, ok := next()
+
+	if len(columns) == 0 {
+		if !ok {
+			return nil
+		}
+
+		columns = tableColumns(item)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	cells := make([]string, len(columns))
+
+	hasHeader := false
+	for i, c := range columns {
+		cells[i] = c.Header
+		hasHeader = hasHeader || c.Header != ""
+	}
+
+	if hasHeader {
+		if _, err := io.WriteString(tw, strings.Join(cells, "\t")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	for ; ok; item, ok = next() {
+		for i, c := range columns {
+			cells[i] = fmt.Sprint(c.Value(item))
+		}
+
+		if _, err := io.WriteString(tw, strings.Join(cells, "\t")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// tableColumns derives the columns of a table from an element.
+func tableColumns(item interface{}) []ColumnSpec {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return []ColumnSpec{{Value: func(item interface{}) interface{} {
+			return item
+		}}}
+	}
+
+	fields := structFields(v.Type(), "")
+	columns := make([]ColumnSpec, len(fields))
+	for i, f := range fields {
+		index := f.Index
+		columns[i] = ColumnSpec{
+			Header: f.Name,
+			Value: func(item interface{}) interface{} {
+				return reflect.Indirect(reflect.ValueOf(item)).Field(index).Interface()
+			},
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,43 @@
+package linq
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestToTable(t *testing.T) {
+	type car struct {
+		Model string
+		Year  int
+		owner string
+	}
+
+	cars := []car{{"Tesla", 2019, "ana"}, {"Ford", 2005, "bob"}}
+
+	tests := []struct {
+		input   interface{}
+		columns []ColumnSpec
+		want    string
+	}{
+		{cars, nil, "Model  Year\nTesla  2019\nFord   2005\n"},
+		{[]*car{&cars[0]}, nil, "Model  Year\nTesla  2019\n"},
+		{cars, []ColumnSpec{
+			{"OWNER", func(i interface{}) interface{} { return i.(car).owner }},
+			{"AGE", func(i interface{}) interface{} { return 2020 - i.(car).Year }},
+		}, "OWNER  AGE\nana    1\nbob    15\n"},
+		{[]string{"a", "bb"}, nil, "a\nbb\n"},
+		{[]car{}, nil, ""},
+		{[]car{}, []ColumnSpec{{Header: "X"}}, "X\n"},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := From(test.input).ToTable(&buf, test.columns...); err != nil || buf.String() != test.want {
+			t.Errorf("From(%v).ToTable()=%q,%v expected %q,nil", test.input, buf.String(), err, test.want)
+		}
+	}
+
+	if err := From(cars).ToTable(failingWriter{}); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToTable()=%v expected write failed", err)
+	}
+}
@@ -0,0 +1,28 @@
+package linq
+
+import "io"
+
+// Template is an interface that is implemented by the templates of both
+// text/template and html/template, so that ExecuteTemplate accepts either.
+type Template interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// ExecuteTemplate iterates over a collection and executes tmpl for each
+// element, with the element as the data of the template, writing the output to
+// w as the elements are produced. It can be used to render reports or emails
+// from the result of a query without collecting it first.
+//
+// ExecuteTemplate stops iterating and returns the error if executing tmpl
+// fails. The output of the failed execution may have been partially written.
+func (q Query) ExecuteTemplate(w io.Writer, tmpl Template) error {
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if err := tmpl.Execute(w, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
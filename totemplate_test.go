@@ -0,0 +1,61 @@
+package linq
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestExecuteTemplate(t *testing.T) {
+	type line struct {
+		Name  string
+		Total int
+	}
+
+	tmpl := template.Must(template.New("line").Parse("{{.Name}}: {{.Total}}\n"))
+	lines := []line{{"apples", 3}, {"pears", 5}}
+
+	var buf bytes.Buffer
+	if err := From(lines).ExecuteTemplate(&buf, tmpl); err != nil {
+		t.Fatalf("ExecuteTemplate()=%v expected nil", err)
+	}
+
+	if got, want := buf.String(), "apples: 3\npears: 5\n"; got != want {
+		t.Errorf("ExecuteTemplate() wrote %q expected %q", got, want)
+	}
+}
+
+func TestExecuteTemplateWithHTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("item").Parse("<li>{{.}}</li>"))
+
+	var buf bytes.Buffer
+	if err := From([]string{"a", "<b>"}).ExecuteTemplate(&buf, tmpl); err != nil {
+		t.Fatalf("ExecuteTemplate()=%v expected nil", err)
+	}
+
+	if got, want := buf.String(), "<li>a</li><li>&lt;b&gt;</li>"; got != want {
+		t.Errorf("ExecuteTemplate() wrote %q expected %q", got, want)
+	}
+}
+
+func TestExecuteTemplate_ReturnsErrorWhenExecutionFails(t *testing.T) {
+	tmpl := template.Must(template.New("field").Option("missingkey=error").Parse("{{.name}}\n"))
+	items := []map[string]string{{"name": "a"}, {}, {"name": "c"}}
+
+	var buf bytes.Buffer
+	err := From(items).ExecuteTemplate(&buf, tmpl)
+	if err == nil || !strings.Contains(err.Error(), "map has no entry for key") {
+		t.Errorf("ExecuteTemplate()=%v expected a missing key error", err)
+	}
+
+	if got := buf.String(); got != "a\n" {
+		t.Errorf("ExecuteTemplate() wrote %q expected %q", got, "a\n")
+	}
+
+	plain := template.Must(template.New("plain").Parse("{{.}}"))
+	if err := From([]int{1}).ExecuteTemplate(failingWriter{}, plain); err == nil || err.Error() != "write failed" {
+		t.Errorf("ExecuteTemplate()=%v expected write failed", err)
+	}
+}
@@ -0,0 +1,51 @@
+package linq
+
+import (
+	"fmt"
+	"io"
+)
+
+// ToWriter iterates over a collection and writes each element to w on its own
+// line. Function format is executed for each element to get the text of the
+// line, without the line terminator. If format is nil, elements are formatted
+// with fmt.Sprint.
+//
+// ToWriter stops iterating and returns the error if writing to w fails.
+func (q Query) ToWriter(w io.Writer, format func(interface{}) string) error {
+	if format == nil {
+		format = func(item interface{}) string {
+			return fmt.Sprint(item)
+		}
+	}
+
+	next := q.Iterate()
+
+	for item, ok := next(); ok; item, ok = next() {
+		if _, err := io.WriteString(w, format(item)+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToWriterT is the typed version of ToWriter.
+//
+//   - formatFn is of type "func(TSource) string"
+//
+// NOTE: ToWriter has better performance than ToWriterT.
+func (q Query) ToWriterT(w io.Writer, formatFn interface{}) error {
+	formatGenericFunc, err := newGenericFunc(
+		"ToWriterT", "formatFn", formatFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(string))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	formatFunc := func(item interface{}) string {
+		return formatGenericFunc.Call(item).(string)
+	}
+
+	return q.ToWriter(w, formatFunc)
+}
@@ -0,0 +1,47 @@
+package linq
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestToWriter(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		format func(interface{}) string
+		want   string
+	}{
+		{[]int{1, 2, 3}, nil, "1\n2\n3\n"},
+		{[]int{1, 2}, func(i interface{}) string {
+			return fmt.Sprintf("#%03d", i)
+		}, "#001\n#002\n"},
+		{[]int{}, nil, ""},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := From(test.input).ToWriter(&buf, test.format); err != nil || buf.String() != test.want {
+			t.Errorf("From(%v).ToWriter()=%q,%v expected %q,nil", test.input, buf.String(), err, test.want)
+		}
+	}
+
+	if err := From([]int{1}).ToWriter(failingWriter{}, nil); err == nil || err.Error() != "write failed" {
+		t.Errorf("ToWriter()=%v expected write failed", err)
+	}
+}
+
+func TestToWriterT(t *testing.T) {
+	var buf bytes.Buffer
+	want := "a!\nb!\n"
+
+	if err := From([]string{"a", "b"}).ToWriterT(&buf, func(s string) string { return s + "!" }); err != nil || buf.String() != want {
+		t.Errorf("ToWriterT()=%q,%v expected %q,nil", buf.String(), err, want)
+	}
+}
+
+func TestToWriterT_PanicWhenFormatFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ToWriterT: parameter [formatFn] has a invalid function signature. Expected: 'func(T)string', actual: 'func(int)int'", func() {
+		From([]int{1}).ToWriterT(&bytes.Buffer{}, func(i int) int { return i })
+	})
+}
@@ -0,0 +1,67 @@
+package linq
+
+import "fmt"
+
+// Transpose turns a collection of rows into a collection of columns. Every
+// element of the collection has to be a row: a slice, an array or a Query.
+// The i-th element of the result is a []interface{} that contains the i-th
+// element of every row, in the order of the rows, so a collection of 2 rows
+// of 3 elements becomes a collection of 3 columns of 2 elements.
+//
+// Rows can have different lengths. The result has as many columns as the
+// longest row has elements, and a row that is too short to have an element in
+// a column is left out of that column, so columns of ragged input are shorter
+// than the number of rows. Pad the rows beforehand, for example with PadEnd,
+// to get columns of equal length.
+//
+// The rows are buffered when the first column is requested. Transpose panics
+// if an element is not a row.
+func (q Query) Transpose() Query {
+	return Query{
+		desc: q.chain("Transpose"),
+		Iterate: func() Iterator {
+			var rows [][]interface{}
+			width := 0
+			buffered := false
+			column := 0
+
+			return func() (item interface{}, ok bool) {
+				if !buffered {
+					buffered = true
+
+					next := q.Iterate()
+					for item, ok := next(); ok; item, ok = next() {
+						nextCell, isRow := nestedIterator(item)
+						if !isRow {
+							panic(fmt.Errorf("Transpose: element [%v] of type '%T' is not a slice, an array or a Query", item, item))
+						}
+
+						var row []interface{}
+						for cell, ok := nextCell(); ok; cell, ok = nextCell() {
+							row = append(row, cell)
+						}
+
+						rows = append(rows, row)
+						if len(row) > width {
+							width = len(row)
+						}
+					}
+				}
+
+				if column >= width {
+					return nil, false
+				}
+
+				cells := make([]interface{}, 0, len(rows))
+				for _, row := range rows {
+					if column < len(row) {
+						cells = append(cells, row[column])
+					}
+				}
+
+				column++
+				return cells, true
+			}
+		},
+	}
+}
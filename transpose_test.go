@@ -0,0 +1,37 @@
+package linq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		want  []interface{}
+	}{
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, []interface{}{
+			[]interface{}{1, 4}, []interface{}{2, 5}, []interface{}{3, 6},
+		}},
+		{[][]int{{1, 2, 3}, {4}, {5, 6}}, []interface{}{
+			[]interface{}{1, 4, 5}, []interface{}{2, 6}, []interface{}{3},
+		}},
+		{[]interface{}{[2]string{"a", "b"}, From([]string{"c", "d"})}, []interface{}{
+			[]interface{}{"a", "c"}, []interface{}{"b", "d"},
+		}},
+		{[][]int{{}, {}}, nil},
+		{[][]int{}, nil},
+	}
+
+	for _, test := range tests {
+		if q := From(test.input).Transpose(); !reflect.DeepEqual(toSlice(q), test.want) {
+			t.Errorf("From(%v).Transpose()=%v expected %v", test.input, toSlice(q), test.want)
+		}
+	}
+}
+
+func TestTranspose_PanicWhenElementIsNotRow(t *testing.T) {
+	mustPanicWithError(t, "Transpose: element [2] of type 'int' is not a slice, an array or a Query", func() {
+		From([]interface{}{[]int{1}, 2}).Transpose().Results()
+	})
+}
@@ -0,0 +1,128 @@
+package linq
+
+// NewKeyValue returns a KeyValue with the specified key and value.
+func NewKeyValue(key, value interface{}) KeyValue {
+	return KeyValue{Key: key, Value: value}
+}
+
+// KeyString returns the key of the pair, which has to be a string.
+func (kv KeyValue) KeyString() string {
+	return kv.Key.(string)
+}
+
+// KeyInt returns the key of the pair, which has to be an int.
+func (kv KeyValue) KeyInt() int {
+	return kv.Key.(int)
+}
+
+// ValueString returns the value of the pair, which has to be a string.
+func (kv KeyValue) ValueString() string {
+	return kv.Value.(string)
+}
+
+// ValueInt returns the value of the pair, which has to be an int.
+func (kv KeyValue) ValueInt() int {
+	return kv.Value.(int)
+}
+
+// ValueFloat64 returns the value of the pair, which has to be a float64.
+func (kv KeyValue) ValueFloat64() float64 {
+	return kv.Value.(float64)
+}
+
+// Pair is a type that is used to pass two values as a single element, such as
+// the corresponding elements of two collections returned by ZipPairs.
+type Pair struct {
+	First  interface{}
+	Second interface{}
+}
+
+// NewPair returns a Pair of the specified values.
+func NewPair(first, second interface{}) Pair {
+	return Pair{First: first, Second: second}
+}
+
+// pairOf is NewPair with the signature of the result selectors of Zip and
+// Join.
+func pairOf(first, second interface{}) interface{} {
+	return NewPair(first, second)
+}
+
+// Triple is a type that is used to pass three values as a single element, such
+// as the corresponding elements of three collections returned by ZipTriples.
+type Triple struct {
+	First  interface{}
+	Second interface{}
+	Third  interface{}
+}
+
+// NewTriple returns a Triple of the specified values.
+func NewTriple(first, second, third interface{}) Triple {
+	return Triple{First: first, Second: second, Third: third}
+}
+
+// tripleOf is NewTriple with the signature of the result selector of Zip3.
+func tripleOf(first, second, third interface{}) interface{} {
+	return NewTriple(first, second, third)
+}
+
+// ZipPairs combines the corresponding elements of two collections into
+// elements of type Pair. Like Zip, it stops at the end of the shorter
+// collection.
+func (q Query) ZipPairs(q2 Query) Query {
+	return q.Zip(q2, pairOf).describe(q.chain("ZipPairs"))
+}
+
+// ZipTriples combines the corresponding elements of three collections into
+// elements of type Triple. Like Zip3, it stops at the end of the shortest
+// collection.
+func (q Query) ZipTriples(q2, q3 Query) Query {
+	return q.Zip3(q2, q3, tripleOf).describe(q.chain("ZipTriples"))
+}
+
+// Pairwise returns a query with a Pair of each element of a collection and the
+// element that follows it, so a collection of n elements results in n-1
+// pairs, which is useful to compute the differences between consecutive
+// elements.
+func (q Query) Pairwise() Query {
+	var length func() int
+	if q.length != nil {
+		length = func() int { return knownLength(q.length() - 1) }
+	}
+
+	return Query{
+		desc:   q.chain("Pairwise"),
+		length: length,
+		Iterate: func() Iterator {
+			next := q.Iterate()
+			var previous interface{}
+			started := false
+
+			return func() (item interface{}, ok bool) {
+				if !started {
+					started = true
+					if previous, ok = next(); !ok {
+						return
+					}
+				}
+
+				current, ok := next()
+				if !ok {
+					return nil, false
+				}
+
+				item, previous = Pair{First: previous, Second: current}, current
+				return item, true
+			}
+		},
+	}
+}
+
+// JoinPairs correlates the elements of two collections based on matching keys
+// like Join, returning a Pair of the outer and the inner element for each
+// match.
+func (q Query) JoinPairs(inner Query,
+	outerKeySelector func(interface{}) interface{},
+	innerKeySelector func(interface{}) interface{}) Query {
+	return q.Join(inner, outerKeySelector, innerKeySelector, pairOf).describe(q.chain("JoinPairs"))
+}
@@ -0,0 +1,73 @@
+package linq
+
+import "testing"
+
+func TestKeyValueAccessors(t *testing.T) {
+	kv := NewKeyValue("a", 1)
+	if kv.KeyString() != "a" || kv.ValueInt() != 1 {
+		t.Errorf("NewKeyValue(a, 1)=%v expected {a 1}", kv)
+	}
+
+	kv = NewKeyValue(2, "b")
+	if kv.KeyInt() != 2 || kv.ValueString() != "b" {
+		t.Errorf("NewKeyValue(2, b)=%v expected {2 b}", kv)
+	}
+
+	if v := NewKeyValue(nil, 1.5).ValueFloat64(); v != 1.5 {
+		t.Errorf("ValueFloat64()=%v expected 1.5", v)
+	}
+}
+
+func TestNewPairAndTriple(t *testing.T) {
+	if p := NewPair(1, "a"); p.First != 1 || p.Second != "a" {
+		t.Errorf("NewPair(1, a)=%v expected {1 a}", p)
+	}
+
+	if tr := NewTriple(1, "a", true); tr.First != 1 || tr.Second != "a" || tr.Third != true {
+		t.Errorf("NewTriple(1, a, true)=%v expected {1 a true}", tr)
+	}
+}
+
+func TestZipPairs(t *testing.T) {
+	q := From([]int{1, 2, 3}).ZipPairs(From([]string{"a", "b"}))
+	if w := []interface{}{Pair{1, "a"}, Pair{2, "b"}}; !validateQuery(q, w) {
+		t.Errorf("ZipPairs()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestZipTriples(t *testing.T) {
+	q := From([]int{1, 2}).ZipTriples(From([]string{"a", "b"}), From([]bool{true}))
+	if w := []interface{}{Triple{1, "a", true}}; !validateQuery(q, w) {
+		t.Errorf("ZipTriples()=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	tests := []struct {
+		input  interface{}
+		output []interface{}
+	}{
+		{[]int{1, 2, 4}, []interface{}{Pair{1, 2}, Pair{2, 4}}},
+		{[]int{1}, []interface{}{}},
+		{[]int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := From(test.input).Pairwise()
+		if !validateQuery(q, test.output) || q.Count() != len(test.output) {
+			t.Errorf("From(%v).Pairwise()=%v expected %v", test.input, toSlice(q), test.output)
+		}
+	}
+}
+
+func TestJoinPairs(t *testing.T) {
+	outer := []int{1, 2}
+	inner := []string{"a1", "b2", "c2"}
+
+	q := From(outer).JoinPairs(From(inner),
+		func(i interface{}) interface{} { return i },
+		func(s interface{}) interface{} { return int(s.(string)[1] - '0') })
+	if w := []interface{}{Pair{1, "a1"}, Pair{2, "b2"}, Pair{2, "c2"}}; !validateQuery(q, w) {
+		t.Errorf("JoinPairs()=%v expected %v", toSlice(q), w)
+	}
+}
@@ -7,6 +7,7 @@ package linq
 // collection including duplicates.
 func (q Query) Union(q2 Query) Query {
 	return Query{
+		desc: q.chain("Union"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			next2 := q2.Iterate()
@@ -38,3 +39,12 @@ func (q Query) Union(q2 Query) Query {
 		},
 	}
 }
+
+// UnionAll produces the bag union of two collections: all the elements of the
+// source collection followed by all the elements of q2, including duplicates.
+//
+// Unlike Union, which returns each distinct element only once, UnionAll
+// behaves like Concat, following the naming of the UNION ALL operator of SQL.
+func (q Query) UnionAll(q2 Query) Query {
+	return q.Concat(q2).describe(q.chain("UnionAll"))
+}
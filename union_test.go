@@ -11,3 +11,13 @@ func TestUnion(t *testing.T) {
 		t.Errorf("From(%v).Union(%v)=%v expected %v", input1, input2, toSlice(q), want)
 	}
 }
+
+func TestUnionAll(t *testing.T) {
+	input1 := []int{1, 2, 2}
+	input2 := []int{2, 3}
+	want := []interface{}{1, 2, 2, 2, 3}
+
+	if q := From(input1).UnionAll(From(input2)); !validateQuery(q, want) {
+		t.Errorf("From(%v).UnionAll(%v)=%v expected %v", input1, input2, toSlice(q), want)
+	}
+}
@@ -0,0 +1,150 @@
+package linq
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// ValidateQuery builds a query with build and checks it with Validate, without
+// iterating over it, so that queries built from configuration can be checked
+// at startup instead of failing while they are iterated.
+//
+// Sources and operators check their parameters when the query is built, such
+// as the signatures of the functions passed to the typed methods, and panic
+// with an error if they are invalid. ValidateQuery recovers such a panic and
+// returns the error instead. Runtime panics and panics with values that are not
+// errors are not recovered.
+func ValidateQuery(build func() Query, results ...interface{}) (Query, error) {
+	q, err := buildQuery(build)
+	if err != nil {
+		return Query{}, err
+	}
+
+	return q, q.Validate(results...)
+}
+
+// buildQuery builds a query with build, recovering the error its sources or
+// operators panic with if their parameters are invalid. Runtime panics and
+// panics with values that are not errors are not recovered.
+func buildQuery(build func() Query) (q Query, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, isErr := r.(error)
+			if _, isRuntime := r.(runtime.Error); !isErr || isRuntime {
+				panic(r)
+			}
+
+			q, err = Query{}, e
+		}
+	}()
+
+	return build(), nil
+}
+
+// Validate checks that a query can be iterated and that its elements can be
+// stored in each of results, as ToSlice and ToMap would, without iterating over
+// the query or calling any of its functions. Each result has to be a non-nil
+// pointer to a slice or a map.
+//
+// The elements are checked against the types recorded when the query was
+// built: the element type of the slice, array, map, string or typed channel it
+// was created from, and the result type of the typed selectors SelectT,
+// SelectIndexedT and SelectPureT. These types are kept by Where, Skip, Take,
+// Reverse, Distinct and OrderBy and their variants, and the typed functions
+// passed to these operators have to accept them. Elements of interface types,
+// and elements of queries built by other operators, are not checked.
+func (q Query) Validate(results ...interface{}) error {
+	if q.Iterate == nil {
+		return errors.New("linq: query has no source")
+	}
+
+	if q.buildErr != nil {
+		return q.buildErr
+	}
+
+	for _, result := range results {
+		if err := q.validateResult(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateResult checks that the elements of q can be stored in result.
+func (q Query) validateResult(result interface{}) error {
+	res := reflect.ValueOf(result)
+	if res.Kind() != reflect.Ptr || res.IsNil() ||
+		(res.Elem().Kind() != reflect.Slice && res.Elem().Kind() != reflect.Map) {
+		return fmt.Errorf("linq: result has an invalid type. Expected: 'non-nil pointer to slice or map', actual: '%T'", result)
+	}
+
+	if q.elemType == nil {
+		return nil
+	}
+
+	t := keyValueType
+	if res.Elem().Kind() == reflect.Slice {
+		t = res.Elem().Type().Elem()
+	}
+
+	if !q.elemType.AssignableTo(t) {
+		return fmt.Errorf("linq: element of type '%s' is not assignable to type '%s'", q.elemType, t)
+	}
+
+	return nil
+}
+
+// keyValueType is the type of the elements of a query created from a map, and
+// of the elements stored by ToMap.
+var keyValueType = reflect.TypeOf(KeyValue{})
+
+// knownType returns t as the type of the elements of a query, or nil if t is
+// an interface type, as the elements may then have any type.
+func knownType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Interface {
+		return nil
+	}
+
+	return t
+}
+
+// elementsOf returns r with the element type and the build error of q, for
+// operators that don't change the elements of q.
+func (r Query) elementsOf(q Query) Query {
+	r.elemType, r.buildErr = q.elemType, q.buildErr
+	return r
+}
+
+// filteredBy returns r, built by passing the elements of q to the typed
+// function fn as its parameter at position param, with the build error of q,
+// or an error if the elements of q can't be passed to fn.
+func (r Query) filteredBy(q Query, fn *genericFunc, param int) Query {
+	r.buildErr = q.paramErr(fn, param)
+	return r
+}
+
+// selectedBy is like filteredBy, but the elements of r are the results of fn.
+func (r Query) selectedBy(q Query, fn *genericFunc, param int) Query {
+	r.elemType = knownType(fn.Cache.TypesOut[0])
+	return r.filteredBy(q, fn, param)
+}
+
+// paramErr returns the build error of q, or an error if the elements of q are
+// known not to be assignable to the parameter at position param of the typed
+// function fn, which would make the query panic when it is iterated.
+func (q Query) paramErr(fn *genericFunc, param int) error {
+	if q.buildErr != nil {
+		return q.buildErr
+	}
+
+	t := fn.Cache.TypesIn[param]
+	if q.elemType == nil || q.elemType.AssignableTo(t) {
+		return nil
+	}
+
+	return fmt.Errorf("%s: parameter [%s] has an invalid type for the elements of the query. Expected: '%s', actual: '%s'",
+		fn.Cache.MethodName, fn.Cache.ParamName, q.elemType, t)
+}
@@ -0,0 +1,75 @@
+package linq
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestValidateQuery(t *testing.T) {
+	q, err := ValidateQuery(func() Query {
+		return From([]int{1, 2}).SelectT(func(i int) string { return strconv.Itoa(i) })
+	}, new([]string))
+	if err != nil || !validateQuery(q, []interface{}{"1", "2"}) {
+		t.Errorf("ValidateQuery()=%v, %v expected [1 2], nil", toSlice(q), err)
+	}
+
+	_, err = ValidateQuery(func() Query {
+		return From([]int{1, 2}).WhereT(func(i int) int { return i })
+	})
+	if want := "WhereT: parameter [predicateFn] has a invalid function signature. Expected: 'func(T)bool', actual: 'func(int)int'"; err == nil || err.Error() != want {
+		t.Errorf("ValidateQuery()=%v expected %v", err, want)
+	}
+
+	_, err = ValidateQuery(func() Query {
+		return From([]int{1, 2}).SelectT(func(i int) int { return i })
+	}, new([]string))
+	if want := "linq: element of type 'int' is not assignable to type 'string'"; err == nil || err.Error() != want {
+		t.Errorf("ValidateQuery()=%v expected %v", err, want)
+	}
+}
+
+func TestValidateDoesNotIterate(t *testing.T) {
+	calls := 0
+	q := From([]int{1, 2}).SelectPureT(func(i int) string {
+		calls++
+		return strconv.Itoa(i)
+	}).WhereT(func(s string) bool {
+		calls++
+		return true
+	})
+
+	if err := q.Validate(new([]string)); err != nil || calls != 0 {
+		t.Errorf("Validate()=%v with %d calls, expected <nil> with 0 calls", err, calls)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		input   Query
+		results []interface{}
+		err     string
+	}{
+		{From([]int{1}), []interface{}{new([]int), new([]interface{})}, ""},
+		{From([]int{}), []interface{}{new([]string)}, "linq: element of type 'int' is not assignable to type 'string'"},
+		{From(map[string]int{}), []interface{}{new(map[string]int), new([]KeyValue)}, ""},
+		{From([]int{1}), []interface{}{new(map[int]int)}, "linq: element of type 'int' is not assignable to type 'linq.KeyValue'"},
+		{FromString("ab"), []interface{}{new([]rune)}, ""},
+		{Range(0, 2).Where(func(interface{}) bool { return true }).Skip(1).Reverse(), []interface{}{new([]string)}, "linq: element of type 'int' is not assignable to type 'string'"},
+		{Range(0, 2).OrderByT(func(i int) int { return -i }).Query, []interface{}{new([]int)}, ""},
+		{Range(0, 2).Select(func(i interface{}) interface{} { return i }), []interface{}{new([]string)}, ""},
+		{FromChannel(make(chan interface{})), []interface{}{new([]string)}, ""},
+		{FromChannelT(make(chan string)), []interface{}{new([]int)}, "linq: element of type 'string' is not assignable to type 'int'"},
+		{From([]interface{}{nil}), []interface{}{new([]int)}, ""},
+		{From([]string{"a"}).WhereT(func(i int) bool { return i > 0 }), nil, "WhereT: parameter [predicateFn] has an invalid type for the elements of the query. Expected: 'string', actual: 'int'"},
+		{From([]string{"a"}).SelectIndexedT(func(i int, s string) int { return i }).TakeWhileT(func(s string) bool { return true }), nil, "TakeWhileT: parameter [predicateFn] has an invalid type for the elements of the query. Expected: 'int', actual: 'string'"},
+		{From([]int{1}), []interface{}{[]int{}}, "linq: result has an invalid type. Expected: 'non-nil pointer to slice or map', actual: '[]int'"},
+		{Query{}, nil, "linq: query has no source"},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate(test.results...)
+		if (err == nil && test.err != "") || (err != nil && err.Error() != test.err) {
+			t.Errorf("%v.Validate(%v)=%v expected %v", test.input, test.results, err, test.err)
+		}
+	}
+}
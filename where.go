@@ -1,22 +1,41 @@
 package linq
 
+import "reflect"
+
 // Where filters a collection of values based on a predicate.
 func (q Query) Where(predicate func(interface{}) bool) Query {
+	filter := func(next Iterator) Iterator {
+		return (&whereEnumerator{iteratorEnumerator{next}, predicate}).Next
+	}
+
+	enumerate := func() Enumerator {
+		return &whereEnumerator{q.enumerator(), predicate}
+	}
+
 	return Query{
+		desc:      q.chain("Where"),
+		resume:    q.resumeWith(filter),
+		enumerate: enumerate,
 		Iterate: func() Iterator {
-			next := q.Iterate()
+			return enumerate().Next
+		},
+	}.elementsOf(q)
+}
 
-			return func() (item interface{}, ok bool) {
-				for item, ok = next(); ok; item, ok = next() {
-					if predicate(item) {
-						return
-					}
-				}
+// whereEnumerator returns the elements of source that satisfy predicate.
+type whereEnumerator struct {
+	source    Enumerator
+	predicate func(interface{}) bool
+}
 
-				return
-			}
-		},
+func (e *whereEnumerator) Next() (item interface{}, ok bool) {
+	for item, ok = e.source.Next(); ok; item, ok = e.source.Next() {
+		if e.predicate(item) {
+			return
+		}
 	}
+
+	return
 }
 
 // WhereT is the typed version of Where.
@@ -38,7 +57,7 @@ func (q Query) WhereT(predicateFn interface{}) Query {
 		return predicateGenericFunc.Call(item).(bool)
 	}
 
-	return q.Where(predicateFunc)
+	return q.Where(predicateFunc).filteredBy(q, predicateGenericFunc, 0)
 }
 
 // WhereIndexed filters a collection of values based on a predicate. Each
@@ -48,6 +67,7 @@ func (q Query) WhereT(predicateFn interface{}) Query {
 // collection. The second argument of predicate represents the element to test.
 func (q Query) WhereIndexed(predicate func(int, interface{}) bool) Query {
 	return Query{
+		desc: q.chain("WhereIndexed"),
 		Iterate: func() Iterator {
 			next := q.Iterate()
 			index := 0
@@ -65,7 +85,7 @@ func (q Query) WhereIndexed(predicate func(int, interface{}) bool) Query {
 				return
 			}
 		},
-	}
+	}.elementsOf(q)
 }
 
 // WhereIndexedT is the typed version of WhereIndexed.
@@ -86,5 +106,19 @@ func (q Query) WhereIndexedT(predicateFn interface{}) Query {
 		return predicateGenericFunc.Call(index, item).(bool)
 	}
 
-	return q.WhereIndexed(predicateFunc)
+	return q.WhereIndexed(predicateFunc).filteredBy(q, predicateGenericFunc, 1)
+}
+
+// SkipNil filters out nil elements of a collection: untyped nils as well as
+// nil pointers of any type, so that selectors and predicates applied later
+// don't have to guard against them.
+func (q Query) SkipNil() Query {
+	return q.Where(func(item interface{}) bool {
+		if item == nil {
+			return false
+		}
+
+		v := reflect.ValueOf(item)
+		return v.Kind() != reflect.Ptr || !v.IsNil()
+	})
 }
@@ -58,3 +58,21 @@ func TestWhereIndexedT_PanicWhenPredicateFnIsInvalid(t *testing.T) {
 		From([]int{1, 1, 1, 2, 1, 2, 3, 4, 2}).WhereIndexedT(func(item string) {})
 	})
 }
+
+func TestSkipNil(t *testing.T) {
+	one, two := 1, 2
+	var nilPtr *int
+	var nilMap map[string]int
+
+	input := []interface{}{nil, &one, nilPtr, 3, nil, &two, nilMap}
+	q := From(input).SkipNil()
+
+	results := q.Results()
+	if len(results) != 4 || results[0] != &one || results[1] != 3 || results[2] != &two {
+		t.Errorf("From(%v).SkipNil()=%v expected [&1 3 &2 map[]]", input, results)
+	}
+
+	if c := From([]*int{nil, nil}).SkipNil().Count(); c != 0 {
+		t.Errorf("SkipNil().Count()=%v expected 0", c)
+	}
+}
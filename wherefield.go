@@ -0,0 +1,184 @@
+package linq
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahmetb/go-linq/v3/internal/values"
+)
+
+// WhereField filters a collection of records by comparing the value of their
+// field or map entry with the specified name to value, so that filters can be
+// built at runtime, for example from configuration or HTTP query parameters.
+// See SelectField for the supported element types. Like in CompilePredicate, a
+// struct field can also be named by its json tag or by its name in any letter
+// case with or without underscores, so that the names of database columns can
+// be used. Elements without a field or entry with that name are filtered out.
+//
+// op is one of the following operators:
+//
+//   - "==", "!=", "<", "<=", ">", ">=" compare the field to value. Numbers of
+//     any type are compared by their values, and a string value is parsed when
+//     compared to a number, a bool or a time.Time (in RFC 3339 format), so
+//     WhereField("Age", ">=", "18") works for an int field. Other values are
+//     compared if they are of the same type and either basic types or
+//     implement Comparable; otherwise only "==" and "!=" match, using
+//     reflect.DeepEqual.
+//   - "contains" matches if the field is a string containing value, a slice or
+//     array with an element equal to value, or a map with the key value.
+//   - "in" matches if the field is equal to an element of value, which is a
+//     slice or array, or a comma-separated string.
+//
+// WhereField panics if op is not one of these operators.
+func (q Query) WhereField(name, op string, value interface{}) Query {
+	match := fieldMatcher(op, value)
+
+	return q.Where(func(item interface{}) bool {
+		field, ok := exprFieldValue(item, name)
+		return ok && match(field)
+	}).describe(q.chain("WhereField(" + name + " " + op + ")"))
+}
+
+// fieldMatcher returns the function that tests a field value for WhereField.
+func fieldMatcher(op string, value interface{}) func(interface{}) bool {
+	ordered := func(test func(int) bool) func(interface{}) bool {
+		return func(field interface{}) bool {
+			c, ok := compareField(field, value)
+			return ok && test(c)
+		}
+	}
+
+	switch op {
+	case "==":
+		return func(field interface{}) bool { return equalField(field, value) }
+	case "!=":
+		return func(field interface{}) bool { return !equalField(field, value) }
+	case "<":
+		return ordered(func(c int) bool { return c < 0 })
+	case "<=":
+		return ordered(func(c int) bool { return c <= 0 })
+	case ">":
+		return ordered(func(c int) bool { return c > 0 })
+	case ">=":
+		return ordered(func(c int) bool { return c >= 0 })
+	case "contains":
+		return func(field interface{}) bool { return containsField(field, value) }
+	case "in":
+		values := values.In(value)
+		return func(field interface{}) bool {
+			for _, v := range values {
+				if equalField(field, v) {
+					return true
+				}
+			}
+
+			return false
+		}
+	}
+
+	panic(fmt.Errorf("WhereField: parameter [op] has an invalid value. Expected: one of '==', '!=', '<', '<=', '>', '>=', 'contains', 'in', actual: '%s'", op))
+}
+
+// compareField compares a field value to value, converting value to the type
+// of the field if it is a string. ok is false if the values can't be ordered.
+func compareField(field, value interface{}) (c int, ok bool) {
+	s, isString := value.(string)
+
+	if f, ok := toFloat64(field); ok {
+		v, ok := toFloat64(value)
+		if !ok && isString {
+			var err error
+			v, err = strconv.ParseFloat(strings.TrimSpace(s), 64)
+			ok = err == nil
+		}
+
+		if !ok {
+			return 0, false
+		}
+
+		return getComparer(f)(f, v), true
+	}
+
+	switch field.(type) {
+	case bool:
+		if isString {
+			b, err := strconv.ParseBool(strings.TrimSpace(s))
+			if err != nil {
+				return 0, false
+			}
+
+			value = b
+		}
+	case time.Time:
+		if isString {
+			t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+			if err != nil {
+				return 0, false
+			}
+
+			value = t
+		}
+	}
+
+	if f, v := reflect.ValueOf(field), reflect.ValueOf(value); f.Kind() == reflect.String && v.Kind() == reflect.String {
+		return strings.Compare(f.String(), v.String()), true
+	}
+
+	if reflect.TypeOf(field) != reflect.TypeOf(value) {
+		return 0, false
+	}
+
+	if b, ok := field.(bool); ok {
+		switch {
+		case b == value.(bool):
+			return 0, true
+		case b:
+			return 1, true
+		default:
+			return -1, true
+		}
+	}
+
+	switch field.(type) {
+	case time.Time, Comparable:
+		return getComparer(field)(field, value), true
+	}
+
+	return 0, false
+}
+
+// equalField reports whether a field value is equal to value.
+func equalField(field, value interface{}) bool {
+	if c, ok := compareField(field, value); ok {
+		return c == 0
+	}
+
+	return reflect.DeepEqual(field, value)
+}
+
+// containsField reports whether a field value contains value.
+func containsField(field, value interface{}) bool {
+	v := reflect.ValueOf(field)
+
+	switch v.Kind() {
+	case reflect.String:
+		return strings.Contains(v.String(), fmt.Sprint(value))
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if equalField(v.Index(i).Interface(), value) {
+				return true
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			if equalField(key.Interface(), value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
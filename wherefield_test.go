@@ -0,0 +1,92 @@
+package linq
+
+import (
+	"testing"
+	"time"
+)
+
+type whereFieldTestCountry string
+
+type whereFieldTestUser struct {
+	Name    string
+	Age     int
+	Country whereFieldTestCountry
+	Admin   bool
+	Tags    []string
+	Joined  time.Time
+}
+
+func TestWhereField(t *testing.T) {
+	joined := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := []whereFieldTestUser{
+		{"Ann", 30, "DE", true, []string{"staff"}, joined},
+		{"Bob", 17, "FR", false, nil, joined.AddDate(1, 0, 0)},
+		{"Cid", 18, "DE", false, []string{"guest", "staff"}, joined.AddDate(2, 0, 0)},
+	}
+
+	names := func(q Query) []interface{} {
+		return toSlice(q.SelectField("Name"))
+	}
+
+	tests := []struct {
+		name   string
+		op     string
+		value  interface{}
+		output []interface{}
+	}{
+		{"Age", ">=", 18, []interface{}{"Ann", "Cid"}},
+		{"Age", ">=", "18", []interface{}{"Ann", "Cid"}},
+		{"Age", "<", 18.5, []interface{}{"Bob", "Cid"}},
+		{"Age", "==", int64(17), []interface{}{"Bob"}},
+		{"Age", "!=", 17, []interface{}{"Ann", "Cid"}},
+		{"Age", ">", "old", []interface{}{}},
+		{"Country", "==", "DE", []interface{}{"Ann", "Cid"}},
+		{"Country", "<=", "DE", []interface{}{"Ann", "Cid"}},
+		{"Name", "contains", "i", []interface{}{"Cid"}},
+		{"Admin", "==", "true", []interface{}{"Ann"}},
+		{"Admin", "!=", true, []interface{}{"Bob", "Cid"}},
+		{"Tags", "contains", "staff", []interface{}{"Ann", "Cid"}},
+		{"Joined", ">", "2020-06-01T00:00:00Z", []interface{}{"Bob", "Cid"}},
+		{"Joined", "==", joined, []interface{}{"Ann"}},
+		{"Country", "in", "FR, IT", []interface{}{"Bob"}},
+		{"Age", "in", []int{17, 30}, []interface{}{"Ann", "Bob"}},
+		{"country", "==", "FR", []interface{}{"Bob"}},
+		{"Missing", "!=", 1, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := From(users).WhereField(test.name, test.op, test.value)
+		if got := names(q); !validateQuery(From(got), test.output) {
+			t.Errorf("WhereField(%q, %q, %v)=%v expected %v", test.name, test.op, test.value, got, test.output)
+		}
+	}
+}
+
+func TestWhereFieldWithMaps(t *testing.T) {
+	records := []map[string]interface{}{
+		{"id": 1, "labels": map[string]string{"env": "prod"}},
+		{"id": 2, "labels": map[string]string{}},
+		{"id": 3},
+	}
+
+	q := From(records).WhereField("labels", "contains", "env").SelectField("id")
+	if w := []interface{}{1}; !validateQuery(q, w) {
+		t.Errorf("WhereField(labels, contains, env)=%v expected %v", toSlice(q), w)
+	}
+
+	q = From(records).WhereField("id", ">", "1").SelectField("id")
+	if w := []interface{}{2, 3}; !validateQuery(q, w) {
+		t.Errorf("WhereField(id, >, 1)=%v expected %v", toSlice(q), w)
+	}
+}
+
+func TestWhereField_PanicWhenOpIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "WhereField: parameter [op] has an invalid value. Expected: one of '==', '!=', '<', '<=', '>', '>=', 'contains', 'in', actual: '=~'", func() {
+		From([]int{1}).WhereField("Age", "=~", 1)
+	})
+
+	_, err := ValidateQuery(func() Query { return From([]int{1}).WhereField("Age", "=~", 1) })
+	if err == nil {
+		t.Errorf("ValidateQuery(WhereField(=~)) expected an error")
+	}
+}
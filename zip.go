@@ -1,5 +1,7 @@
 package linq
 
+import "strconv"
+
 // Zip applies a specified function to the corresponding elements of two
 // collections, producing a collection of the results.
 //
@@ -14,6 +16,7 @@ func (q Query) Zip(q2 Query,
 	resultSelector func(interface{}, interface{}) interface{}) Query {
 
 	return Query{
+		desc: q.chain("Zip"),
 		Iterate: func() Iterator {
 			next1 := q.Iterate()
 			next2 := q2.Iterate()
@@ -53,3 +56,232 @@ func (q Query) ZipT(q2 Query,
 
 	return q.Zip(q2, resultSelectorFunc)
 }
+
+// Zip3 applies a specified function to the corresponding elements of three
+// collections, producing a collection of the results.
+//
+// Like Zip, the method combines elements until it reaches the end of one of
+// the collections.
+func (q Query) Zip3(q2, q3 Query,
+	resultSelector func(interface{}, interface{}, interface{}) interface{}) Query {
+
+	return Query{
+		desc: q.chain("Zip3"),
+		Iterate: func() Iterator {
+			next1 := q.Iterate()
+			next2 := q2.Iterate()
+			next3 := q3.Iterate()
+
+			return func() (item interface{}, ok bool) {
+				item1, ok1 := next1()
+				item2, ok2 := next2()
+				item3, ok3 := next3()
+
+				if ok1 && ok2 && ok3 {
+					return resultSelector(item1, item2, item3), true
+				}
+
+				return nil, false
+			}
+		},
+	}
+}
+
+// Zip3T is the typed version of Zip3.
+//
+//   - resultSelectorFn is of type "func(TFirst,TSecond,TThird)TResult"
+//
+// NOTE: Zip3 has better performance than Zip3T.
+func (q Query) Zip3T(q2, q3 Query,
+	resultSelectorFn interface{}) Query {
+	resultSelectorGenericFunc, err := newGenericFunc(
+		"Zip3T", "resultSelectorFn", resultSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	resultSelectorFunc := func(item1, item2, item3 interface{}) interface{} {
+		return resultSelectorGenericFunc.Call(item1, item2, item3)
+	}
+
+	return q.Zip3(q2, q3, resultSelectorFunc)
+}
+
+// ZipN applies a specified function to the corresponding elements of any
+// number of collections, producing a collection of the results.
+//
+// The elements of the collections are passed to resultSelector in a new slice
+// for every result, in the order of the queries. Like Zip, the method combines
+// elements until it reaches the end of one of the collections. If no queries
+// are passed, the result is empty.
+func ZipN(resultSelector func([]interface{}) interface{}, queries ...Query) Query {
+	queries = append([]Query(nil), queries...)
+
+	return Query{
+		desc: "ZipN(" + strconv.Itoa(len(queries)) + ")",
+		Iterate: func() Iterator {
+			nexts := make([]Iterator, len(queries))
+			for i, q := range queries {
+				nexts[i] = q.Iterate()
+			}
+
+			done := len(nexts) == 0
+
+			return func() (item interface{}, ok bool) {
+				if done {
+					return
+				}
+
+				items := make([]interface{}, len(nexts))
+				for i, next := range nexts {
+					if items[i], ok = next(); !ok {
+						done = true
+						return nil, false
+					}
+				}
+
+				return resultSelector(items), true
+			}
+		},
+	}
+}
+
+// ZipLongest applies a specified function to the corresponding elements of two
+// collections, producing a collection of the results.
+//
+// Unlike Zip, the method combines elements until it reaches the end of both
+// collections. Once the shorter collection is exhausted, fill1 or fill2 is
+// passed to resultSelector in place of its elements. For example, if one
+// collection has three elements and the other one has four, the result
+// collection has four elements.
+func (q Query) ZipLongest(q2 Query,
+	resultSelector func(interface{}, interface{}) interface{},
+	fill1, fill2 interface{}) Query {
+
+	var length func() int
+	if q.length != nil && q2.length != nil {
+		length = func() int {
+			n1, n2 := q.length(), q2.length()
+			if n1 > n2 {
+				return n1
+			}
+
+			return n2
+		}
+	}
+
+	return Query{
+		desc:   q.chain("ZipLongest"),
+		length: length,
+		Iterate: func() Iterator {
+			next1 := q.Iterate()
+			next2 := q2.Iterate()
+			done1, done2 := false, false
+
+			return func() (item interface{}, ok bool) {
+				item1, item2 := fill1, fill2
+
+				if !done1 {
+					var ok1 bool
+					if item1, ok1 = next1(); !ok1 {
+						item1, done1 = fill1, true
+					}
+				}
+
+				if !done2 {
+					var ok2 bool
+					if item2, ok2 = next2(); !ok2 {
+						item2, done2 = fill2, true
+					}
+				}
+
+				if done1 && done2 {
+					return nil, false
+				}
+
+				return resultSelector(item1, item2), true
+			}
+		},
+	}
+}
+
+// ZipLongestT is the typed version of ZipLongest.
+//
+//   - resultSelectorFn is of type "func(TFirst,TSecond)TResult"
+//
+// NOTE: ZipLongest has better performance than ZipLongestT.
+func (q Query) ZipLongestT(q2 Query,
+	resultSelectorFn interface{}, fill1, fill2 interface{}) Query {
+	resultSelectorGenericFunc, err := newGenericFunc(
+		"ZipLongestT", "resultSelectorFn", resultSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType), new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	resultSelectorFunc := func(item1 interface{}, item2 interface{}) interface{} {
+		return resultSelectorGenericFunc.Call(item1, item2)
+	}
+
+	return q.ZipLongest(q2, resultSelectorFunc, fill1, fill2)
+}
+
+// Unzip splits a collection into two collections, which is the inverse of Zip.
+// Function leftSelector and rightSelector are applied to every element to get
+// its parts, such as the Key and the Value of a KeyValue.
+//
+// Unzip iterates over the collection once and immediately, and the returned
+// queries contain the parts of the elements in their original order.
+func (q Query) Unzip(leftSelector, rightSelector func(interface{}) interface{}) (Query, Query) {
+	var left, right []interface{}
+	if n := q.capacity(); n > 0 {
+		left = make([]interface{}, 0, n)
+		right = make([]interface{}, 0, n)
+	}
+
+	next := q.Iterate()
+	for item, ok := next(); ok; item, ok = next() {
+		left = append(left, leftSelector(item))
+		right = append(right, rightSelector(item))
+	}
+
+	desc := q.chain("Unzip")
+	return From(left).describe(desc), From(right).describe(desc)
+}
+
+// UnzipT is the typed version of Unzip.
+//
+//   - leftSelectorFn is of type "func(TSource)TLeft"
+//   - rightSelectorFn is of type "func(TSource)TRight"
+//
+// NOTE: Unzip has better performance than UnzipT.
+func (q Query) UnzipT(leftSelectorFn, rightSelectorFn interface{}) (Query, Query) {
+	leftSelectorGenericFunc, err := newGenericFunc(
+		"UnzipT", "leftSelectorFn", leftSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	rightSelectorGenericFunc, err := newGenericFunc(
+		"UnzipT", "rightSelectorFn", rightSelectorFn,
+		simpleParamValidator(newElemTypeSlice(new(genericType)), newElemTypeSlice(new(genericType))),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	leftSelectorFunc := func(item interface{}) interface{} {
+		return leftSelectorGenericFunc.Call(item)
+	}
+
+	rightSelectorFunc := func(item interface{}) interface{} {
+		return rightSelectorGenericFunc.Call(item)
+	}
+
+	return q.Unzip(leftSelectorFunc, rightSelectorFunc)
+}
@@ -24,3 +24,126 @@ func TestZipT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
 		})
 	})
 }
+
+func TestZip3(t *testing.T) {
+	input1 := []int{1, 2, 3}
+	input2 := []int{2, 4, 5, 1}
+	input3 := []int{10, 20, 30, 40}
+	want := []interface{}{13, 26, 38}
+
+	if q := From(input1).Zip3(From(input2), From(input3), func(i, j, k interface{}) interface{} {
+		return i.(int) + j.(int) + k.(int)
+	}); !validateQuery(q, want) {
+		t.Errorf("From(%v).Zip3(%v, %v)=%v expected %v", input1, input2, input3, toSlice(q), want)
+	}
+
+	if q := From(input1).Zip3T(From(input2), From(input3), func(i, j, k int) int {
+		return i * j * k
+	}); !validateQuery(q, []interface{}{20, 160, 450}) {
+		t.Errorf("From(%v).Zip3T(%v, %v)=%v expected [20 160 450]", input1, input2, input3, toSlice(q))
+	}
+}
+
+func TestZip3T_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "Zip3T: parameter [resultSelectorFn] has a invalid function signature. Expected: 'func(T,T,T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1}).Zip3T(From([]int{2}), From([]int{3}), func(i, j int) int {
+			return i + j
+		})
+	})
+}
+
+func TestZipN(t *testing.T) {
+	tests := []struct {
+		input []Query
+		want  []interface{}
+	}{
+		{[]Query{From([]int{1, 2, 3}), Range(10, 5), Repeat(100, 2), From([]int{1000, 2000})}, []interface{}{1111, 2113}},
+		{[]Query{From([]int{1, 2, 3})}, []interface{}{1, 2, 3}},
+		{[]Query{From([]int{1, 2, 3}), Empty()}, []interface{}{}},
+		{nil, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		if q := ZipN(func(items []interface{}) interface{} {
+			sum := 0
+			for _, item := range items {
+				sum += item.(int)
+			}
+			return sum
+		}, test.input...); !validateQuery(q, test.want) {
+			t.Errorf("ZipN(%v)=%v expected %v", test.input, toSlice(q), test.want)
+		}
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	tests := []struct {
+		input1 []int
+		input2 []int
+		want   []interface{}
+	}{
+		{[]int{1, 2, 3}, []int{2, 4, 5, 1}, []interface{}{3, 6, 8, 101}},
+		{[]int{1, 2, 3}, []int{2}, []interface{}{3, 1002, 1003}},
+		{[]int{}, []int{}, []interface{}{}},
+	}
+
+	for _, test := range tests {
+		q := From(test.input1).ZipLongest(From(test.input2), func(i, j interface{}) interface{} {
+			return i.(int) + j.(int)
+		}, 100, 1000)
+
+		if !validateQuery(q, test.want) || q.Count() != len(test.want) {
+			t.Errorf("From(%v).ZipLongest(%v)=%v expected %v", test.input1, test.input2, toSlice(q), test.want)
+		}
+	}
+
+	if q := From([]int{1}).ZipLongestT(From([]string{"a", "b"}), func(i int, s string) string {
+		return s + string(rune('0'+i))
+	}, 0, "-"); !validateQuery(q, []interface{}{"a1", "b0"}) {
+		t.Errorf("From([1]).ZipLongestT([a b])=%v expected [a1 b0]", toSlice(q))
+	}
+}
+
+func TestZipLongestT_PanicWhenResultSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "ZipLongestT: parameter [resultSelectorFn] has a invalid function signature. Expected: 'func(T,T)T', actual: 'func(int)int'", func() {
+		From([]int{1}).ZipLongestT(From([]int{2}), func(i int) int {
+			return i
+		}, 0, 0)
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	input := []KeyValue{{"a", 1}, {"b", 2}, {"c", 3}}
+
+	keys, values := From(input).Unzip(func(i interface{}) interface{} {
+		return i.(KeyValue).Key
+	}, func(i interface{}) interface{} {
+		return i.(KeyValue).Value
+	})
+
+	if w := []interface{}{"a", "b", "c"}; !validateQuery(keys, w) {
+		t.Errorf("From(%v).Unzip() left=%v expected %v", input, toSlice(keys), w)
+	}
+
+	if w := []interface{}{1, 2, 3}; !validateQuery(values, w) {
+		t.Errorf("From(%v).Unzip() right=%v expected %v", input, toSlice(values), w)
+	}
+
+	left, right := Range(1, 3).UnzipT(func(i int) int { return i * 2 }, func(i int) string {
+		return string(rune('a' + i))
+	})
+
+	if !validateQuery(left, []interface{}{2, 4, 6}) || !validateQuery(right, []interface{}{"b", "c", "d"}) {
+		t.Errorf("Range(1, 3).UnzipT()=%v, %v expected [2 4 6], [b c d]", toSlice(left), toSlice(right))
+	}
+
+	if left, right := Empty().Unzip(nil, nil); left.Count() != 0 || right.Count() != 0 {
+		t.Errorf("Empty().Unzip()=%v, %v expected [], []", toSlice(left), toSlice(right))
+	}
+}
+
+func TestUnzipT_PanicWhenRightSelectorFnIsInvalid(t *testing.T) {
+	mustPanicWithError(t, "UnzipT: parameter [rightSelectorFn] has a invalid function signature. Expected: 'func(T)T', actual: 'func(int,int)int'", func() {
+		From([]int{1}).UnzipT(func(i int) int { return i }, func(i, j int) int { return i })
+	})
+}